@@ -0,0 +1,209 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+	"github.com/hazelcast/hazelcast-go-client/nearcache"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+func TestCache_PutGet(t *testing.T) {
+	it.CacheTester(t, func(t *testing.T, cc *hz.Cache) {
+		ctx := context.Background()
+		require.NoError(t, cc.Put(ctx, "k1", "v1"))
+		v, err := cc.Get(ctx, "k1")
+		require.NoError(t, err)
+		assert.Equal(t, "v1", v)
+		v, err = cc.Get(ctx, "missing")
+		require.NoError(t, err)
+		assert.Nil(t, v)
+		old, err := cc.GetAndPut(ctx, "k1", "v2")
+		require.NoError(t, err)
+		assert.Equal(t, "v1", old)
+		ok, err := cc.ContainsKey(ctx, "k1")
+		require.NoError(t, err)
+		assert.True(t, ok)
+		size, err := cc.Size(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, size)
+	})
+}
+
+func TestCache_PutIfAbsentReplaceRemove(t *testing.T) {
+	it.CacheTester(t, func(t *testing.T, cc *hz.Cache) {
+		ctx := context.Background()
+		ok, err := cc.PutIfAbsent(ctx, "k1", "v1")
+		require.NoError(t, err)
+		assert.True(t, ok)
+		ok, err = cc.PutIfAbsent(ctx, "k1", "v2")
+		require.NoError(t, err)
+		assert.False(t, ok)
+		ok, err = cc.Replace(ctx, "k1", "v2")
+		require.NoError(t, err)
+		assert.True(t, ok)
+		ok, err = cc.Replace(ctx, "missing", "v2")
+		require.NoError(t, err)
+		assert.False(t, ok)
+		ok, err = cc.ReplaceIfSame(ctx, "k1", "other", "v3")
+		require.NoError(t, err)
+		assert.False(t, ok)
+		ok, err = cc.ReplaceIfSame(ctx, "k1", "v2", "v3")
+		require.NoError(t, err)
+		assert.True(t, ok)
+		old, err := cc.GetAndReplace(ctx, "k1", "v4")
+		require.NoError(t, err)
+		assert.Equal(t, "v3", old)
+		ok, err = cc.RemoveIfSame(ctx, "k1", "v3")
+		require.NoError(t, err)
+		assert.False(t, ok)
+		old, err = cc.GetAndRemove(ctx, "k1")
+		require.NoError(t, err)
+		assert.Equal(t, "v4", old)
+		require.NoError(t, cc.Put(ctx, "k2", "v"))
+		ok, err = cc.Remove(ctx, "k2")
+		require.NoError(t, err)
+		assert.True(t, ok)
+		ok, err = cc.Remove(ctx, "k2")
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+}
+
+func TestCache_PutAllGetAll(t *testing.T) {
+	it.CacheTester(t, func(t *testing.T, cc *hz.Cache) {
+		ctx := context.Background()
+		entries := []types.Entry{
+			{Key: "k1", Value: "v1"},
+			{Key: "k2", Value: "v2"},
+			{Key: "k3", Value: "v3"},
+		}
+		require.NoError(t, cc.PutAll(ctx, entries...))
+		got, err := cc.GetAll(ctx, "k1", "k2", "k3", "missing")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, entries, got)
+		require.NoError(t, cc.RemoveAll(ctx))
+		size, err := cc.Size(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, size)
+		require.NoError(t, cc.PutAll(ctx, entries...))
+		require.NoError(t, cc.Clear(ctx))
+		size, err = cc.Size(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, size)
+	})
+}
+
+func TestCache_ExpiryPolicy(t *testing.T) {
+	it.CacheTester(t, func(t *testing.T, cc *hz.Cache) {
+		ctx := context.Background()
+		ep := hz.ExpiryPolicy{Create: 2 * time.Second}
+		require.NoError(t, cc.PutWithExpiryPolicy(ctx, "k1", "v1", ep))
+		require.NoError(t, cc.Put(ctx, "k2", "v2"))
+		it.Eventually(t, func() bool {
+			v, err := cc.Get(ctx, "k1")
+			require.NoError(t, err)
+			return v == nil
+		})
+		v, err := cc.Get(ctx, "k2")
+		require.NoError(t, err)
+		assert.Equal(t, "v2", v)
+	})
+}
+
+func TestCache_ExpiryPolicyNegativeDuration(t *testing.T) {
+	it.CacheTester(t, func(t *testing.T, cc *hz.Cache) {
+		ep := hz.ExpiryPolicy{Access: -time.Second}
+		err := cc.PutWithExpiryPolicy(context.Background(), "k1", "v1", ep)
+		require.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+	})
+}
+
+func TestCache_EntryListener(t *testing.T) {
+	it.CacheTester(t, func(t *testing.T, cc *hz.Cache) {
+		ctx := context.Background()
+		var mu sync.Mutex
+		var events []hz.CacheEntryEventType
+		sid, err := cc.AddEntryListener(ctx, func(event *hz.CacheEntryNotified) {
+			mu.Lock()
+			events = append(events, event.EventType)
+			mu.Unlock()
+		})
+		require.NoError(t, err)
+		require.NoError(t, cc.Put(ctx, "k1", "v1"))
+		require.NoError(t, cc.Put(ctx, "k1", "v2"))
+		_, err = cc.Remove(ctx, "k1")
+		require.NoError(t, err)
+		expected := []hz.CacheEntryEventType{hz.CacheEntryCreated, hz.CacheEntryUpdated, hz.CacheEntryRemoved}
+		it.Eventually(t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return assert.ObjectsAreEqual(expected, events)
+		})
+		require.NoError(t, cc.RemoveEntryListener(ctx, sid))
+		require.NoError(t, cc.Put(ctx, "k2", "v"))
+		it.Never(t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return len(events) != len(expected)
+		})
+	})
+}
+
+func TestCache_NearCache(t *testing.T) {
+	makeName := func() string {
+		return it.NewUniqueObjectName("cache", "nc")
+	}
+	configCB := func(cfg *hz.Config) {
+		cfg.AddNearCache(nearcache.Config{Name: "test-cache*"})
+	}
+	it.CacheTesterWithConfigAndName(t, makeName, configCB, func(t *testing.T, cc *hz.Cache) {
+		ctx := context.Background()
+		require.NoError(t, cc.Put(ctx, "k1", "v1"))
+		for i := 0; i < 2; i++ {
+			v, err := cc.Get(ctx, "k1")
+			require.NoError(t, err)
+			assert.Equal(t, "v1", v)
+		}
+		// local writes invalidate the Near Cache.
+		require.NoError(t, cc.Put(ctx, "k1", "v2"))
+		v, err := cc.Get(ctx, "k1")
+		require.NoError(t, err)
+		assert.Equal(t, "v2", v)
+		entries, err := cc.GetAll(ctx, "k1", "missing")
+		require.NoError(t, err)
+		assert.Equal(t, []types.Entry{{Key: "k1", Value: "v2"}}, entries)
+	})
+}
+
+func TestCache_NotConfigured(t *testing.T) {
+	it.TesterWithConfigBuilder(t, nil, func(t *testing.T, client *hz.Client) {
+		_, err := client.GetCache(context.Background(), it.NewUniqueObjectName("not-configured-cache"))
+		require.True(t, errors.Is(err, hzerrors.ErrCacheNotExists))
+	})
+}
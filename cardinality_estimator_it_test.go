@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+)
+
+func TestCardinalityEstimator_Estimate(t *testing.T) {
+	it.CardinalityEstimatorTester(t, func(t *testing.T, ce *hz.CardinalityEstimator) {
+		ctx := context.Background()
+		est, err := ce.Estimate(ctx)
+		require.NoError(t, err)
+		require.Equal(t, int64(0), est)
+		const count = 1000
+		for i := 0; i < count; i++ {
+			require.NoError(t, ce.Add(ctx, fmt.Sprintf("item-%d", i)))
+			// adding the same item again does not change the estimate.
+			require.NoError(t, ce.Add(ctx, fmt.Sprintf("item-%d", i)))
+		}
+		est, err = ce.Estimate(ctx)
+		require.NoError(t, err)
+		// HyperLogLog++ has a standard error of about 1% with the default precision.
+		require.InDelta(t, count, est, count*0.05)
+	})
+}
+
+func TestCardinalityEstimator_AddNil(t *testing.T) {
+	it.CardinalityEstimatorTester(t, func(t *testing.T, ce *hz.CardinalityEstimator) {
+		err := ce.Add(context.Background(), nil)
+		require.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+	})
+}
@@ -29,6 +29,7 @@ import (
 	icluster "github.com/hazelcast/hazelcast-go-client/internal/cluster"
 	icp "github.com/hazelcast/hazelcast-go-client/internal/cp"
 	"github.com/hazelcast/hazelcast-go-client/internal/event"
+	ijet "github.com/hazelcast/hazelcast-go-client/internal/jet"
 	"github.com/hazelcast/hazelcast-go-client/internal/lifecycle"
 	inearcache "github.com/hazelcast/hazelcast-go-client/internal/nearcache"
 	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
@@ -49,6 +50,26 @@ type AtomicLong = icp.AtomicLong
 type AtomicRef = icp.AtomicRef
 type CPMap = icp.Map
 type CPSubsystem = icp.Subsystem
+type FencedLock = icp.FencedLock
+type Semaphore = icp.Semaphore
+type CountDownLatch = icp.CountDownLatch
+type JetService = ijet.Service
+type Job = ijet.Job
+type JobStatus = ijet.JobStatus
+
+const (
+	JobStatusNotRunning                 = ijet.JobStatusNotRunning
+	JobStatusStarting                   = ijet.JobStatusStarting
+	JobStatusRunning                    = ijet.JobStatusRunning
+	JobStatusSuspended                  = ijet.JobStatusSuspended
+	JobStatusSuspendedExportingSnapshot = ijet.JobStatusSuspendedExportingSnapshot
+	JobStatusCompleting                 = ijet.JobStatusCompleting
+	JobStatusFailed                     = ijet.JobStatusFailed
+	JobStatusCompleted                  = ijet.JobStatusCompleted
+)
+
+// InvalidFence is the fencing token returned by FencedLock methods when the lock is not acquired.
+const InvalidFence = icp.InvalidFence
 
 func NewLockContext(ctx context.Context) context.Context {
 	return iproxy.NewLockContext(ctx)
@@ -83,6 +104,7 @@ type Client struct {
 	ic                      *client.Client
 	sqlService              isql.Service
 	cpSubsystem             CPSubsystem
+	jetService              *JetService
 	nearCacheMgrsMu         *sync.RWMutex
 	nearCacheMgrs           map[string]*inearcache.Manager
 	cfg                     *Config
@@ -192,6 +214,8 @@ func (c *Client) GetRingbuffer(ctx context.Context, name string) (*Ringbuffer, e
 }
 
 // GetMap returns a distributed map instance.
+// If the Near Cache of the map has a preloader, the stored keys are loaded before the map is returned.
+// Concurrent GetMap calls for the same map wait until that preload completes, regardless of their contexts.
 func (c *Client) GetMap(ctx context.Context, name string) (*Map, error) {
 	if c.ic.State() != client.Ready {
 		return nil, hzerrors.ErrClientNotActive
@@ -215,10 +239,45 @@ func (c *Client) GetMap(ctx context.Context, name string) (*Map, error) {
 			return nil, err
 		}
 		m.hasNearCache = true
+		// the stored keys are loaded before the map is published, so the Near Cache is warm when it is used.
+		// failing to preload does not fail the map, since the Near Cache is filled on demand anyway.
+		err = nc.Preload(ctx, func(ctx context.Context, keys []interface{}) error {
+			_, err := m.GetAll(ctx, keys...)
+			return err
+		})
+		if err != nil {
+			c.ic.Logger.Warnf("hazelcast.Client.GetMap: %s", err.Error())
+		}
 		return m, nil
 	})
 }
 
+// GetCache returns a JCache compatible cache instance.
+// The cache must be configured on the members.
+func (c *Client) GetCache(ctx context.Context, name string) (*Cache, error) {
+	if c.ic.State() != client.Ready {
+		return nil, hzerrors.ErrClientNotActive
+	}
+	return c.proxyManager.getCache(ctx, name, func(p *proxy) (interface{}, error) {
+		cc := newCache(p, name)
+		ncc, ok, err := c.cfg.GetNearCache(name)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			// there is no near cache config for this cache
+			return cc, nil
+		}
+		ncmgr := c.getNearCacheManager(ServiceNameCache)
+		nc := ncmgr.GetOrCreateNearCache(p.name, ncc)
+		cc.ncc, err = newNearCacheCache(ctx, nc, c.ic.SerializationService, ncmgr.RepairingTask(), c.ic.Logger, p.name, p.listenerBinder, p.smart)
+		if err != nil {
+			return nil, err
+		}
+		return cc, nil
+	})
+}
+
 // GetReplicatedMap returns a replicated map instance.
 func (c *Client) GetReplicatedMap(ctx context.Context, name string) (*ReplicatedMap, error) {
 	if c.ic.State() != client.Ready {
@@ -251,6 +310,14 @@ func (c *Client) GetTopic(ctx context.Context, name string) (*Topic, error) {
 	return c.proxyManager.getTopic(ctx, name)
 }
 
+// GetReliableTopic returns a reliable topic instance.
+func (c *Client) GetReliableTopic(ctx context.Context, name string) (*ReliableTopic, error) {
+	if c.ic.State() != client.Ready {
+		return nil, hzerrors.ErrClientNotActive
+	}
+	return c.proxyManager.getReliableTopic(ctx, name)
+}
+
 // GetSet returns a set instance.
 func (c *Client) GetSet(ctx context.Context, name string) (*Set, error) {
 	if c.ic.State() != client.Ready {
@@ -267,6 +334,39 @@ func (c *Client) GetPNCounter(ctx context.Context, name string) (*PNCounter, err
 	return c.proxyManager.getPNCounter(ctx, name)
 }
 
+// GetCardinalityEstimator returns a CardinalityEstimator instance.
+func (c *Client) GetCardinalityEstimator(ctx context.Context, name string) (*CardinalityEstimator, error) {
+	if c.ic.State() != client.Ready {
+		return nil, hzerrors.ErrClientNotActive
+	}
+	return c.proxyManager.getCardinalityEstimator(ctx, name)
+}
+
+// GetExecutorService returns an ExecutorService instance.
+func (c *Client) GetExecutorService(ctx context.Context, name string) (*ExecutorService, error) {
+	if c.ic.State() != client.Ready {
+		return nil, hzerrors.ErrClientNotActive
+	}
+	return c.proxyManager.getExecutorService(ctx, name)
+}
+
+// GetDurableExecutorService returns a DurableExecutorService instance.
+func (c *Client) GetDurableExecutorService(ctx context.Context, name string) (*DurableExecutorService, error) {
+	if c.ic.State() != client.Ready {
+		return nil, hzerrors.ErrClientNotActive
+	}
+	return c.proxyManager.getDurableExecutorService(ctx, name)
+}
+
+// GetVectorCollection returns a VectorCollection instance.
+// If the client configuration contains a configuration for the collection, it is added to the cluster first.
+func (c *Client) GetVectorCollection(ctx context.Context, name string) (*VectorCollection, error) {
+	if c.ic.State() != client.Ready {
+		return nil, hzerrors.ErrClientNotActive
+	}
+	return c.proxyManager.getVectorCollection(ctx, name)
+}
+
 // GetFlakeIDGenerator returns a FlakeIDGenerator instance.
 func (c *Client) GetFlakeIDGenerator(ctx context.Context, name string) (*FlakeIDGenerator, error) {
 	if c.ic.State() != client.Ready {
@@ -379,11 +479,38 @@ func (c *Client) SQL() sql.Service {
 	return c.sqlService
 }
 
+// Jet returns a service to manage the Jet jobs running in the cluster.
+func (c *Client) Jet() *JetService {
+	return c.jetService
+}
+
+// PartitionService returns a service which provides information about the partitions of the cluster.
+func (c *Client) PartitionService() *PartitionService {
+	return &PartitionService{
+		ps: c.ic.PartitionService,
+		cs: c.ic.ClusterService,
+		ss: c.ic.SerializationService,
+	}
+}
+
 // CPSubsystem returns a service to offer a set of in-memory linearizable data structures.
 func (c *Client) CPSubsystem() CPSubsystem {
 	return c.cpSubsystem
 }
 
+// NewTransactionContext creates a context for a new transaction with the given options.
+// Use NewTransactionOptions to start with the default options.
+func (c *Client) NewTransactionContext(opts TransactionOptions) (*TransactionContext, error) {
+	if c.ic.State() != client.Ready {
+		return nil, hzerrors.ErrClientNotActive
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	pm := c.proxyManager
+	return newTransactionContext(pm.serviceBundle, c.ic.ConnectionManager, pm.refIDGenerator, opts), nil
+}
+
 func (c *Client) addLifecycleListener(subscriptionID int64, handler LifecycleStateChangeHandler) {
 	c.ic.EventDispatcher.Subscribe(eventLifecycleEventStateChanged, subscriptionID, func(event event.Event) {
 		// This is a workaround to avoid cyclic dependency between internal/cluster and hazelcast package.
@@ -481,7 +608,11 @@ func (c *Client) createComponents(config *Config) {
 	}
 	proxyManagerServiceBundle.NCMDestroyFn = destroyNearCacheFun
 	c.proxyManager = newProxyManager(proxyManagerServiceBundle)
-	c.cpSubsystem = icp.NewSubsystem(c.ic.SerializationService, c.ic.InvocationFactory, c.ic.InvocationService, &c.ic.Logger)
+	cpSessionManager := icp.NewSessionManager(c.ic.InvocationFactory, c.ic.InvocationService, &c.ic.Logger, c.ic.Name())
+	// CP sessions must be closed while the connections are still open.
+	c.ic.AddBeforeShutdownHandler(cpSessionManager.Shutdown)
+	c.cpSubsystem = icp.NewSubsystem(c.ic.SerializationService, c.ic.InvocationFactory, c.ic.InvocationService, &c.ic.Logger, cpSessionManager)
+	c.jetService = ijet.NewService(c.ic.Invoker, c.ic.ClusterService)
 	c.sqlService = isql.NewService(c.ic.ConnectionManager, c.ic.SerializationService, c.ic.Invoker, &c.ic.Logger)
 }
 
@@ -497,7 +628,11 @@ func (c *Client) getNearCacheManager(service string) *inearcache.Manager {
 	if !ok {
 		ris := c.cfg.NearCacheInvalidation.ReconciliationIntervalSeconds()
 		mis := c.cfg.NearCacheInvalidation.MaxToleratedMissCount()
-		mgr = inearcache.NewManager(c.ic, ris, mis)
+		if service == ServiceNameCache {
+			mgr = inearcache.NewCacheManager(c.ic, ris, mis)
+		} else {
+			mgr = inearcache.NewManager(c.ic, ris, mis)
+		}
 		c.nearCacheMgrs[service] = mgr
 	}
 	c.nearCacheMgrsMu.Unlock()
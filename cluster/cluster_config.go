@@ -47,26 +47,35 @@ type Config struct {
 	HeartbeatInterval types.Duration `json:",omitempty"`
 	// HeartbeatTimeout is the maximum time to wait for the response of a ping before closing the connection.
 	HeartbeatTimeout types.Duration `json:",omitempty"`
+	// BackupAckTimeout is the maximum time to wait for the backup acknowledgements of an invocation after its response is received.
+	// When the timeout is reached, the invocation is completed with the response.
+	// It is used only if smart routing is enabled, defaults to 5 seconds.
+	BackupAckTimeout types.Duration `json:",omitempty"`
 	// RedoOperation enables retrying some errors even when they are not retried by default.
 	RedoOperation bool `json:",omitempty"`
 	// Unisocket disables smart routing and enables unisocket mode of operation.
 	Unisocket bool `json:",omitempty"`
+	// DisableBackupAckToClient disables receiving the backup acknowledgements directly from the backup replicas.
+	// If disabled, the member which runs the operation waits for the backup acknowledgements before sending the response.
+	DisableBackupAckToClient bool `json:",omitempty"`
 }
 
 func (c *Config) Clone() Config {
 	return Config{
-		Name:               c.Name,
-		Unisocket:          c.Unisocket,
-		HeartbeatInterval:  c.HeartbeatInterval,
-		HeartbeatTimeout:   c.HeartbeatTimeout,
-		InvocationTimeout:  c.InvocationTimeout,
-		RedoOperation:      c.RedoOperation,
-		loadBalancer:       c.loadBalancer,
-		Security:           c.Security.Clone(),
-		Cloud:              c.Cloud.Clone(),
-		Discovery:          c.Discovery.Clone(),
-		ConnectionStrategy: c.ConnectionStrategy.Clone(),
-		Network:            c.Network.Clone(),
+		Name:                     c.Name,
+		Unisocket:                c.Unisocket,
+		HeartbeatInterval:        c.HeartbeatInterval,
+		HeartbeatTimeout:         c.HeartbeatTimeout,
+		InvocationTimeout:        c.InvocationTimeout,
+		RedoOperation:            c.RedoOperation,
+		BackupAckTimeout:         c.BackupAckTimeout,
+		DisableBackupAckToClient: c.DisableBackupAckToClient,
+		loadBalancer:             c.loadBalancer,
+		Security:                 c.Security.Clone(),
+		Cloud:                    c.Cloud.Clone(),
+		Discovery:                c.Discovery.Clone(),
+		ConnectionStrategy:       c.ConnectionStrategy.Clone(),
+		Network:                  c.Network.Clone(),
 	}
 }
 
@@ -86,6 +95,10 @@ func (c *Config) Validate() error {
 	if err != nil {
 		return err
 	}
+	err = check.EnsureNonNegativeDuration((*time.Duration)(&c.BackupAckTimeout), 5*time.Second, "invalid backup ack timeout")
+	if err != nil {
+		return err
+	}
 	if c.loadBalancer == nil {
 		c.loadBalancer = NewRoundRobinLoadBalancer()
 	}
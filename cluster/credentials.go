@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+)
+
+// Credentials authenticate the client to the cluster instead of the username and password.
+// The following credentials are supported: TokenCredentials, TokenProvider and CustomCredentials, or pointers to them.
+// See SecurityConfig.SetCredentials.
+type Credentials interface {
+	validateCredentials() error
+}
+
+// TokenCredentials authenticate the client with a static token.
+type TokenCredentials struct {
+	Token []byte
+}
+
+func (c TokenCredentials) validateCredentials() error {
+	if len(c.Token) == 0 {
+		return fmt.Errorf("token cannot be empty: %w", hzerrors.ErrInvalidConfiguration)
+	}
+	return nil
+}
+
+// TokenProvider returns a token to authenticate the client and the time it expires.
+// The token is reused for the following authentications, until it expires or the cluster rejects it.
+// If expiry is zero, the provider is called for each authentication, e.g., when the client reconnects.
+type TokenProvider func(ctx context.Context) (token []byte, expiry time.Time, err error)
+
+func (p TokenProvider) validateCredentials() error {
+	if p == nil {
+		return fmt.Errorf("token provider cannot be nil: %w", hzerrors.ErrInvalidConfiguration)
+	}
+	return nil
+}
+
+// CustomCredentials authenticate the client with an arbitrary value, which is serialized using the serialization service.
+// The value must be deserializable on the members, and it should be handled by a custom JAAS login module.
+type CustomCredentials struct {
+	Value interface{}
+}
+
+func (c CustomCredentials) validateCredentials() error {
+	if c.Value == nil {
+		return fmt.Errorf("custom credentials value cannot be nil: %w", hzerrors.ErrInvalidConfiguration)
+	}
+	return nil
+}
+
+// credentialsValue returns the credentials the given pointer credentials point to.
+// Other credentials are returned as is.
+func credentialsValue(credentials Credentials) (Credentials, error) {
+	switch c := credentials.(type) {
+	case TokenCredentials, TokenProvider, CustomCredentials:
+		return c, nil
+	case *TokenCredentials:
+		if c != nil {
+			return *c, nil
+		}
+	case *TokenProvider:
+		if c != nil {
+			return *c, nil
+		}
+	case *CustomCredentials:
+		if c != nil {
+			return *c, nil
+		}
+	default:
+		return nil, fmt.Errorf("unsupported credentials type %T: %w", credentials, hzerrors.ErrInvalidConfiguration)
+	}
+	return nil, fmt.Errorf("credentials cannot be a nil pointer: %w", hzerrors.ErrInvalidConfiguration)
+}
@@ -0,0 +1,160 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const defaultMemberPort = 5701
+
+// Resolver looks up DNS records.
+// *net.Resolver implements this interface.
+type Resolver interface {
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+	LookupSRV(ctx context.Context, service, proto, name string) (cname string, addrs []*net.SRV, err error)
+}
+
+// DNSStrategyConfig contains the configuration for the DNS discovery strategy.
+type DNSStrategyConfig struct {
+	// Resolver is used to look up the DNS records.
+	// Defaults to net.DefaultResolver.
+	Resolver Resolver `json:"-"`
+	// Name is the DNS name to look up.
+	// If SRV is true, it is the full name of the SRV record, such as "_hazelcast._tcp.example.com".
+	Name string
+	// Port is the port of the members, if SRV is false.
+	// Defaults to 5701.
+	Port int `json:",omitempty"`
+	// RefreshInterval is the duration the discovered members are cached for.
+	// If it is zero, the records are looked up every time the members are discovered.
+	RefreshInterval types.Duration `json:",omitempty"`
+	// SRV enables looking up SRV records, instead of A and AAAA records.
+	// The targets of the SRV records are resolved to their A and AAAA records, the ports are taken from the SRV records.
+	SRV bool `json:",omitempty"`
+}
+
+/*
+DNSStrategy discovers the members using DNS records.
+
+By default, the A and AAAA records of the configured name are looked up, and the configured port is used for all members.
+This is suitable for a headless Kubernetes service, or a DNS name with a record for each member:
+
+	strategy := discovery.NewDNSStrategy(discovery.DNSStrategyConfig{
+		Name: "hazelcast.default.svc.cluster.local",
+	})
+	config.Cluster.Discovery.Strategy = strategy
+
+If SRV is enabled, the SRV records of the configured name are looked up, which also contain the ports of the members.
+*/
+type DNSStrategy struct {
+	resolver  Resolver
+	mu        *sync.Mutex
+	updatedAt time.Time
+	nodes     []Node
+	config    DNSStrategyConfig
+}
+
+// NewDNSStrategy creates a DNSStrategy with the given configuration.
+func NewDNSStrategy(config DNSStrategyConfig) *DNSStrategy {
+	resolver := config.Resolver
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+	if config.Port == 0 {
+		config.Port = defaultMemberPort
+	}
+	return &DNSStrategy{
+		config:   config,
+		resolver: resolver,
+		mu:       &sync.Mutex{},
+	}
+}
+
+// Start validates the configuration.
+func (s *DNSStrategy) Start(ctx context.Context, opts StrategyOptions) error {
+	if s.config.Name == "" {
+		return fmt.Errorf("discovery.DNSStrategy: name is required")
+	}
+	if s.config.Port < 0 || s.config.Port > 65535 {
+		return fmt.Errorf("discovery.DNSStrategy: invalid port: %d", s.config.Port)
+	}
+	if s.config.RefreshInterval < 0 {
+		return fmt.Errorf("discovery.DNSStrategy: invalid refresh interval: %s", s.config.RefreshInterval)
+	}
+	return nil
+}
+
+// DiscoverNodes returns the members found in the DNS records.
+// The nodes contain only the private addresses.
+func (s *DNSStrategy) DiscoverNodes(ctx context.Context) ([]Node, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.nodes != nil && time.Since(s.updatedAt) < time.Duration(s.config.RefreshInterval) {
+		return s.nodes, nil
+	}
+	var nodes []Node
+	var err error
+	if s.config.SRV {
+		nodes, err = s.lookupSRV(ctx)
+	} else {
+		nodes, err = s.lookupIP(ctx, s.config.Name, s.config.Port)
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.nodes = nodes
+	s.updatedAt = time.Now()
+	return nodes, nil
+}
+
+func (s *DNSStrategy) lookupIP(ctx context.Context, host string, port int) ([]Node, error) {
+	addrs, err := s.resolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return nil, fmt.Errorf("discovery.DNSStrategy: looking up %s: %w", host, err)
+	}
+	nodes := make([]Node, 0, len(addrs))
+	for _, addr := range addrs {
+		nodes = append(nodes, Node{PrivateAddr: net.JoinHostPort(addr.IP.String(), strconv.Itoa(port))})
+	}
+	return nodes, nil
+}
+
+func (s *DNSStrategy) lookupSRV(ctx context.Context) ([]Node, error) {
+	// passing empty service and proto looks up the name directly
+	_, srvs, err := s.resolver.LookupSRV(ctx, "", "", s.config.Name)
+	if err != nil {
+		return nil, fmt.Errorf("discovery.DNSStrategy: looking up SRV records of %s: %w", s.config.Name, err)
+	}
+	var nodes []Node
+	for _, srv := range srvs {
+		ns, err := s.lookupIP(ctx, strings.TrimSuffix(srv.Target, "."), int(srv.Port))
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, ns...)
+	}
+	return nodes, nil
+}
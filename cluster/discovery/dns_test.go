@@ -0,0 +1,143 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package discovery_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hazelcast/hazelcast-go-client/cluster/discovery"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+func TestDNSStrategy_A(t *testing.T) {
+	resolver := &fakeResolver{
+		ips: map[string][]string{"hz.example.com": {"10.0.0.1", "fd00::1"}},
+	}
+	s := discovery.NewDNSStrategy(discovery.DNSStrategyConfig{Resolver: resolver, Name: "hz.example.com"})
+	require.NoError(t, s.Start(context.Background(), discovery.StrategyOptions{}))
+	nodes, err := s.DiscoverNodes(context.Background())
+	require.NoError(t, err)
+	target := []discovery.Node{
+		{PrivateAddr: "10.0.0.1:5701"},
+		{PrivateAddr: "[fd00::1]:5701"},
+	}
+	assert.Equal(t, target, nodes)
+}
+
+func TestDNSStrategy_SRV(t *testing.T) {
+	resolver := &fakeResolver{
+		ips: map[string][]string{
+			"member1.example.com": {"10.0.0.1"},
+			"member2.example.com": {"10.0.0.2"},
+		},
+		srvs: map[string][]*net.SRV{
+			"_hazelcast._tcp.example.com": {
+				{Target: "member1.example.com.", Port: 5701},
+				{Target: "member2.example.com.", Port: 5702},
+			},
+		},
+	}
+	s := discovery.NewDNSStrategy(discovery.DNSStrategyConfig{Resolver: resolver, Name: "_hazelcast._tcp.example.com", SRV: true})
+	require.NoError(t, s.Start(context.Background(), discovery.StrategyOptions{}))
+	nodes, err := s.DiscoverNodes(context.Background())
+	require.NoError(t, err)
+	target := []discovery.Node{
+		{PrivateAddr: "10.0.0.1:5701"},
+		{PrivateAddr: "10.0.0.2:5702"},
+	}
+	assert.Equal(t, target, nodes)
+}
+
+func TestDNSStrategy_RefreshInterval(t *testing.T) {
+	resolver := &fakeResolver{
+		ips: map[string][]string{"hz.example.com": {"10.0.0.1"}},
+	}
+	config := discovery.DNSStrategyConfig{
+		Resolver:        resolver,
+		Name:            "hz.example.com",
+		Port:            5702,
+		RefreshInterval: types.Duration(time.Hour),
+	}
+	s := discovery.NewDNSStrategy(config)
+	require.NoError(t, s.Start(context.Background(), discovery.StrategyOptions{}))
+	_, err := s.DiscoverNodes(context.Background())
+	require.NoError(t, err)
+	nodes, err := s.DiscoverNodes(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []discovery.Node{{PrivateAddr: "10.0.0.1:5702"}}, nodes)
+	assert.Equal(t, 1, resolver.lookups)
+}
+
+func TestDNSStrategy_LookupError(t *testing.T) {
+	s := discovery.NewDNSStrategy(discovery.DNSStrategyConfig{Resolver: &fakeResolver{}, Name: "hz.example.com"})
+	require.NoError(t, s.Start(context.Background(), discovery.StrategyOptions{}))
+	_, err := s.DiscoverNodes(context.Background())
+	require.Error(t, err)
+}
+
+func TestDNSStrategy_InvalidConfig(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config discovery.DNSStrategyConfig
+	}{
+		{name: "no name", config: discovery.DNSStrategyConfig{}},
+		{name: "invalid port", config: discovery.DNSStrategyConfig{Name: "hz", Port: 70000}},
+		{name: "negative refresh interval", config: discovery.DNSStrategyConfig{Name: "hz", RefreshInterval: types.Duration(-time.Second)}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := discovery.NewDNSStrategy(tc.config)
+			require.Error(t, s.Start(context.Background(), discovery.StrategyOptions{}))
+		})
+	}
+}
+
+var errNotFound = errors.New("not found")
+
+type fakeResolver struct {
+	ips     map[string][]string
+	srvs    map[string][]*net.SRV
+	lookups int
+}
+
+func (r *fakeResolver) LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error) {
+	r.lookups++
+	ips, ok := r.ips[host]
+	if !ok {
+		return nil, errNotFound
+	}
+	addrs := make([]net.IPAddr, len(ips))
+	for i, ip := range ips {
+		addrs[i] = net.IPAddr{IP: net.ParseIP(ip)}
+	}
+	return addrs, nil
+}
+
+func (r *fakeResolver) LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error) {
+	srvs, ok := r.srvs[name]
+	if !ok {
+		return "", nil, errNotFound
+	}
+	return name, srvs, nil
+}
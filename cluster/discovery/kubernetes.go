@@ -0,0 +1,379 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package discovery
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/rest"
+	"github.com/hazelcast/hazelcast-go-client/logger"
+)
+
+const (
+	kubernetesServiceAccountDir = "/var/run/secrets/kubernetes.io/serviceaccount"
+	kubernetesDefaultAPIServer  = "https://kubernetes.default.svc"
+	kubernetesDefaultNamespace  = "default"
+)
+
+// KubernetesStrategyConfig contains the configuration for the Kubernetes discovery strategy.
+// The defaults are suitable for a client running in the same Kubernetes cluster as the members.
+type KubernetesStrategyConfig struct {
+	// HTTPClient is used to send the requests to the API server.
+	// Defaults to a client which trusts the CA certificate in CACertPath.
+	HTTPClient *http.Client `json:"-"`
+	// APIServer is the URL of the Kubernetes API server.
+	// Defaults to the URL in KUBERNETES_SERVICE_HOST and KUBERNETES_SERVICE_PORT environment variables, or https://kubernetes.default.svc.
+	APIServer string `json:",omitempty"`
+	// Token is the bearer token to authenticate to the API server.
+	// If it is not set, the token is read from TokenPath.
+	Token string `json:",omitempty"`
+	// TokenPath is the path of the bearer token file.
+	// The file is read again for every request, so rotated tokens, such as projected service account tokens, are used once they are updated.
+	// Defaults to the service account token file.
+	TokenPath string `json:",omitempty"`
+	// CACertPath is the path of the CA certificate of the API server.
+	// Defaults to the service account CA certificate file.
+	CACertPath string `json:",omitempty"`
+	// Namespace is the namespace of the members.
+	// Defaults to the namespace of the service account, or "default".
+	Namespace string `json:",omitempty"`
+	// ServiceName is the name of the service of the members.
+	// Either ServiceName or ServiceLabelSelector is required.
+	ServiceName string `json:",omitempty"`
+	// ServiceLabelSelector is the label selector of the services of the members, such as "app=hazelcast".
+	ServiceLabelSelector string `json:",omitempty"`
+	// ServicePortName is the name of the port of the members in the service.
+	// Defaults to the first port of the service.
+	ServicePortName string `json:",omitempty"`
+}
+
+/*
+KubernetesStrategy discovers the members using the endpoints of a Kubernetes service, which are listed through the Kubernetes API server.
+The service account of the client must have the permission to get and list endpoints, and get services and nodes if public addresses are used.
+
+	strategy := discovery.NewKubernetesStrategy(discovery.KubernetesStrategyConfig{
+		ServiceName: "hazelcast",
+	})
+	config.Cluster.Discovery.Strategy = strategy
+
+The private addresses of the nodes are the pod addresses.
+If the client uses public addresses, the public address of a member is looked up from a service which exposes only that member, e.g., a service per pod.
+The external address of a LoadBalancer service is used, or the external address of the Kubernetes node with the node port of a NodePort service.
+*/
+type KubernetesStrategy struct {
+	httpClient *rest.HTTPClient
+	logger     logger.Logger
+	config     KubernetesStrategyConfig
+	publicIP   bool
+}
+
+// NewKubernetesStrategy creates a KubernetesStrategy with the given configuration.
+func NewKubernetesStrategy(config KubernetesStrategyConfig) *KubernetesStrategy {
+	return &KubernetesStrategy{config: config}
+}
+
+// Start loads the service account credentials and validates the configuration.
+func (s *KubernetesStrategy) Start(ctx context.Context, opts StrategyOptions) error {
+	cfg := s.config
+	if cfg.ServiceName == "" && cfg.ServiceLabelSelector == "" {
+		return fmt.Errorf("discovery.KubernetesStrategy: service name or service label selector is required")
+	}
+	if cfg.APIServer == "" {
+		cfg.APIServer = kubernetesAPIServerFromEnv()
+	}
+	cfg.APIServer = strings.TrimRight(cfg.APIServer, "/")
+	if cfg.Token == "" {
+		if cfg.TokenPath == "" {
+			cfg.TokenPath = kubernetesServiceAccountDir + "/token"
+		}
+		// the token file is read early, so a missing token fails the start
+		if _, err := readKubernetesToken(cfg.TokenPath); err != nil {
+			return err
+		}
+	}
+	if cfg.Namespace == "" {
+		cfg.Namespace = kubernetesDefaultNamespace
+		if b, err := os.ReadFile(kubernetesServiceAccountDir + "/namespace"); err == nil {
+			cfg.Namespace = strings.TrimSpace(string(b))
+		}
+	}
+	if cfg.HTTPClient == nil {
+		if cfg.CACertPath == "" {
+			cfg.CACertPath = kubernetesServiceAccountDir + "/ca.crt"
+		}
+		hc, err := newKubernetesHTTPClient(cfg.CACertPath)
+		if err != nil {
+			return err
+		}
+		cfg.HTTPClient = hc
+	}
+	s.config = cfg
+	s.httpClient = rest.NewHTTPClientWith(cfg.HTTPClient)
+	s.logger = opts.Logger
+	s.publicIP = opts.UsePublicIP
+	return nil
+}
+
+// DiscoverNodes returns the ready endpoints of the service.
+func (s *KubernetesStrategy) DiscoverNodes(ctx context.Context) ([]Node, error) {
+	endpoints, err := s.memberEndpoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var all []kubernetesEndpoints
+	if s.publicIP {
+		var list kubernetesEndpointsList
+		if err := s.get(ctx, s.namespacePath("endpoints"), &list); err != nil {
+			return nil, err
+		}
+		all = list.Items
+	}
+	var nodes []Node
+	for _, ep := range endpoints {
+		for _, subset := range ep.Subsets {
+			port, ok := subset.port(s.config.ServicePortName)
+			if !ok {
+				continue
+			}
+			for _, addr := range subset.Addresses {
+				node := Node{PrivateAddr: net.JoinHostPort(addr.IP, strconv.Itoa(port))}
+				if s.publicIP {
+					if node.PublicAddr, err = s.publicAddr(ctx, all, addr); err != nil {
+						return nil, err
+					}
+				}
+				nodes = append(nodes, node)
+			}
+		}
+	}
+	return nodes, nil
+}
+
+func (s *KubernetesStrategy) memberEndpoints(ctx context.Context) ([]kubernetesEndpoints, error) {
+	if s.config.ServiceName != "" {
+		var ep kubernetesEndpoints
+		if err := s.get(ctx, s.namespacePath("endpoints/"+url.PathEscape(s.config.ServiceName)), &ep); err != nil {
+			return nil, err
+		}
+		return []kubernetesEndpoints{ep}, nil
+	}
+	path := s.namespacePath("endpoints") + "?labelSelector=" + url.QueryEscape(s.config.ServiceLabelSelector)
+	var list kubernetesEndpointsList
+	if err := s.get(ctx, path, &list); err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// publicAddr returns the public address of the member with the given address.
+// It returns an empty string if the member is not exposed by a LoadBalancer or NodePort service.
+func (s *KubernetesStrategy) publicAddr(ctx context.Context, all []kubernetesEndpoints, addr kubernetesEndpointAddress) (string, error) {
+	for _, ep := range all {
+		if !ep.exposesOnly(addr.IP) {
+			continue
+		}
+		var svc kubernetesService
+		if err := s.get(ctx, s.namespacePath("services/"+url.PathEscape(ep.Metadata.Name)), &svc); err != nil {
+			return "", err
+		}
+		if len(svc.Spec.Ports) == 0 {
+			continue
+		}
+		switch svc.Spec.Type {
+		case "LoadBalancer":
+			for _, ingress := range svc.Status.LoadBalancer.Ingress {
+				host := ingress.IP
+				if host == "" {
+					host = ingress.Hostname
+				}
+				if host != "" {
+					return net.JoinHostPort(host, strconv.Itoa(svc.Spec.Ports[0].Port)), nil
+				}
+			}
+		case "NodePort":
+			if addr.NodeName == nil {
+				continue
+			}
+			var node kubernetesNode
+			if err := s.get(ctx, "/api/v1/nodes/"+url.PathEscape(*addr.NodeName), &node); err != nil {
+				return "", err
+			}
+			if ip := node.externalIP(); ip != "" {
+				return net.JoinHostPort(ip, strconv.Itoa(svc.Spec.Ports[0].NodePort)), nil
+			}
+		}
+	}
+	s.log(logger.WeightWarn, func() string {
+		return fmt.Sprintf("discovery.KubernetesStrategy: cannot find the public address of %s", addr.IP)
+	})
+	return "", nil
+}
+
+func (s *KubernetesStrategy) namespacePath(resource string) string {
+	return fmt.Sprintf("/api/v1/namespaces/%s/%s", url.PathEscape(s.config.Namespace), resource)
+}
+
+func (s *KubernetesStrategy) get(ctx context.Context, path string, v interface{}) error {
+	token, err := s.token()
+	if err != nil {
+		return err
+	}
+	b, err := s.httpClient.Get(ctx, s.config.APIServer+path, rest.NewHTTPHeader("Authorization", "Bearer "+token))
+	if err != nil {
+		return fmt.Errorf("discovery.KubernetesStrategy: getting %s: %w", path, err)
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return fmt.Errorf("discovery.KubernetesStrategy: decoding %s: %w", path, err)
+	}
+	return nil
+}
+
+// token returns the configured token, or the current contents of the token file.
+func (s *KubernetesStrategy) token() (string, error) {
+	if s.config.Token != "" {
+		return s.config.Token, nil
+	}
+	return readKubernetesToken(s.config.TokenPath)
+}
+
+func (s *KubernetesStrategy) log(weight logger.Weight, f func() string) {
+	if s.logger != nil {
+		s.logger.Log(weight, f)
+	}
+}
+
+func kubernetesAPIServerFromEnv() string {
+	host := os.Getenv("KUBERNETES_SERVICE_HOST")
+	port := os.Getenv("KUBERNETES_SERVICE_PORT")
+	if host == "" || port == "" {
+		return kubernetesDefaultAPIServer
+	}
+	return "https://" + net.JoinHostPort(host, port)
+}
+
+func readKubernetesToken(path string) (string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("discovery.KubernetesStrategy: reading the service account token: %w", err)
+	}
+	return strings.TrimSpace(string(b)), nil
+}
+
+func newKubernetesHTTPClient(caCertPath string) (*http.Client, error) {
+	caCert, err := os.ReadFile(caCertPath)
+	if err != nil {
+		return nil, fmt.Errorf("discovery.KubernetesStrategy: reading the CA certificate: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caCert) {
+		return nil, fmt.Errorf("discovery.KubernetesStrategy: invalid CA certificate: %s", caCertPath)
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+	return &http.Client{Transport: transport}, nil
+}
+
+// The types below contain the subset of the Kubernetes API objects used by the strategy.
+
+type kubernetesEndpointsList struct {
+	Items []kubernetesEndpoints `json:"items"`
+}
+
+type kubernetesEndpoints struct {
+	Metadata struct {
+		Name string `json:"name"`
+	} `json:"metadata"`
+	Subsets []kubernetesEndpointSubset `json:"subsets"`
+}
+
+// exposesOnly returns true if the given IP is the only address of the endpoints.
+func (e kubernetesEndpoints) exposesOnly(ip string) bool {
+	if len(e.Subsets) != 1 || len(e.Subsets[0].Addresses) != 1 {
+		return false
+	}
+	return e.Subsets[0].Addresses[0].IP == ip
+}
+
+type kubernetesEndpointSubset struct {
+	Addresses []kubernetesEndpointAddress `json:"addresses"`
+	Ports     []kubernetesEndpointPort    `json:"ports"`
+}
+
+func (s kubernetesEndpointSubset) port(name string) (int, bool) {
+	for _, p := range s.Ports {
+		if name == "" || p.Name == name {
+			return p.Port, true
+		}
+	}
+	return 0, false
+}
+
+type kubernetesEndpointAddress struct {
+	NodeName *string `json:"nodeName"`
+	IP       string  `json:"ip"`
+}
+
+type kubernetesEndpointPort struct {
+	Name string `json:"name"`
+	Port int    `json:"port"`
+}
+
+type kubernetesService struct {
+	Spec struct {
+		Type  string `json:"type"`
+		Ports []struct {
+			Port     int `json:"port"`
+			NodePort int `json:"nodePort"`
+		} `json:"ports"`
+	} `json:"spec"`
+	Status struct {
+		LoadBalancer struct {
+			Ingress []struct {
+				IP       string `json:"ip"`
+				Hostname string `json:"hostname"`
+			} `json:"ingress"`
+		} `json:"loadBalancer"`
+	} `json:"status"`
+}
+
+type kubernetesNode struct {
+	Status struct {
+		Addresses []struct {
+			Type    string `json:"type"`
+			Address string `json:"address"`
+		} `json:"addresses"`
+	} `json:"status"`
+}
+
+func (n kubernetesNode) externalIP() string {
+	for _, addr := range n.Status.Addresses {
+		if addr.Type == "ExternalIP" {
+			return addr.Address
+		}
+	}
+	return ""
+}
@@ -0,0 +1,199 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package discovery_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hazelcast/hazelcast-go-client/cluster/discovery"
+)
+
+const (
+	k8sMemberEndpoints = `{
+		"metadata": {"name": "hazelcast"},
+		"subsets": [{
+			"addresses": [{"ip": "10.0.0.1", "nodeName": "node1"}, {"ip": "10.0.0.2", "nodeName": "node2"}],
+			"ports": [{"name": "metrics", "port": 8080}, {"name": "hazelcast", "port": 5701}]
+		}]
+	}`
+	k8sAllEndpoints = `{"items": [
+		` + k8sMemberEndpoints + `,
+		{"metadata": {"name": "hazelcast-0"}, "subsets": [{"addresses": [{"ip": "10.0.0.1"}], "ports": [{"port": 5701}]}]},
+		{"metadata": {"name": "hazelcast-1"}, "subsets": [{"addresses": [{"ip": "10.0.0.2"}], "ports": [{"port": 5701}]}]}
+	]}`
+	k8sLoadBalancerService = `{
+		"spec": {"type": "LoadBalancer", "ports": [{"port": 5701, "nodePort": 31001}]},
+		"status": {"loadBalancer": {"ingress": [{"ip": "35.0.0.1"}]}}
+	}`
+	k8sNodePortService = `{"spec": {"type": "NodePort", "ports": [{"port": 5701, "nodePort": 31002}]}}`
+	k8sNode2           = `{"status": {"addresses": [{"type": "InternalIP", "address": "192.168.0.2"}, {"type": "ExternalIP", "address": "35.0.0.2"}]}}`
+)
+
+func TestKubernetesStrategy_ServiceName(t *testing.T) {
+	srv := newKubernetesTestServer(t, map[string]string{
+		"/api/v1/namespaces/hz/endpoints/hazelcast": k8sMemberEndpoints,
+	})
+	defer srv.Close()
+	s := discovery.NewKubernetesStrategy(discovery.KubernetesStrategyConfig{
+		HTTPClient:      srv.Client(),
+		APIServer:       srv.URL,
+		Token:           "secret",
+		Namespace:       "hz",
+		ServiceName:     "hazelcast",
+		ServicePortName: "hazelcast",
+	})
+	require.NoError(t, s.Start(context.Background(), discovery.StrategyOptions{}))
+	nodes, err := s.DiscoverNodes(context.Background())
+	require.NoError(t, err)
+	target := []discovery.Node{
+		{PrivateAddr: "10.0.0.1:5701"},
+		{PrivateAddr: "10.0.0.2:5701"},
+	}
+	assert.Equal(t, target, nodes)
+}
+
+func TestKubernetesStrategy_LabelSelector(t *testing.T) {
+	srv := newKubernetesTestServer(t, map[string]string{
+		"/api/v1/namespaces/hz/endpoints?labelSelector=app%3Dhazelcast": `{"items": [` + k8sMemberEndpoints + `]}`,
+	})
+	defer srv.Close()
+	s := discovery.NewKubernetesStrategy(discovery.KubernetesStrategyConfig{
+		HTTPClient:           srv.Client(),
+		APIServer:            srv.URL,
+		Token:                "secret",
+		Namespace:            "hz",
+		ServiceLabelSelector: "app=hazelcast",
+	})
+	require.NoError(t, s.Start(context.Background(), discovery.StrategyOptions{}))
+	nodes, err := s.DiscoverNodes(context.Background())
+	require.NoError(t, err)
+	// the first port is used if the port name is not set
+	target := []discovery.Node{
+		{PrivateAddr: "10.0.0.1:8080"},
+		{PrivateAddr: "10.0.0.2:8080"},
+	}
+	assert.Equal(t, target, nodes)
+}
+
+func TestKubernetesStrategy_PublicAddresses(t *testing.T) {
+	srv := newKubernetesTestServer(t, map[string]string{
+		"/api/v1/namespaces/hz/endpoints/hazelcast":  k8sMemberEndpoints,
+		"/api/v1/namespaces/hz/endpoints":            k8sAllEndpoints,
+		"/api/v1/namespaces/hz/services/hazelcast-0": k8sLoadBalancerService,
+		"/api/v1/namespaces/hz/services/hazelcast-1": k8sNodePortService,
+		"/api/v1/nodes/node2":                        k8sNode2,
+	})
+	defer srv.Close()
+	s := discovery.NewKubernetesStrategy(discovery.KubernetesStrategyConfig{
+		HTTPClient:      srv.Client(),
+		APIServer:       srv.URL,
+		Token:           "secret",
+		Namespace:       "hz",
+		ServiceName:     "hazelcast",
+		ServicePortName: "hazelcast",
+	})
+	require.NoError(t, s.Start(context.Background(), discovery.StrategyOptions{UsePublicIP: true}))
+	nodes, err := s.DiscoverNodes(context.Background())
+	require.NoError(t, err)
+	target := []discovery.Node{
+		{PrivateAddr: "10.0.0.1:5701", PublicAddr: "35.0.0.1:5701"},
+		{PrivateAddr: "10.0.0.2:5701", PublicAddr: "35.0.0.2:31002"},
+	}
+	assert.Equal(t, target, nodes)
+}
+
+func TestKubernetesStrategy_Unauthorized(t *testing.T) {
+	srv := newKubernetesTestServer(t, map[string]string{
+		"/api/v1/namespaces/hz/endpoints/hazelcast": k8sMemberEndpoints,
+	})
+	defer srv.Close()
+	s := discovery.NewKubernetesStrategy(discovery.KubernetesStrategyConfig{
+		HTTPClient:  srv.Client(),
+		APIServer:   srv.URL,
+		Token:       "wrong",
+		Namespace:   "hz",
+		ServiceName: "hazelcast",
+	})
+	require.NoError(t, s.Start(context.Background(), discovery.StrategyOptions{}))
+	_, err := s.DiscoverNodes(context.Background())
+	require.Error(t, err)
+}
+
+func TestKubernetesStrategy_TokenRotation(t *testing.T) {
+	srv := newKubernetesTestServer(t, map[string]string{
+		"/api/v1/namespaces/hz/endpoints/hazelcast": k8sMemberEndpoints,
+	})
+	defer srv.Close()
+	tokenPath := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenPath, []byte("secret\n"), 0600))
+	s := discovery.NewKubernetesStrategy(discovery.KubernetesStrategyConfig{
+		HTTPClient:  srv.Client(),
+		APIServer:   srv.URL,
+		TokenPath:   tokenPath,
+		Namespace:   "hz",
+		ServiceName: "hazelcast",
+	})
+	require.NoError(t, s.Start(context.Background(), discovery.StrategyOptions{}))
+	_, err := s.DiscoverNodes(context.Background())
+	require.NoError(t, err)
+	// the rotated token is used for the next request
+	require.NoError(t, os.WriteFile(tokenPath, []byte("expired\n"), 0600))
+	_, err = s.DiscoverNodes(context.Background())
+	require.Error(t, err)
+	require.NoError(t, os.WriteFile(tokenPath, []byte("secret\n"), 0600))
+	_, err = s.DiscoverNodes(context.Background())
+	require.NoError(t, err)
+}
+
+func TestKubernetesStrategy_NoTokenFile(t *testing.T) {
+	s := discovery.NewKubernetesStrategy(discovery.KubernetesStrategyConfig{
+		TokenPath:   filepath.Join(t.TempDir(), "token"),
+		ServiceName: "hazelcast",
+	})
+	require.Error(t, s.Start(context.Background(), discovery.StrategyOptions{}))
+}
+
+func TestKubernetesStrategy_NoService(t *testing.T) {
+	s := discovery.NewKubernetesStrategy(discovery.KubernetesStrategyConfig{Token: "secret"})
+	require.Error(t, s.Start(context.Background(), discovery.StrategyOptions{}))
+}
+
+// newKubernetesTestServer creates a stand-in for the Kubernetes API server which serves the given responses.
+func newKubernetesTestServer(t *testing.T, responses map[string]string) *httptest.Server {
+	return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, ok := responses[r.URL.RequestURI()]
+		if !ok {
+			t.Logf("unexpected request: %s", r.URL.RequestURI())
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+}
@@ -39,6 +39,20 @@ Use config.Cluster.SetLoadBalancer to set the load balancer:
 	config := hazelcast.Config{}
 	config.Cluster.SetLoadBalancer(cluster.NewRandomLoadBalancer())
 
+A load balancer which implements MemberAwareLoadBalancer selects among the members of the connections instead of their addresses,
+so it can take the member attributes, versions and the lite member flag into account.
+The following member aware load balancers are built-in:
+
+  - ZoneAwareLoadBalancer prefers the members whose zone attribute matches one of the client labels.
+  - LeastOutstandingInvocationsLoadBalancer selects the member with the fewest invocations waiting for a response.
+  - WeightedRandomLoadBalancer selects a random member with a probability proportional to its weight.
+
+For instance, the following keeps the non-key-based operations in the zone of the client, if there are members in the same zone:
+
+	config := hazelcast.Config{}
+	config.SetLabels("us-east-1a")
+	config.Cluster.SetLoadBalancer(cluster.NewZoneAwareLoadBalancer("zone"))
+
 # Hazelcast Cloud Discovery
 
 Hazelcast Go client can discover and connect to Hazelcast clusters running on Hazelcast Cloud https://cloud.hazelcast.com.
@@ -75,6 +89,39 @@ You can enable this feature by setting config.Discovery.UsePublicIP to true and
 
 For more details on member-side configuration, refer to the Discovery SPI section in the Hazelcast IMDG Reference Manual.
 
+# DNS and Kubernetes Discovery
+
+The members can be discovered using DNS records with discovery.DNSStrategy.
+The A and AAAA records of the given name are looked up by default, set SRV to true to look up SRV records instead:
+
+	config := hazelcast.Config{}
+	config.Cluster.Discovery.Strategy = discovery.NewDNSStrategy(discovery.DNSStrategyConfig{
+		Name:            "hazelcast.default.svc.cluster.local",
+		RefreshInterval: types.Duration(30 * time.Second),
+	})
+
+The endpoints of a Kubernetes service can be listed through the Kubernetes API server with discovery.KubernetesStrategy.
+When the client runs in the Kubernetes cluster, the service account token, CA certificate and namespace are used by default:
+
+	config := hazelcast.Config{}
+	config.Cluster.Discovery.Strategy = discovery.NewKubernetesStrategy(discovery.KubernetesStrategyConfig{
+		ServiceName: "hazelcast",
+	})
+
+If config.Cluster.Discovery.UsePublicIP is true, the public address of each member is taken from a LoadBalancer or NodePort service which exposes only that member.
+
+# Backup Acknowledgements
+
+When smart routing is enabled, the backup replicas send the acknowledgements of the synchronous backups directly to the client,
+instead of to the member which runs the operation.
+That saves a network hop for the operations with backups, such as Map.Put.
+The invocation is completed when all of its backups are acknowledged, or config.Cluster.BackupAckTimeout passes after the response is received:
+
+	config := hazelcast.Config{}
+	config.Cluster.BackupAckTimeout = types.Duration(3 * time.Second)
+
+Set config.Cluster.DisableBackupAckToClient to true to let the member wait for the backups before sending the response.
+
 # Client Connection Strategy
 
 You can configure how the client reconnects to the cluster after a disconnection by setting config.Cluster.ConnectionStrategy.ReconnectMode.
@@ -155,5 +202,34 @@ If the private key is encrypted using a password, third argument will be used to
 	var config hazelcast.Config
 	_ := config.Cluster.Network.SSL.AddClientCertAndEncryptedKeyPath("/path/of/cert.pem", "path/of/key.pem", "password")
 	client, _ := hazelcast.StartNewClientWithConfig(ctx, config)
+
+The CA and client certificates are loaded once by default.
+If the certificates are rotated, set SSLConfig.CertificateReloadInterval to check the certificate files for changes periodically.
+The reloaded certificates are used for the new connections.
+A client certificate which expires before the next check is reloaded even if its file has not changed.
+Set SSLConfig.RecycleConnections to replace the existing connections with connections which use the reloaded certificates, one connection at a time.
+A connection is closed after its replacement is opened and its invocations are completed.
+
+	config.Cluster.Network.SSL.CertificateReloadInterval = types.Duration(time.Minute)
+	config.Cluster.Network.SSL.RecycleConnections = true
+
+Alternatively, set tls.Config.GetClientCertificate using SSLConfig.SetTLSConfig, which is called for each new connection.
+
+# Authentication
+
+By default, the client authenticates with the username and password in config.Cluster.Security.Credentials.
+Other credentials can be set using config.Cluster.Security.SetCredentials, which requires Hazelcast Enterprise:
+
+  - TokenCredentials authenticates with a static token.
+  - TokenProvider authenticates with a token returned from a function, which is called again when the token expires or is rejected.
+  - CustomCredentials authenticates with an arbitrary value, which is serialized and passed to the JAAS login modules on the members.
+
+For example:
+
+	var config hazelcast.Config
+	config.Cluster.Security.SetCredentials(cluster.TokenProvider(func(ctx context.Context) ([]byte, time.Time, error) {
+		// fetch a short-lived token from the identity service
+		return token, expiry, nil
+	}))
 */
 package cluster
@@ -0,0 +1,227 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cluster
+
+import (
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+// MemberAwareLoadBalancer is a LoadBalancer which selects the next member using the member information, such as attributes, version or the lite member flag.
+// If the load balancer of the client implements this interface, OneOfMembers is used instead of OneOf when the members of the connections are known.
+type MemberAwareLoadBalancer interface {
+	LoadBalancer
+	// OneOfMembers returns one of the given members.
+	// members contains at least one item, and only the members the client is connected to.
+	// members are sorted by their UUIDs.
+	// Assume access to this function is synchronized.
+	// This function should return as soon as possible, should never block.
+	OneOfMembers(members []MemberInfo) MemberInfo
+}
+
+// LoadBalancerOptions are passed to the LoadBalancerStarter's Start method.
+type LoadBalancerOptions struct {
+	// OutstandingInvocations returns the number of invocations sent to the member with the given UUID which are not completed yet.
+	OutstandingInvocations func(member types.UUID) int
+	// Labels are the labels of the client.
+	Labels []string
+}
+
+// LoadBalancerStarter is an optional interface for a load balancer.
+// If implemented, Start is called once when the client is created, before the load balancer is used.
+type LoadBalancerStarter interface {
+	Start(opts LoadBalancerOptions)
+}
+
+/*
+ZoneAwareLoadBalancer prefers the members in the same zone as the client.
+A member is in the same zone if the value of its zone attribute is one of the client labels.
+The preferred members are selected in order, other members are used only if there are no preferred members.
+
+Assuming the members have the "zone" attribute set, the client in zone "us-east-1a" can be configured as follows:
+
+	config := hazelcast.Config{}
+	config.SetLabels("us-east-1a")
+	config.Cluster.SetLoadBalancer(cluster.NewZoneAwareLoadBalancer("zone"))
+
+Note that only non-keyed operations are load balanced, keyed operations are always sent to the owner of the key if smart routing is enabled.
+*/
+type ZoneAwareLoadBalancer struct {
+	labels    map[string]struct{}
+	attribute string
+	index     int
+}
+
+// NewZoneAwareLoadBalancer creates a ZoneAwareLoadBalancer which uses the given member attribute as the zone, such as "zone" or "rack".
+func NewZoneAwareLoadBalancer(attribute string) *ZoneAwareLoadBalancer {
+	return &ZoneAwareLoadBalancer{attribute: attribute}
+}
+
+// Start stores the client labels.
+func (lb *ZoneAwareLoadBalancer) Start(opts LoadBalancerOptions) {
+	lb.labels = make(map[string]struct{}, len(opts.Labels))
+	for _, label := range opts.Labels {
+		lb.labels[label] = struct{}{}
+	}
+}
+
+// OneOf selects the next address in order from the given address list.
+func (lb *ZoneAwareLoadBalancer) OneOf(addrs []Address) Address {
+	return addrs[nextIndex(&lb.index, len(addrs))]
+}
+
+// OneOfMembers selects the next member in the same zone as the client in order.
+// If there are no members in the same zone, the next member is selected in order from all members.
+func (lb *ZoneAwareLoadBalancer) OneOfMembers(members []MemberInfo) MemberInfo {
+	var preferred []MemberInfo
+	for _, member := range members {
+		if lb.sameZone(member) {
+			preferred = append(preferred, member)
+		}
+	}
+	if len(preferred) == 0 {
+		preferred = members
+	}
+	return preferred[nextIndex(&lb.index, len(preferred))]
+}
+
+func (lb *ZoneAwareLoadBalancer) sameZone(member MemberInfo) bool {
+	zone, ok := member.Attributes[lb.attribute]
+	if !ok {
+		return false
+	}
+	_, ok = lb.labels[zone]
+	return ok
+}
+
+// LeastOutstandingInvocationsLoadBalancer selects the member with the fewest invocations waiting for a response.
+// Ties are broken in order.
+type LeastOutstandingInvocationsLoadBalancer struct {
+	outstanding func(member types.UUID) int
+	index       int
+}
+
+// NewLeastOutstandingInvocationsLoadBalancer creates a LeastOutstandingInvocationsLoadBalancer.
+func NewLeastOutstandingInvocationsLoadBalancer() *LeastOutstandingInvocationsLoadBalancer {
+	return &LeastOutstandingInvocationsLoadBalancer{}
+}
+
+// Start stores the function which returns the number of outstanding invocations.
+func (lb *LeastOutstandingInvocationsLoadBalancer) Start(opts LoadBalancerOptions) {
+	lb.outstanding = opts.OutstandingInvocations
+}
+
+// OneOf selects the next address in order from the given address list.
+func (lb *LeastOutstandingInvocationsLoadBalancer) OneOf(addrs []Address) Address {
+	return addrs[nextIndex(&lb.index, len(addrs))]
+}
+
+// OneOfMembers selects the member with the fewest outstanding invocations.
+func (lb *LeastOutstandingInvocationsLoadBalancer) OneOfMembers(members []MemberInfo) MemberInfo {
+	start := nextIndex(&lb.index, len(members))
+	if lb.outstanding == nil {
+		return members[start]
+	}
+	best := start
+	bestCount := lb.outstanding(members[start].UUID)
+	for i := 1; i < len(members) && bestCount > 0; i++ {
+		j := (start + i) % len(members)
+		if count := lb.outstanding(members[j].UUID); count < bestCount {
+			best, bestCount = j, count
+		}
+	}
+	return members[best]
+}
+
+// WeightedRandomLoadBalancer selects a random member, the probability of selecting a member is proportional to its weight.
+// Members with zero or negative weight are not selected, unless no member has a positive weight.
+type WeightedRandomLoadBalancer struct {
+	rand   *rand.Rand
+	weight func(member MemberInfo) float64
+}
+
+// NewWeightedRandomLoadBalancer creates a WeightedRandomLoadBalancer which uses the given function to compute the weight of a member.
+func NewWeightedRandomLoadBalancer(weight func(member MemberInfo) float64) *WeightedRandomLoadBalancer {
+	return &WeightedRandomLoadBalancer{
+		rand:   rand.New(rand.NewSource(time.Now().UnixNano())),
+		weight: weight,
+	}
+}
+
+// AttributeWeight returns a weight function which reads the weight of a member from the given attribute.
+// defaultWeight is used if the attribute is not set or is not a number.
+func AttributeWeight(attribute string, defaultWeight float64) func(member MemberInfo) float64 {
+	return func(member MemberInfo) float64 {
+		v, ok := member.Attributes[attribute]
+		if !ok {
+			return defaultWeight
+		}
+		w, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return defaultWeight
+		}
+		return w
+	}
+}
+
+// OneOf selects a random address from the given list and returns it.
+func (lb *WeightedRandomLoadBalancer) OneOf(addrs []Address) Address {
+	return addrs[lb.rand.Intn(len(addrs))]
+}
+
+// OneOfMembers selects a random member using the member weights.
+func (lb *WeightedRandomLoadBalancer) OneOfMembers(members []MemberInfo) MemberInfo {
+	weights := make([]float64, len(members))
+	var total float64
+	for i, member := range members {
+		if w := lb.weight(member); w > 0 {
+			weights[i] = w
+			total += w
+		}
+	}
+	if total == 0 {
+		return members[lb.rand.Intn(len(members))]
+	}
+	r := lb.rand.Float64() * total
+	for i, w := range weights {
+		if r < w {
+			return members[i]
+		}
+		r -= w
+	}
+	// rounding errors may cause r to be slightly larger than the total weight
+	for i := len(weights) - 1; i >= 0; i-- {
+		if weights[i] > 0 {
+			return members[i]
+		}
+	}
+	return members[0]
+}
+
+// nextIndex returns the index to use for n items and advances it.
+func nextIndex(index *int, n int) int {
+	i := *index
+	if i >= n {
+		// some of the items were removed since the last call
+		i = 0
+	}
+	*index = (i + 1) % n
+	return i
+}
@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cluster_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hazelcast/hazelcast-go-client/cluster"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+func TestZoneAwareLoadBalancer_OneOfMembers(t *testing.T) {
+	members := []cluster.MemberInfo{
+		{Address: "a:5701", Attributes: map[string]string{"zone": "us-east-1a"}},
+		{Address: "b:5701", Attributes: map[string]string{"zone": "us-east-1b"}},
+		{Address: "c:5701", Attributes: map[string]string{"zone": "us-east-1a"}},
+		{Address: "d:5701"},
+	}
+	lb := cluster.NewZoneAwareLoadBalancer("zone")
+	lb.Start(cluster.LoadBalancerOptions{Labels: []string{"backend", "us-east-1a"}})
+	var addrs []cluster.Address
+	for i := 0; i < 4; i++ {
+		addrs = append(addrs, lb.OneOfMembers(members).Address)
+	}
+	assert.Equal(t, []cluster.Address{"a:5701", "c:5701", "a:5701", "c:5701"}, addrs)
+}
+
+func TestZoneAwareLoadBalancer_OneOfMembers_NoMembersInZone(t *testing.T) {
+	members := []cluster.MemberInfo{
+		{Address: "a:5701", Attributes: map[string]string{"zone": "us-east-1b"}},
+		{Address: "b:5701"},
+	}
+	lb := cluster.NewZoneAwareLoadBalancer("zone")
+	lb.Start(cluster.LoadBalancerOptions{Labels: []string{"us-east-1a"}})
+	var addrs []cluster.Address
+	for i := 0; i < 3; i++ {
+		addrs = append(addrs, lb.OneOfMembers(members).Address)
+	}
+	assert.Equal(t, []cluster.Address{"a:5701", "b:5701", "a:5701"}, addrs)
+}
+
+func TestLeastOutstandingInvocationsLoadBalancer_OneOfMembers(t *testing.T) {
+	members := []cluster.MemberInfo{
+		{Address: "a:5701", UUID: types.NewUUID()},
+		{Address: "b:5701", UUID: types.NewUUID()},
+		{Address: "c:5701", UUID: types.NewUUID()},
+	}
+	outstanding := map[types.UUID]int{
+		members[0].UUID: 5,
+		members[1].UUID: 1,
+		members[2].UUID: 3,
+	}
+	lb := cluster.NewLeastOutstandingInvocationsLoadBalancer()
+	lb.Start(cluster.LoadBalancerOptions{
+		OutstandingInvocations: func(member types.UUID) int {
+			return outstanding[member]
+		},
+	})
+	assert.Equal(t, cluster.Address("b:5701"), lb.OneOfMembers(members).Address)
+	outstanding[members[1].UUID] = 4
+	assert.Equal(t, cluster.Address("c:5701"), lb.OneOfMembers(members).Address)
+	// ties are broken in order
+	outstanding[members[0].UUID] = 0
+	outstanding[members[2].UUID] = 0
+	assert.Equal(t, cluster.Address("c:5701"), lb.OneOfMembers(members).Address)
+	assert.Equal(t, cluster.Address("a:5701"), lb.OneOfMembers(members).Address)
+}
+
+func TestWeightedRandomLoadBalancer_OneOfMembers(t *testing.T) {
+	members := []cluster.MemberInfo{
+		{Address: "a:5701", Attributes: map[string]string{"weight": "3"}},
+		{Address: "b:5701", Attributes: map[string]string{"weight": "1"}},
+		{Address: "c:5701", Attributes: map[string]string{"weight": "0"}},
+		{Address: "d:5701", Attributes: map[string]string{"weight": "invalid"}},
+	}
+	lb := cluster.NewWeightedRandomLoadBalancer(cluster.AttributeWeight("weight", 0))
+	counts := map[cluster.Address]int{}
+	const n = 10000
+	for i := 0; i < n; i++ {
+		counts[lb.OneOfMembers(members).Address]++
+	}
+	assert.Equal(t, 0, counts["c:5701"])
+	assert.Equal(t, 0, counts["d:5701"])
+	assert.InDelta(t, 0.75, float64(counts["a:5701"])/n, 0.05)
+	assert.InDelta(t, 0.25, float64(counts["b:5701"])/n, 0.05)
+}
+
+func TestWeightedRandomLoadBalancer_OneOfMembers_NoWeights(t *testing.T) {
+	members := []cluster.MemberInfo{{Address: "a:5701"}, {Address: "b:5701"}}
+	lb := cluster.NewWeightedRandomLoadBalancer(cluster.AttributeWeight("weight", 0))
+	addr := lb.OneOfMembers(members).Address
+	assert.Contains(t, []cluster.Address{"a:5701", "b:5701"}, addr)
+}
+
+func TestAttributeWeight(t *testing.T) {
+	weight := cluster.AttributeWeight("weight", 1)
+	assert.Equal(t, 2.5, weight(cluster.MemberInfo{Attributes: map[string]string{"weight": "2.5"}}))
+	assert.Equal(t, 1.0, weight(cluster.MemberInfo{Attributes: map[string]string{"weight": "heavy"}}))
+	assert.Equal(t, 1.0, weight(cluster.MemberInfo{}))
+}
@@ -16,7 +16,14 @@
 
 package cluster
 
+import (
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+)
+
 type SecurityConfig struct {
+	credentials Credentials
 	Credentials CredentialsConfig
 }
 
@@ -25,7 +32,29 @@ func (c SecurityConfig) Clone() SecurityConfig {
 }
 
 func (c *SecurityConfig) Validate() error {
-	return nil
+	if c.credentials == nil {
+		return nil
+	}
+	if c.Credentials.Username != "" || c.Credentials.Password != "" {
+		return fmt.Errorf("username and password cannot be used with other credentials: %w", hzerrors.ErrInvalidConfiguration)
+	}
+	credentials, err := credentialsValue(c.credentials)
+	if err != nil {
+		return err
+	}
+	c.credentials = credentials
+	return credentials.validateCredentials()
+}
+
+// SetCredentials sets the credentials to authenticate the client instead of the username and password.
+// If credentials is nil, the username and password are used.
+func (c *SecurityConfig) SetCredentials(credentials Credentials) {
+	c.credentials = credentials
+}
+
+// GetCredentials returns the credentials set with SetCredentials.
+func (c *SecurityConfig) GetCredentials() Credentials {
+	return c.credentials
 }
 
 type CredentialsConfig struct {
@@ -17,10 +17,16 @@
 package cluster_test
 
 import (
+	"context"
+	"errors"
 	"reflect"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
 
 	"github.com/hazelcast/hazelcast-go-client/cluster"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
 	"github.com/hazelcast/hazelcast-go-client/internal/it"
 )
 
@@ -35,3 +41,50 @@ func TestSecurityConfig_CloneAndValidate(t *testing.T) {
 	}
 	it.Must(cfg.Validate())
 }
+
+func TestSecurityConfig_ValidateCredentials(t *testing.T) {
+	testCases := []struct {
+		name        string
+		credentials cluster.Credentials
+		username    string
+		valid       bool
+	}{
+		{name: "none", valid: true},
+		{name: "token", credentials: cluster.TokenCredentials{Token: []byte("token")}, valid: true},
+		{name: "empty token", credentials: cluster.TokenCredentials{}},
+		{name: "token provider", credentials: cluster.TokenProvider(func(ctx context.Context) ([]byte, time.Time, error) {
+			return []byte("token"), time.Time{}, nil
+		}), valid: true},
+		{name: "nil token provider", credentials: cluster.TokenProvider(nil)},
+		{name: "custom", credentials: cluster.CustomCredentials{Value: "secret"}, valid: true},
+		{name: "nil custom", credentials: cluster.CustomCredentials{}},
+		{name: "token with username", credentials: cluster.TokenCredentials{Token: []byte("token")}, username: "user"},
+		{name: "token pointer", credentials: &cluster.TokenCredentials{Token: []byte("token")}, valid: true},
+		{name: "empty token pointer", credentials: &cluster.TokenCredentials{}},
+		{name: "nil token pointer", credentials: (*cluster.TokenCredentials)(nil)},
+		{name: "custom pointer", credentials: &cluster.CustomCredentials{Value: "secret"}, valid: true},
+		{name: "nil custom pointer", credentials: (*cluster.CustomCredentials)(nil)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := cluster.SecurityConfig{}
+			cfg.Credentials.Username = tc.username
+			cfg.SetCredentials(tc.credentials)
+			cfg = cfg.Clone()
+			err := cfg.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+				return
+			}
+			require.True(t, errors.Is(err, hzerrors.ErrInvalidConfiguration))
+		})
+	}
+}
+
+func TestSecurityConfig_ValidateCredentials_Pointer(t *testing.T) {
+	cfg := cluster.SecurityConfig{}
+	cfg.SetCredentials(&cluster.TokenCredentials{Token: []byte("token")})
+	require.NoError(t, cfg.Validate())
+	// pointer credentials are replaced with the values they point to
+	require.Equal(t, cluster.TokenCredentials{Token: []byte("token")}, cfg.GetCredentials())
+}
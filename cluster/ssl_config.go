@@ -23,38 +23,74 @@ import (
 	"encoding/pem"
 	"fmt"
 	"os"
+	"time"
 
+	"github.com/hazelcast/hazelcast-go-client/internal/check"
 	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/types"
 )
 
 // SSLConfig is SSL configuration for client.
 // SSLConfig has tls.Config embedded in it so that users can set any field of tls config as they wish.
 type SSLConfig struct {
 	tlsConfig *tls.Config
+	caPath    string
+	keyPairs  []keyPairFiles
 	// ServerName sets the host name of the server.
 	ServerName string `json:",omitempty"`
-	Enabled    bool   `json:",omitempty"`
+	// CertificateReloadInterval enables reloading the CA and client certificates, if it is positive.
+	// The files set with SetCAPath, AddClientCertAndKeyPath and AddClientCertAndEncryptedKeyPath are checked for changes in the given interval.
+	// If any of them has changed, the certificates are reloaded and used for the new connections.
+	// The files are also reloaded if a client certificate expires before the next check,
+	// so a certificate which is renewed without changing the modification time and size of its file is picked up.
+	CertificateReloadInterval types.Duration `json:",omitempty"`
+	Enabled                   bool           `json:",omitempty"`
+	// RecycleConnections enables replacing the connections opened with the previous certificates, after the certificates are reloaded.
+	// The connections are replaced one at a time.
+	// A connection is closed after a new connection to the same member is opened and the invocations sent over it are completed.
+	RecycleConnections bool `json:",omitempty"`
+}
+
+// keyPairFiles contains the files of a client certificate, which is stored in tls.Config.Certificates at index.
+type keyPairFiles struct {
+	certPath string
+	keyPath  string
+	password string
+	index    int
+	// encrypted is true if the private key is encrypted with the password.
+	encrypted bool
 }
 
 func (c *SSLConfig) Clone() SSLConfig {
 	c.ensureTLSConfig()
 	return SSLConfig{
-		ServerName: c.ServerName,
-		Enabled:    c.Enabled,
-		tlsConfig:  c.tlsConfig.Clone(),
+		ServerName:                c.ServerName,
+		Enabled:                   c.Enabled,
+		CertificateReloadInterval: c.CertificateReloadInterval,
+		RecycleConnections:        c.RecycleConnections,
+		tlsConfig:                 c.tlsConfig.Clone(),
+		caPath:                    c.caPath,
+		keyPairs:                  append([]keyPairFiles(nil), c.keyPairs...),
 	}
 }
 
 func (c *SSLConfig) Validate() error {
 	c.ensureTLSConfig()
+	if err := check.EnsureNonNegativeDuration((*time.Duration)(&c.CertificateReloadInterval), 0, "invalid certificate reload interval"); err != nil {
+		return err
+	}
 	return nil
 }
 
 // SetTLSConfig overrides the internal TLS configuration.
 // Use this method only when you want to replace the internal TLS configuration.
 // It should be called before calling any other methods or setting any fields.
+// The callbacks of the given configuration, such as GetClientCertificate, are called for each new connection,
+// so they can be used to provide the rotated certificates instead of CertificateReloadInterval.
 func (c *SSLConfig) SetTLSConfig(tlsConfig *tls.Config) {
 	c.tlsConfig = tlsConfig.Clone()
+	c.caPath = ""
+	c.keyPairs = nil
 }
 
 // TLSConfig returns the clone of internal TLS configuration.
@@ -66,18 +102,12 @@ func (c *SSLConfig) TLSConfig() *tls.Config {
 // SetCAPath sets CA file path.
 func (c *SSLConfig) SetCAPath(path string) error {
 	c.ensureTLSConfig()
-	// XXX: what happens if the path is loaded multiple times?
-	// load CA cert
-	if caCert, err := os.ReadFile(path); err != nil {
-		return ihzerrors.NewIOError("reading CA certificate", err)
-	} else {
-		caCertPool := x509.NewCertPool()
-		if ok := caCertPool.AppendCertsFromPEM(caCert); !ok {
-			return ihzerrors.NewIOError("error while loading the CA file, make sure the path exits and the format is pem", nil)
-		} else {
-			c.tlsConfig.RootCAs = caCertPool
-		}
+	caCertPool, err := loadCACertPool(path)
+	if err != nil {
+		return err
 	}
+	c.tlsConfig.RootCAs = caCertPool
+	c.caPath = path
 	return nil
 }
 
@@ -89,13 +119,10 @@ func (c *SSLConfig) SetCAPath(path string) error {
 // For mutual authentication at least one client certificate should be added.
 // It returns an error if any of files cannot be loaded.
 func (c *SSLConfig) AddClientCertAndKeyPath(clientCertPath string, clientPrivateKeyPath string) error {
-	c.ensureTLSConfig()
-	if cert, err := tls.LoadX509KeyPair(clientCertPath, clientPrivateKeyPath); err != nil {
-		return fmt.Errorf("loading key pair: %w", err)
-	} else {
-		c.tlsConfig.Certificates = append(c.tlsConfig.Certificates, cert)
-	}
-	return nil
+	return c.addKeyPair(keyPairFiles{
+		certPath: clientCertPath,
+		keyPath:  clientPrivateKeyPath,
+	})
 }
 
 // AddClientCertAndEncryptedKeyPath decrypts the keyfile with the given password and
@@ -108,29 +135,59 @@ func (c *SSLConfig) AddClientCertAndKeyPath(clientCertPath string, clientPrivate
 // For mutual authentication at least one client certificate should be added.
 // It returns an error if any of files cannot be loaded.
 func (c *SSLConfig) AddClientCertAndEncryptedKeyPath(certPath string, privateKeyPath string, password string) error {
-	c.ensureTLSConfig()
-	var certPEMBlock, privatePEM, der []byte
-	var privKey *rsa.PrivateKey
-	var cert tls.Certificate
-	var err error
-	if certPEMBlock, err = os.ReadFile(certPath); err != nil {
-		return fmt.Errorf("reading cert: %w", err)
+	return c.addKeyPair(keyPairFiles{
+		certPath:  certPath,
+		keyPath:   privateKeyPath,
+		password:  password,
+		encrypted: true,
+	})
+}
+
+// CertificateFiles returns the paths of the CA and client certificate files.
+func (c *SSLConfig) CertificateFiles() []string {
+	var paths []string
+	if c.caPath != "" {
+		paths = append(paths, c.caPath)
 	}
-	if privatePEM, err = os.ReadFile(privateKeyPath); err != nil {
-		return fmt.Errorf("reading private key: %w", err)
+	for _, kp := range c.keyPairs {
+		paths = append(paths, kp.certPath, kp.keyPath)
 	}
-	privatePEMBlock, _ := pem.Decode(privatePEM)
-	if der, err = x509.DecryptPEMBlock(privatePEMBlock, []byte(password)); err != nil {
-		return fmt.Errorf("decrypting private key: %w", err)
+	return paths
+}
+
+// ReloadTLSConfig returns a clone of the internal TLS configuration with the CA and client certificates loaded from their files again.
+// The internal TLS configuration is not modified.
+func (c *SSLConfig) ReloadTLSConfig() (*tls.Config, error) {
+	cfg := c.TLSConfig()
+	if c.caPath != "" {
+		caCertPool, err := loadCACertPool(c.caPath)
+		if err != nil {
+			return nil, err
+		}
+		cfg.RootCAs = caCertPool
 	}
-	if privKey, err = x509.ParsePKCS1PrivateKey(der); err != nil {
-		return fmt.Errorf("parsing private key: %w", err)
+	if len(c.keyPairs) > 0 {
+		cfg.Certificates = append([]tls.Certificate(nil), cfg.Certificates...)
 	}
-	keyPEM := pem.EncodeToMemory(&pem.Block{Type: privatePEMBlock.Type, Bytes: x509.MarshalPKCS1PrivateKey(privKey)})
-	if cert, err = tls.X509KeyPair(certPEMBlock, keyPEM); err != nil {
-		return fmt.Errorf("creating certificate from key pair: %w", err)
+	for _, kp := range c.keyPairs {
+		cert, err := kp.load()
+		if err != nil {
+			return nil, err
+		}
+		cfg.Certificates[kp.index] = cert
+	}
+	return cfg, nil
+}
+
+func (c *SSLConfig) addKeyPair(kp keyPairFiles) error {
+	c.ensureTLSConfig()
+	cert, err := kp.load()
+	if err != nil {
+		return err
 	}
+	kp.index = len(c.tlsConfig.Certificates)
 	c.tlsConfig.Certificates = append(c.tlsConfig.Certificates, cert)
+	c.keyPairs = append(c.keyPairs, kp)
 	return nil
 }
 
@@ -141,3 +198,50 @@ func (c *SSLConfig) ensureTLSConfig() {
 		}
 	}
 }
+
+func loadCACertPool(path string) (*x509.CertPool, error) {
+	caCert, err := os.ReadFile(path)
+	if err != nil {
+		return nil, ihzerrors.NewIOError("reading CA certificate", err)
+	}
+	caCertPool := x509.NewCertPool()
+	if ok := caCertPool.AppendCertsFromPEM(caCert); !ok {
+		return nil, ihzerrors.NewIOError("error while loading the CA file, make sure the path exits and the format is pem", nil)
+	}
+	return caCertPool, nil
+}
+
+func (kp keyPairFiles) load() (tls.Certificate, error) {
+	if !kp.encrypted {
+		cert, err := tls.LoadX509KeyPair(kp.certPath, kp.keyPath)
+		if err != nil {
+			return tls.Certificate{}, fmt.Errorf("loading key pair: %w", err)
+		}
+		return cert, nil
+	}
+	var certPEMBlock, privatePEM, der []byte
+	var privKey *rsa.PrivateKey
+	var cert tls.Certificate
+	var err error
+	if certPEMBlock, err = os.ReadFile(kp.certPath); err != nil {
+		return cert, fmt.Errorf("reading cert: %w", err)
+	}
+	if privatePEM, err = os.ReadFile(kp.keyPath); err != nil {
+		return cert, fmt.Errorf("reading private key: %w", err)
+	}
+	privatePEMBlock, _ := pem.Decode(privatePEM)
+	if privatePEMBlock == nil {
+		return cert, fmt.Errorf("decoding private key: no PEM data found in %s", kp.keyPath)
+	}
+	if der, err = x509.DecryptPEMBlock(privatePEMBlock, []byte(kp.password)); err != nil {
+		return cert, fmt.Errorf("decrypting private key: %w", err)
+	}
+	if privKey, err = x509.ParsePKCS1PrivateKey(der); err != nil {
+		return cert, fmt.Errorf("parsing private key: %w", err)
+	}
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: privatePEMBlock.Type, Bytes: x509.MarshalPKCS1PrivateKey(privKey)})
+	if cert, err = tls.X509KeyPair(certPEMBlock, keyPEM); err != nil {
+		return cert, fmt.Errorf("creating certificate from key pair: %w", err)
+	}
+	return cert, nil
+}
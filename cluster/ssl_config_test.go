@@ -17,6 +17,8 @@
 package cluster_test
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -77,3 +79,35 @@ func TestSSLConfig_AddClientCertAndKeyPath(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, len(sslConfig.TLSConfig().Certificates), 2)
 }
+
+func TestSSLConfig_ReloadTLSConfig(t *testing.T) {
+	dir := t.TempDir()
+	caPath := filepath.Join(dir, "ca.pem")
+	certPath := filepath.Join(dir, "cert.pem")
+	keyPath := filepath.Join(dir, "key.pem")
+	copyTestFile(t, "testdata/server1-cert.pem", caPath)
+	copyTestFile(t, "testdata/client1-cert.pem", certPath)
+	copyTestFile(t, "testdata/client1-key.pem", keyPath)
+	sslConfig := cluster.SSLConfig{Enabled: true}
+	require.NoError(t, sslConfig.SetCAPath(caPath))
+	require.NoError(t, sslConfig.AddClientCertAndKeyPath(certPath, keyPath))
+	require.Equal(t, []string{caPath, certPath, keyPath}, sslConfig.CertificateFiles())
+	oldCert := sslConfig.TLSConfig().Certificates[0]
+	copyTestFile(t, "testdata/client2-cert.pem", certPath)
+	copyTestFile(t, "testdata/client2-key.pem", keyPath)
+	tlsConfig, err := sslConfig.ReloadTLSConfig()
+	require.NoError(t, err)
+	require.Len(t, tlsConfig.Certificates, 1)
+	require.NotEqual(t, oldCert.Certificate, tlsConfig.Certificates[0].Certificate)
+	// the internal configuration is not modified
+	require.Equal(t, oldCert.Certificate, sslConfig.TLSConfig().Certificates[0].Certificate)
+	require.NoError(t, os.Remove(caPath))
+	_, err = sslConfig.ReloadTLSConfig()
+	require.Error(t, err)
+}
+
+func copyTestFile(t *testing.T, src, dst string) {
+	b, err := os.ReadFile(src)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(dst, b, 0600))
+}
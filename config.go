@@ -31,6 +31,7 @@ import (
 	"github.com/hazelcast/hazelcast-go-client/nearcache"
 	"github.com/hazelcast/hazelcast-go-client/serialization"
 	"github.com/hazelcast/hazelcast-go-client/types"
+	"github.com/hazelcast/hazelcast-go-client/vector"
 )
 
 // see: com.hazelcast.internal.nearcache.impl.invalidation.RepairingTask
@@ -49,6 +50,8 @@ type Config struct {
 	nearCaches            map[string]nearcache.Config
 	NearCaches            []nearcache.Config                `json:",omitempty"`
 	FlakeIDGenerators     map[string]FlakeIDGeneratorConfig `json:",omitempty"`
+	ReliableTopics        map[string]ReliableTopicConfig    `json:",omitempty"`
+	VectorCollections     map[string]vector.Config          `json:",omitempty"`
 	Labels                []string                          `json:",omitempty"`
 	ClientName            string                            `json:",omitempty"`
 	Logger                logger.Config                     `json:",omitempty"`
@@ -120,6 +123,8 @@ func (c *Config) Clone() Config {
 	newLabels := make([]string, len(c.Labels))
 	copy(newLabels, c.Labels)
 	newFlakeIDConfigs := c.copyFlakeIDGeneratorConfig()
+	newReliableTopicConfigs := c.copyReliableTopicConfig()
+	newVectorCollectionConfigs := c.copyVectorCollectionConfig()
 	nccs := c.copyNearCacheConfig()
 	newNCs := make([]nearcache.Config, 0, len(c.NearCaches))
 	newNCs = append(newNCs, c.NearCaches...)
@@ -127,6 +132,8 @@ func (c *Config) Clone() Config {
 		ClientName:            c.ClientName,
 		Labels:                newLabels,
 		FlakeIDGenerators:     newFlakeIDConfigs,
+		ReliableTopics:        newReliableTopicConfigs,
+		VectorCollections:     newVectorCollectionConfigs,
 		nearCaches:            nccs,
 		NearCaches:            newNCs,
 		Cluster:               c.Cluster.Clone(),
@@ -168,6 +175,20 @@ func (c *Config) Validate() error {
 			return err
 		}
 	}
+	c.ensureReliableTopics()
+	for k, v := range c.ReliableTopics {
+		if err := v.Validate(); err != nil {
+			return err
+		}
+		c.ReliableTopics[k] = v
+	}
+	c.ensureVectorCollections()
+	for k, v := range c.VectorCollections {
+		if err := v.Validate(); err != nil {
+			return err
+		}
+		c.VectorCollections[k] = v
+	}
 	c.ensureNearCacheConfigs()
 	for _, nc := range c.NearCaches {
 		c.AddNearCache(nc)
@@ -208,6 +229,18 @@ func (c *Config) ensureFlakeIDGenerators() {
 	}
 }
 
+func (c *Config) ensureReliableTopics() {
+	if c.ReliableTopics == nil {
+		c.ReliableTopics = map[string]ReliableTopicConfig{}
+	}
+}
+
+func (c *Config) ensureVectorCollections() {
+	if c.VectorCollections == nil {
+		c.VectorCollections = map[string]vector.Config{}
+	}
+}
+
 func (c *Config) ensureNearCacheConfigs() {
 	if c.nearCaches == nil {
 		c.nearCaches = map[string]nearcache.Config{}
@@ -243,6 +276,34 @@ func (c *Config) AddFlakeIDGenerator(name string, prefetchCount int32, prefetchE
 	return nil
 }
 
+// AddReliableTopic validates the values and adds new ReliableTopicConfig with the given name.
+func (c *Config) AddReliableTopic(name string, readBatchSize int32, overloadPolicy TopicOverloadPolicy) error {
+	if _, ok := c.ReliableTopics[name]; ok {
+		return hzerrors.NewIllegalArgumentError(fmt.Sprintf("config already exists for %s", name), nil)
+	}
+	rtConfig := ReliableTopicConfig{ReadBatchSize: readBatchSize, OverloadPolicy: overloadPolicy}
+	if err := rtConfig.Validate(); err != nil {
+		return err
+	}
+	c.ensureReliableTopics()
+	c.ReliableTopics[name] = rtConfig
+	return nil
+}
+
+// AddVectorCollection validates the values and adds new vector.Config with the given name.
+func (c *Config) AddVectorCollection(name string, indexes ...vector.IndexConfig) error {
+	if _, ok := c.VectorCollections[name]; ok {
+		return hzerrors.NewIllegalArgumentError(fmt.Sprintf("config already exists for %s", name), nil)
+	}
+	vcConfig := vector.Config{Indexes: indexes}
+	if err := vcConfig.Validate(); err != nil {
+		return err
+	}
+	c.ensureVectorCollections()
+	c.VectorCollections[name] = vcConfig
+	return nil
+}
+
 func (c Config) copyNearCacheConfig() map[string]nearcache.Config {
 	c.ensureNearCacheConfigs()
 	configs := make(map[string]nearcache.Config, len(c.nearCaches))
@@ -261,6 +322,24 @@ func (c Config) copyFlakeIDGeneratorConfig() map[string]FlakeIDGeneratorConfig {
 	return configs
 }
 
+func (c Config) copyReliableTopicConfig() map[string]ReliableTopicConfig {
+	c.ensureReliableTopics()
+	configs := make(map[string]ReliableTopicConfig, len(c.ReliableTopics))
+	for k, v := range c.ReliableTopics {
+		configs[k] = v.Clone()
+	}
+	return configs
+}
+
+func (c Config) copyVectorCollectionConfig() map[string]vector.Config {
+	c.ensureVectorCollections()
+	configs := make(map[string]vector.Config, len(c.VectorCollections))
+	for k, v := range c.VectorCollections {
+		configs[k] = v.Clone()
+	}
+	return configs
+}
+
 type configForMarshal Config
 
 // StatsConfig contains configuration for Management Center.
@@ -319,6 +398,39 @@ func (f *FlakeIDGeneratorConfig) Clone() FlakeIDGeneratorConfig {
 	}
 }
 
+const defaultReliableTopicReadBatchSize = 10
+
+// ReliableTopicConfig contains configuration for a ReliableTopic.
+type ReliableTopicConfig struct {
+	// ReadBatchSize is the maximum number of messages read from the backing Ringbuffer at once by a listener.
+	// The allowed range is [1, 1000] and defaults to 10.
+	ReadBatchSize int32 `json:",omitempty"`
+	// OverloadPolicy determines what happens when a message is published but there is no space in the backing Ringbuffer.
+	// Defaults to TopicOverloadPolicyBlock.
+	OverloadPolicy TopicOverloadPolicy `json:",omitempty"`
+}
+
+// Validate validates the configuration and adds the defaults.
+func (r *ReliableTopicConfig) Validate() error {
+	if r.ReadBatchSize == 0 {
+		r.ReadBatchSize = defaultReliableTopicReadBatchSize
+	} else if err := check.WithinRangeInt32(r.ReadBatchSize, 1, MaxBatchSize); err != nil {
+		return err
+	}
+	if err := check.WithinRangeInt32(int32(r.OverloadPolicy), int32(TopicOverloadPolicyBlock), int32(TopicOverloadPolicyError)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Clone returns a copy of the ReliableTopicConfig struct.
+func (r *ReliableTopicConfig) Clone() ReliableTopicConfig {
+	return ReliableTopicConfig{
+		ReadBatchSize:  r.ReadBatchSize,
+		OverloadPolicy: r.OverloadPolicy,
+	}
+}
+
 // NearCacheInvalidationConfig contains invalidation configuration for all Near Caches.
 type NearCacheInvalidationConfig struct {
 	maxToleratedMissCount         *int
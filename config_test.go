@@ -54,6 +54,8 @@ func TestConfig(t *testing.T) {
 		{name: "CloneFlakeIDGeneratorConfig", f: configCloneFlakeIDGeneratorConfigTest},
 		{name: "AddFlakeIDGenerator", f: configAddFlakeIDGeneratorTest},
 		{name: "AddExistingFlakeIDGenerator", f: configAddExistingFlakeIDGeneratorTest},
+		{name: "ValidateReliableTopicConfig", f: configValidateReliableTopicConfigTest},
+		{name: "AddReliableTopic", f: configAddReliableTopicTest},
 		{name: "AddNearCache", f: configAddNearCacheTest},
 		{name: "ValidateNearCacheFails", f: configValidateNearCacheFailsTest},
 		{name: "ServerNameIsAutomaticallySetForViridian", f: configServerNameIsAutomaticallySetForViridian},
@@ -246,6 +248,7 @@ func configUnmarshalJSONConfigTest(t *testing.T) {
 		"HeartbeatInterval": "10s",
 		"HeartbeatTimeout": "15s",
 		"InvocationTimeout": "25s",
+		"BackupAckTimeout": "3s",
 		"Network": {
 			"ConnectionTimeout": "20s"
 		},
@@ -290,6 +293,7 @@ func configUnmarshalJSONConfigTest(t *testing.T) {
 	assert.Equal(t, types.Duration(10*time.Second), config.Cluster.HeartbeatInterval)
 	assert.Equal(t, types.Duration(15*time.Second), config.Cluster.HeartbeatTimeout)
 	assert.Equal(t, types.Duration(25*time.Second), config.Cluster.InvocationTimeout)
+	assert.Equal(t, types.Duration(3*time.Second), config.Cluster.BackupAckTimeout)
 	assert.Equal(t, cluster.ReconnectModeOff, config.Cluster.ConnectionStrategy.ReconnectMode)
 	assert.Equal(t, true, config.Stats.Enabled)
 	assert.Equal(t, types.Duration(2*time.Minute), config.Stats.Period)
@@ -502,6 +506,64 @@ func configAddExistingFlakeIDGeneratorTest(t *testing.T) {
 	assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
 }
 
+func configValidateReliableTopicConfigTest(t *testing.T) {
+	testCases := []struct {
+		expectErr       error
+		name            string
+		config          hazelcast.ReliableTopicConfig
+		expectBatchSize int32
+	}{
+		{
+			name:            "ZeroValuedConfiguration",
+			config:          hazelcast.ReliableTopicConfig{},
+			expectBatchSize: hazelcast.DefaultReliableTopicReadBatchSize,
+		},
+		{
+			name:            "ValidReadBatchSize",
+			config:          hazelcast.ReliableTopicConfig{ReadBatchSize: 1000, OverloadPolicy: hazelcast.TopicOverloadPolicyError},
+			expectBatchSize: 1000,
+		},
+		{
+			name:      "NegativeReadBatchSize",
+			config:    hazelcast.ReliableTopicConfig{ReadBatchSize: -1},
+			expectErr: hzerrors.ErrIllegalArgument,
+		},
+		{
+			name:      "InvalidReadBatchSize",
+			config:    hazelcast.ReliableTopicConfig{ReadBatchSize: 1001},
+			expectErr: hzerrors.ErrIllegalArgument,
+		},
+		{
+			name:      "InvalidOverloadPolicy",
+			config:    hazelcast.ReliableTopicConfig{OverloadPolicy: 42},
+			expectErr: hzerrors.ErrIllegalArgument,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.Validate()
+			if tc.expectErr != nil {
+				assert.True(t, errors.Is(err, tc.expectErr))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectBatchSize, tc.config.ReadBatchSize)
+		})
+	}
+}
+
+func configAddReliableTopicTest(t *testing.T) {
+	config := hazelcast.Config{}
+	assert.NoError(t, config.AddReliableTopic("foo", 0, hazelcast.TopicOverloadPolicyDiscardOldest))
+	err := config.AddReliableTopic("foo", 5, hazelcast.TopicOverloadPolicyBlock)
+	assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+	require.NoError(t, config.Validate())
+	assert.Equal(t, hazelcast.ReliableTopicConfig{
+		ReadBatchSize:  hazelcast.DefaultReliableTopicReadBatchSize,
+		OverloadPolicy: hazelcast.TopicOverloadPolicyDiscardOldest,
+	}, config.Clone().ReliableTopics["foo"])
+}
+
 func configAddNearCacheTest(t *testing.T) {
 	config := hazelcast.Config{}
 	ncc := nearcache.Config{Name: "foo"}
@@ -540,8 +602,10 @@ func checkDefault(t *testing.T, c *hazelcast.Config) {
 	assert.Equal(t, types.Duration(5*time.Second), c.Cluster.HeartbeatInterval)
 	assert.Equal(t, types.Duration(60*time.Second), c.Cluster.HeartbeatTimeout)
 	assert.Equal(t, types.Duration(120*time.Second), c.Cluster.InvocationTimeout)
+	assert.Equal(t, types.Duration(5*time.Second), c.Cluster.BackupAckTimeout)
 	assert.Equal(t, false, c.Cluster.Unisocket)
 	assert.Equal(t, false, c.Cluster.RedoOperation)
+	assert.Equal(t, false, c.Cluster.DisableBackupAckToClient)
 
 	assert.Equal(t, []string{"127.0.0.1:5701"}, c.Cluster.Network.Addresses)
 	assert.Equal(t, false, c.Cluster.Network.SSL.Enabled)
@@ -0,0 +1,122 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+	"github.com/hazelcast/hazelcast-go-client/internal/it/skip"
+)
+
+func TestCountDownLatch(t *testing.T) {
+	skip.If(t, "enterprise")
+	skip.If(t, "hz < 5")
+	testCases := []struct {
+		name       string
+		f          func(t *testing.T)
+		noParallel bool
+	}{
+		{name: "CountDownLatchTrySetCount", f: countDownLatchTrySetCountTest},
+		{name: "CountDownLatchCountDown", f: countDownLatchCountDownTest},
+		{name: "CountDownLatchAwait", f: countDownLatchAwaitTest},
+		{name: "CountDownLatchAwait_Timeout", f: countDownLatchAwaitTimeoutTest},
+	}
+	// run no-parallel test first
+	sort.Slice(testCases, func(i, j int) bool {
+		return testCases[i].noParallel && !testCases[j].noParallel
+	})
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if !tc.noParallel {
+				t.Parallel()
+			}
+			tc.f(t)
+		})
+	}
+}
+
+func countDownLatchTrySetCountTest(t *testing.T) {
+	it.CountDownLatchTester(t, func(t *testing.T, l *hz.CountDownLatch) {
+		ctx := context.Background()
+		ok, err := l.TrySetCount(ctx, 3)
+		require.NoError(t, err)
+		require.True(t, ok)
+		ok, err = l.TrySetCount(ctx, 5)
+		require.NoError(t, err)
+		require.False(t, ok)
+		count, err := l.GetCount(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 3, count)
+	})
+}
+
+func countDownLatchCountDownTest(t *testing.T) {
+	it.CountDownLatchTester(t, func(t *testing.T, l *hz.CountDownLatch) {
+		ctx := context.Background()
+		_, err := l.TrySetCount(ctx, 2)
+		require.NoError(t, err)
+		require.NoError(t, l.CountDown(ctx))
+		count, err := l.GetCount(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+		require.NoError(t, l.CountDown(ctx))
+		require.NoError(t, l.CountDown(ctx))
+		count, err = l.GetCount(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 0, count)
+	})
+}
+
+func countDownLatchAwaitTest(t *testing.T) {
+	it.CountDownLatchTester(t, func(t *testing.T, l *hz.CountDownLatch) {
+		ctx := context.Background()
+		_, err := l.TrySetCount(ctx, 1)
+		require.NoError(t, err)
+		go func() {
+			time.Sleep(500 * time.Millisecond)
+			if err := l.CountDown(ctx); err != nil {
+				panic(err)
+			}
+		}()
+		awaitCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+		ok, err := l.Await(awaitCtx)
+		require.NoError(t, err)
+		require.True(t, ok)
+	})
+}
+
+func countDownLatchAwaitTimeoutTest(t *testing.T) {
+	it.CountDownLatchTester(t, func(t *testing.T, l *hz.CountDownLatch) {
+		ctx := context.Background()
+		_, err := l.TrySetCount(ctx, 1)
+		require.NoError(t, err)
+		awaitCtx, cancel := context.WithTimeout(ctx, 500*time.Millisecond)
+		defer cancel()
+		ok, err := l.Await(awaitCtx)
+		require.NoError(t, err)
+		require.False(t, ok)
+	})
+}
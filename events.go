@@ -59,6 +59,7 @@ const (
 	eventListItemNotified           = "list.itemnotified"
 	eventSetItemNotified            = "set.itemnotified"
 	eventDistributedObjectNotified  = "distributedobjectnotified"
+	eventCacheEntryNotified         = "cache.entrynotified"
 )
 
 // EntryNotified contains information about an entry event.
@@ -301,3 +302,46 @@ func newDistributedObjectNotified(service string, object string, eventType Distr
 		EventType:   eventType,
 	}
 }
+
+// CacheEntryEventType is the type of a cache entry event.
+type CacheEntryEventType int32
+
+const (
+	// CacheEntryCreated is dispatched if an entry is created.
+	CacheEntryCreated CacheEntryEventType = 1
+	// CacheEntryUpdated is dispatched if an entry is updated.
+	CacheEntryUpdated CacheEntryEventType = 2
+	// CacheEntryRemoved is dispatched if an entry is removed.
+	CacheEntryRemoved CacheEntryEventType = 3
+	// CacheEntryExpired is dispatched if an entry is expired.
+	CacheEntryExpired CacheEntryEventType = 4
+)
+
+// CacheEntryNotifiedHandler is called when a cache entry event happens.
+type CacheEntryNotifiedHandler func(event *CacheEntryNotified)
+
+// CacheEntryNotified contains information about a cache entry event.
+// OldValue is set only if OldValueAvailable is true.
+type CacheEntryNotified struct {
+	Key               interface{}
+	Value             interface{}
+	OldValue          interface{}
+	CacheName         string
+	EventType         CacheEntryEventType
+	OldValueAvailable bool
+}
+
+func (e *CacheEntryNotified) EventName() string {
+	return eventCacheEntryNotified
+}
+
+func newCacheEntryNotified(name string, key, value, oldValue interface{}, oldValueAvailable bool, eventType CacheEntryEventType) *CacheEntryNotified {
+	return &CacheEntryNotified{
+		CacheName:         name,
+		Key:               key,
+		Value:             value,
+		OldValue:          oldValue,
+		OldValueAvailable: oldValueAvailable,
+		EventType:         eventType,
+	}
+}
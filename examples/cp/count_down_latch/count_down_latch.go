@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client"
+)
+
+/*
+To use CP Subsystem, you need to have at least three member in your cluster.
+Member count which will be used by CP Subsystem has to be specified in the Hazelcast config file.
+Default member count is 0 which disables the CP Subsystem.
+
+	<cp-subsystem>
+		<cp-member-count>3</cp-member-count>
+		<group-size>3</group-size>
+	</cp-subsystem>
+*/
+
+func main() {
+	ctx := context.Background()
+	client, err := hazelcast.StartNewClient(ctx)
+	if err != nil {
+		panic(err)
+	}
+	latch, err := client.CPSubsystem().GetCountDownLatch(ctx, "my-latch")
+	if err != nil {
+		panic(err)
+	}
+	const workers = 3
+	if _, err := latch.TrySetCount(ctx, workers); err != nil {
+		panic(err)
+	}
+	for i := 0; i < workers; i++ {
+		go func(i int) {
+			fmt.Println("worker done:", i)
+			if err := latch.CountDown(ctx); err != nil {
+				panic(err)
+			}
+		}(i)
+	}
+	// wait for the workers at most 10 seconds.
+	awaitCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	done, err := latch.Await(awaitCtx)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println("all workers done:", done)
+	if err := client.Shutdown(ctx); err != nil {
+		panic(err)
+	}
+}
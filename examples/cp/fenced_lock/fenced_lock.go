@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-go-client"
+)
+
+/*
+To use CP Subsystem, you need to have at least three member in your cluster.
+Member count which will be used by CP Subsystem has to be specified in the Hazelcast config file.
+Default member count is 0 which disables the CP Subsystem.
+
+	<cp-subsystem>
+		<cp-member-count>3</cp-member-count>
+		<group-size>3</group-size>
+	</cp-subsystem>
+*/
+
+func main() {
+	ctx := context.Background()
+	client, err := hazelcast.StartNewClient(ctx)
+	if err != nil {
+		panic(err)
+	}
+	lock, err := client.CPSubsystem().GetLock(ctx, "my-lock")
+	if err != nil {
+		panic(err)
+	}
+	// the owner of the lock is the lock context.
+	lockCtx := hazelcast.NewLockContext(ctx)
+	fence, err := lock.Lock(lockCtx)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println("lock acquired with fence:", fence)
+	// another owner cannot acquire the lock.
+	otherFence, err := lock.TryLock(hazelcast.NewLockContext(ctx))
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println("lock acquired by the other owner:", otherFence != hazelcast.InvalidFence)
+	if err := lock.Unlock(lockCtx); err != nil {
+		panic(err)
+	}
+	fmt.Println("lock released")
+	if err := client.Shutdown(ctx); err != nil {
+		panic(err)
+	}
+}
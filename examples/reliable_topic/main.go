@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/hazelcast/hazelcast-go-client"
+)
+
+func main() {
+	messageCount := 10
+	ctx := context.TODO()
+	config := hazelcast.NewConfig()
+	// Read the messages in batches of 5 and wait for space if the backing Ringbuffer is full.
+	if err := config.AddReliableTopic("sample-reliable-topic", 5, hazelcast.TopicOverloadPolicyBlock); err != nil {
+		log.Fatal(err)
+	}
+	client, err := hazelcast.StartNewClientWithConfig(ctx, config)
+	if err != nil {
+		log.Fatal(err)
+	}
+	topic, err := client.GetReliableTopic(ctx, "sample-reliable-topic")
+	if err != nil {
+		log.Fatal(err)
+	}
+	wg := &sync.WaitGroup{}
+	wg.Add(messageCount)
+	// The listener keeps working if some messages are lost, e.g., because it could not keep up with the publishers.
+	listenerConfig := hazelcast.ReliableTopicListenerConfig{LossTolerant: true}
+	_, err = topic.AddMessageListenerWithConfig(ctx, listenerConfig, func(event *hazelcast.MessagePublished) {
+		fmt.Println("Received message: ", event.Value)
+		wg.Done()
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	for i := 0; i < messageCount; i++ {
+		if err := topic.Publish(ctx, fmt.Sprintf("Message %d", i)); err != nil {
+			log.Fatal(err)
+		}
+	}
+	wg.Wait()
+	client.Shutdown(ctx)
+}
@@ -0,0 +1,115 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+)
+
+// unknownTask is not known by the members, so submitting it fails on the member side.
+type unknownTask struct{}
+
+func (unknownTask) FactoryID() int32 {
+	return 7777
+}
+
+func (unknownTask) ClassID() int32 {
+	return 1
+}
+
+func (unknownTask) WriteData(output serialization.DataOutput) {}
+
+func (unknownTask) ReadData(input serialization.DataInput) {}
+
+func TestExecutorService_Shutdown(t *testing.T) {
+	it.Tester(t, func(t *testing.T, client *hz.Client) {
+		ctx := context.Background()
+		es, err := client.GetExecutorService(ctx, it.NewUniqueObjectName("executor"))
+		require.NoError(t, err)
+		defer es.Destroy(ctx)
+		down, err := es.IsShutdown(ctx)
+		require.NoError(t, err)
+		require.False(t, down)
+		require.NoError(t, es.Shutdown(ctx))
+		down, err = es.IsShutdown(ctx)
+		require.NoError(t, err)
+		require.True(t, down)
+	})
+}
+
+func TestExecutorService_SubmitFails(t *testing.T) {
+	it.Tester(t, func(t *testing.T, client *hz.Client) {
+		ctx := context.Background()
+		es, err := client.GetExecutorService(ctx, it.NewUniqueObjectName("executor"))
+		require.NoError(t, err)
+		defer es.Destroy(ctx)
+		futures, err := es.SubmitToAllMembers(ctx, unknownTask{})
+		require.NoError(t, err)
+		require.Equal(t, it.MemberCount(), len(futures))
+		for _, f := range futures {
+			_, err := f.Get(ctx)
+			require.Error(t, err)
+			require.True(t, f.IsDone())
+		}
+		f, err := es.SubmitToKeyOwner(ctx, unknownTask{}, "key")
+		require.NoError(t, err)
+		_, err = f.Get(ctx)
+		require.Error(t, err)
+		_, err = es.SubmitToKeyOwner(ctx, nil, "key")
+		require.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+	})
+}
+
+func TestDurableExecutorService_Shutdown(t *testing.T) {
+	it.Tester(t, func(t *testing.T, client *hz.Client) {
+		ctx := context.Background()
+		es, err := client.GetDurableExecutorService(ctx, it.NewUniqueObjectName("durable-executor"))
+		require.NoError(t, err)
+		defer es.Destroy(ctx)
+		require.NoError(t, es.Shutdown(ctx))
+		down, err := es.IsShutdown(ctx)
+		require.NoError(t, err)
+		require.True(t, down)
+	})
+}
+
+func TestDurableExecutorService_SubmitFails(t *testing.T) {
+	it.Tester(t, func(t *testing.T, client *hz.Client) {
+		ctx := context.Background()
+		es, err := client.GetDurableExecutorService(ctx, it.NewUniqueObjectName("durable-executor"))
+		require.NoError(t, err)
+		defer es.Destroy(ctx)
+		f, err := es.SubmitToKeyOwner(ctx, unknownTask{}, "key")
+		if err != nil {
+			// the member may reject the task while deserializing it.
+			return
+		}
+		_, err = f.Get(ctx)
+		require.Error(t, err)
+		_, err = es.RetrieveResult(ctx, f.TaskID())
+		require.Error(t, err)
+	})
+}
@@ -18,15 +18,20 @@ package hazelcast
 
 import (
 	"context"
+	"sync"
 	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
 )
 
 // Exports non-exported types and methods to hazelcast_test package.
 
 const (
-	DefaultFlakeIDPrefetchCount  = defaultFlakeIDPrefetchCount
-	DefaultFlakeIDPrefetchExpiry = defaultFlakeIDPrefetchExpiry
-	InvalidFlakeID               = invalidFlakeID
+	DefaultFlakeIDPrefetchCount       = defaultFlakeIDPrefetchCount
+	DefaultFlakeIDPrefetchExpiry      = defaultFlakeIDPrefetchExpiry
+	InvalidFlakeID                    = invalidFlakeID
+	DefaultReliableTopicReadBatchSize = defaultReliableTopicReadBatchSize
 )
 
 type (
@@ -54,3 +59,62 @@ func NewFlakeIDBatch(base, increment int64, size int32, expiry time.Duration) fl
 func (f *flakeIDBatch) NextID() int64 {
 	return f.nextID()
 }
+
+func (m *Map) PartitionIDForKey(key interface{}) (int32, error) {
+	keyData, err := m.validateAndSerialize(key)
+	if err != nil {
+		return 0, err
+	}
+	return m.partitionService.GetPartitionID(keyData)
+}
+
+func ConvertTo[T any](value interface{}) (T, error) {
+	return convertTo[T](value)
+}
+
+func MakeDurableTaskID(partitionID int32, sequence int32) int64 {
+	return makeDurableTaskID(partitionID, sequence)
+}
+
+func SplitDurableTaskID(taskID int64) (int32, int32) {
+	return splitDurableTaskID(taskID)
+}
+
+func NewMapKeysIterator(partitionCount int32) *MapIterator {
+	it := &MapIterator{mode: mapIterationKeys, partitionCount: partitionCount}
+	it.resetPointers()
+	return it
+}
+
+func (it *MapIterator) HandleFetchResponse(response *proto.ClientMessage) error {
+	return it.handleFetchResponse(response)
+}
+
+func (it *MapIterator) PartitionID() int32 {
+	return it.partitionID
+}
+
+func (it *MapIterator) Pointers() []proto.Pair {
+	return it.pointers
+}
+
+func NewQueryCacheSequences() *QueryCache {
+	return &QueryCache{sequences: map[int32]int64{}, brokenSeqs: map[int32]int64{}}
+}
+
+func (qc *QueryCache) CheckSequence(partitionID int32, sequence int64) (apply bool, lost bool) {
+	return qc.checkSequence(codec.QueryCacheEventData{PartitionID: partitionID, Sequence: sequence})
+}
+
+func NewProxyCreationLocker() (lock func(name string) func(), count func() int) {
+	m := &proxyManager{creationMu: &sync.Mutex{}, creationLocks: map[string]*creationLock{}}
+	return m.lockCreation, func() int {
+		m.creationMu.Lock()
+		defer m.creationMu.Unlock()
+		return len(m.creationLocks)
+	}
+}
+
+func FilterReadItems(filter RingbufferFilterFunc, items []interface{}, errs []error, seqs []int64) ([]interface{}, []error, []int64) {
+	return filterReadItems(filter, items, errs, seqs)
+}
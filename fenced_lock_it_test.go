@@ -0,0 +1,150 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+	"github.com/hazelcast/hazelcast-go-client/internal/it/skip"
+)
+
+func TestFencedLock(t *testing.T) {
+	skip.If(t, "enterprise")
+	skip.If(t, "hz < 5")
+	testCases := []struct {
+		name       string
+		f          func(t *testing.T)
+		noParallel bool
+	}{
+		{name: "FencedLockLock", f: fencedLockLockTest},
+		{name: "FencedLockLock_Reentrant", f: fencedLockLockReentrantTest},
+		{name: "FencedLockTryLock_WhenLockedByAnotherOwner", f: fencedLockTryLockWhenLockedByAnotherOwnerTest},
+		{name: "FencedLockTryLockWithTimeout", f: fencedLockTryLockWithTimeoutTest},
+		{name: "FencedLockUnlock_WhenNotLocked", f: fencedLockUnlockWhenNotLockedTest},
+		{name: "FencedLockGetFence_WhenNotLocked", f: fencedLockGetFenceWhenNotLockedTest},
+	}
+	// run no-parallel test first
+	sort.Slice(testCases, func(i, j int) bool {
+		return testCases[i].noParallel && !testCases[j].noParallel
+	})
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if !tc.noParallel {
+				t.Parallel()
+			}
+			tc.f(t)
+		})
+	}
+}
+
+func fencedLockLockTest(t *testing.T) {
+	it.FencedLockTester(t, func(t *testing.T, l *hz.FencedLock) {
+		ctx := context.Background()
+		fence, err := l.Lock(ctx)
+		require.NoError(t, err)
+		require.NotEqual(t, hz.InvalidFence, fence)
+		locked, err := l.IsLockedByContext(ctx)
+		require.NoError(t, err)
+		require.True(t, locked)
+		f, err := l.GetFence(ctx)
+		require.NoError(t, err)
+		require.Equal(t, fence, f)
+		require.NoError(t, l.Unlock(ctx))
+		locked, err = l.IsLocked(ctx)
+		require.NoError(t, err)
+		require.False(t, locked)
+	})
+}
+
+func fencedLockLockReentrantTest(t *testing.T) {
+	it.FencedLockTester(t, func(t *testing.T, l *hz.FencedLock) {
+		ctx := context.Background()
+		fence1, err := l.Lock(ctx)
+		require.NoError(t, err)
+		fence2, err := l.Lock(ctx)
+		require.NoError(t, err)
+		require.Equal(t, fence1, fence2)
+		count, err := l.GetLockCount(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+		require.NoError(t, l.Unlock(ctx))
+		require.NoError(t, l.Unlock(ctx))
+		count, err = l.GetLockCount(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 0, count)
+	})
+}
+
+func fencedLockTryLockWhenLockedByAnotherOwnerTest(t *testing.T) {
+	it.FencedLockTester(t, func(t *testing.T, l *hz.FencedLock) {
+		ctx1 := hz.NewLockContext(context.Background())
+		ctx2 := hz.NewLockContext(context.Background())
+		fence, err := l.Lock(ctx1)
+		require.NoError(t, err)
+		require.NotEqual(t, hz.InvalidFence, fence)
+		fence, err = l.TryLock(ctx2)
+		require.NoError(t, err)
+		require.Equal(t, hz.InvalidFence, fence)
+		locked, err := l.IsLockedByContext(ctx2)
+		require.NoError(t, err)
+		require.False(t, locked)
+		require.NoError(t, l.Unlock(ctx1))
+		fence, err = l.TryLock(ctx2)
+		require.NoError(t, err)
+		require.NotEqual(t, hz.InvalidFence, fence)
+		require.NoError(t, l.Unlock(ctx2))
+	})
+}
+
+func fencedLockTryLockWithTimeoutTest(t *testing.T) {
+	it.FencedLockTester(t, func(t *testing.T, l *hz.FencedLock) {
+		ctx1 := hz.NewLockContext(context.Background())
+		ctx2 := hz.NewLockContext(context.Background())
+		_, err := l.Lock(ctx1)
+		require.NoError(t, err)
+		start := time.Now()
+		fence, err := l.TryLockWithTimeout(ctx2, 500*time.Millisecond)
+		require.NoError(t, err)
+		require.Equal(t, hz.InvalidFence, fence)
+		require.True(t, time.Since(start) >= 500*time.Millisecond)
+		require.NoError(t, l.Unlock(ctx1))
+	})
+}
+
+func fencedLockUnlockWhenNotLockedTest(t *testing.T) {
+	it.FencedLockTester(t, func(t *testing.T, l *hz.FencedLock) {
+		err := l.Unlock(context.Background())
+		require.True(t, errors.Is(err, hzerrors.ErrIllegalMonitorState))
+	})
+}
+
+func fencedLockGetFenceWhenNotLockedTest(t *testing.T) {
+	it.FencedLockTester(t, func(t *testing.T, l *hz.FencedLock) {
+		_, err := l.GetFence(context.Background())
+		require.True(t, errors.Is(err, hzerrors.ErrIllegalMonitorState))
+	})
+}
@@ -0,0 +1,40 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cb
+
+import (
+	"time"
+)
+
+// ExponentialBackoff returns a retry policy which doubles the delay with each attempt, starting from initial up to max.
+// The delay is randomized by ±jitter of it using rnd, which returns values in [0, 1).
+// rnd is not called if jitter is zero.
+func ExponentialBackoff(initial, max time.Duration, jitter float64, rnd func() float64) RetryPolicyFunc {
+	return func(attempt int) time.Duration {
+		d := initial
+		for i := 0; i < attempt && d < max; i++ {
+			d *= 2
+		}
+		if d > max {
+			d = max
+		}
+		if jitter == 0 {
+			return d
+		}
+		return time.Duration(float64(d) + float64(d)*jitter*(2.0*rnd()-1.0))
+	}
+}
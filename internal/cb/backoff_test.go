@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/cb"
+)
+
+func TestExponentialBackoff(t *testing.T) {
+	policy := cb.ExponentialBackoff(100*time.Millisecond, 30*time.Second, 0, nil)
+	assert.Equal(t, 100*time.Millisecond, policy(0))
+	assert.Equal(t, 200*time.Millisecond, policy(1))
+	assert.Equal(t, 800*time.Millisecond, policy(3))
+	assert.Equal(t, 30*time.Second, policy(20))
+	assert.Equal(t, 30*time.Second, policy(1000))
+}
+
+func TestExponentialBackoff_Jitter(t *testing.T) {
+	delay := func(attempt int, rnd float64) time.Duration {
+		return cb.ExponentialBackoff(10*time.Millisecond, time.Second, 0.5, func() float64 { return rnd })(attempt)
+	}
+	assert.Equal(t, 10*time.Millisecond, delay(0, 0.5))
+	assert.Equal(t, 5*time.Millisecond, delay(0, 0))
+	assert.Equal(t, 40*time.Millisecond, delay(2, 0.5))
+	assert.Equal(t, 60*time.Millisecond, delay(2, 1))
+	assert.Equal(t, time.Second, delay(20, 0.5))
+	assert.Equal(t, 1500*time.Millisecond, delay(1000, 1))
+}
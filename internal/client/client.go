@@ -259,7 +259,11 @@ func (c *Client) createComponents(config *Config) {
 		Logger:            c.Logger,
 		Config:            config.Cluster,
 	})
-	invocationService := invocation.NewService(invocationHandler, c.EventDispatcher, c.Logger)
+	backupAckTimeout := icluster.BackupAckTimeout(config.Cluster)
+	invocationService := invocation.NewService(invocationHandler, c.EventDispatcher, c.Logger, backupAckTimeout)
+	if backupAckTimeout > 0 {
+		icluster.NewBackupListenerService(c.InvocationFactory, invocationService, c.EventDispatcher, c.Logger)
+	}
 	iv := time.Duration(c.clusterConfig.HeartbeatInterval)
 	it := time.Duration(c.clusterConfig.HeartbeatTimeout)
 	c.heartbeatService = icluster.NewHeartbeatService(connectionManager, c.InvocationFactory, invocationService, c.Logger, iv, it)
@@ -100,6 +100,20 @@ func (iv *Invoker) InvokeOnPartitionAsync(ctx context.Context, request *proto.Cl
 	return inv, err
 }
 
+func (iv *Invoker) InvokeOnMember(ctx context.Context, request *proto.ClientMessage, member *pubcluster.MemberInfo) (*proto.ClientMessage, error) {
+	now := time.Now()
+	return iv.TryInvoke(ctx, func(ctx context.Context, attempt int) (interface{}, error) {
+		if attempt > 0 {
+			request = request.Copy()
+		}
+		inv := iv.factory.NewMemberBoundInvocation(request, member, now)
+		if err := iv.SendInvocation(ctx, inv); err != nil {
+			return nil, err
+		}
+		return inv.GetWithContext(ctx)
+	})
+}
+
 func (iv *Invoker) InvokeOnRandomTarget(ctx context.Context, request *proto.ClientMessage, handler proto.ClientMessageHandler) (*proto.ClientMessage, error) {
 	return iv.invokeOnRandomTarget(ctx, request, handler, false)
 }
@@ -0,0 +1,126 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	pubcluster "github.com/hazelcast/hazelcast-go-client/cluster"
+	"github.com/hazelcast/hazelcast-go-client/internal/event"
+	"github.com/hazelcast/hazelcast-go-client/internal/invocation"
+	"github.com/hazelcast/hazelcast-go-client/internal/logger"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+)
+
+var backupListenerConnectionEventSubID = event.NextSubscriptionID()
+
+// BackupAckTimeout returns the backup acknowledgement timeout if the backup acknowledgements are sent to the client.
+// Otherwise, it returns zero.
+func BackupAckTimeout(cfg *pubcluster.Config) time.Duration {
+	if cfg.Unisocket || cfg.DisableBackupAckToClient {
+		return 0
+	}
+	return time.Duration(cfg.BackupAckTimeout)
+}
+
+// BackupListenerService registers the local backup listener on each connection,
+// so the backup replicas send the backup acknowledgements directly to the client.
+// The backup events are handled by the invocation service.
+type BackupListenerService struct {
+	invocationFactory *ConnectionInvocationFactory
+	invocationService backupListenerInvoker
+	logger            logger.LogAdaptor
+	mu                *sync.Mutex
+	// correlationIDs maps connection IDs to the correlation IDs of the listener registrations
+	correlationIDs map[int64]int64
+}
+
+// backupListenerInvoker is implemented by *invocation.Service.
+type backupListenerInvoker interface {
+	SendUrgentRequest(ctx context.Context, inv invocation.Invocation) error
+	Remove(correlationID int64) error
+}
+
+func NewBackupListenerService(invFactory *ConnectionInvocationFactory, invService *invocation.Service, dispatcher *event.DispatchService, logger logger.LogAdaptor) *BackupListenerService {
+	bs := &BackupListenerService{
+		invocationFactory: invFactory,
+		invocationService: invService,
+		logger:            logger,
+		mu:                &sync.Mutex{},
+		correlationIDs:    map[int64]int64{},
+	}
+	dispatcher.Subscribe(EventConnection, backupListenerConnectionEventSubID, bs.handleConnectionEvent)
+	return bs
+}
+
+func (bs *BackupListenerService) handleConnectionEvent(event event.Event) {
+	e := event.(*ConnectionStateChangedEvent)
+	if e.state != ConnectionStateOpened {
+		bs.deregister(e.Conn)
+		return
+	}
+	if err := bs.register(e.Conn); err != nil {
+		bs.logger.Debug(func() string {
+			return fmt.Sprintf("%d: cannot add backup listener, the member will wait for the backups: %s", e.Conn.connectionID, err.Error())
+		})
+	}
+}
+
+func (bs *BackupListenerService) register(conn *Connection) error {
+	request := codec.EncodeClientLocalBackupListenerRequest()
+	// the handler keeps the invocation registered, it is not called since the invocation service handles the backup events
+	inv := bs.invocationFactory.NewConnectionBoundInvocation(request, conn, func(*proto.ClientMessage) {}, time.Now())
+	ctx, cancel := context.WithDeadline(context.Background(), inv.Deadline())
+	defer cancel()
+	correlationID := inv.Request().CorrelationID()
+	bs.mu.Lock()
+	bs.correlationIDs[conn.connectionID] = correlationID
+	bs.mu.Unlock()
+	if err := bs.invocationService.SendUrgentRequest(ctx, inv); err != nil {
+		bs.deregister(conn)
+		return err
+	}
+	if _, err := inv.GetWithContext(ctx); err != nil {
+		// invocations with an event handler are not removed when they fail
+		bs.deregister(conn)
+		return err
+	}
+	// the invocations sent over the connection can request the backup acknowledgements from now on
+	conn.enableBackupAcks()
+	return nil
+}
+
+// deregister removes the listener registration of the connection from the invocation service.
+// There is no request to remove the listener from the member, since the member removes it when the connection is closed.
+func (bs *BackupListenerService) deregister(conn *Connection) {
+	bs.mu.Lock()
+	correlationID, ok := bs.correlationIDs[conn.connectionID]
+	delete(bs.correlationIDs, conn.connectionID)
+	bs.mu.Unlock()
+	if !ok {
+		return
+	}
+	if err := bs.invocationService.Remove(correlationID); err != nil {
+		bs.logger.Debug(func() string {
+			return fmt.Sprintf("%d: removing backup listener: %s", conn.connectionID, err.Error())
+		})
+	}
+}
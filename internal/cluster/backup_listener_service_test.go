@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cluster
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pubcluster "github.com/hazelcast/hazelcast-go-client/cluster"
+	"github.com/hazelcast/hazelcast-go-client/internal/invocation"
+	"github.com/hazelcast/hazelcast-go-client/internal/logger"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+func TestBackupListenerService_ConnectionClosed(t *testing.T) {
+	invoker := newTestBackupListenerInvoker(nil)
+	bs := newTestBackupListenerService(invoker)
+	conn := &Connection{connectionID: 1, memberUUID: valueOf(types.NewUUID())}
+	bs.handleConnectionEvent(NewConnectionOpened(conn))
+	require.True(t, conn.backupAcksEnabled())
+	assert.Equal(t, 1, bs.registrationCount())
+	bs.handleConnectionEvent(NewConnectionClosed(conn, nil))
+	assert.Equal(t, 0, bs.registrationCount())
+	// the registration is removed from the invocation service
+	require.Len(t, invoker.sentIDs(), 1)
+	assert.Equal(t, invoker.sentIDs(), invoker.removedIDs())
+}
+
+func TestBackupListenerService_RegistrationFailed(t *testing.T) {
+	invoker := newTestBackupListenerInvoker(errors.New("registration failed"))
+	bs := newTestBackupListenerService(invoker)
+	conn := &Connection{connectionID: 1, memberUUID: valueOf(types.NewUUID())}
+	bs.handleConnectionEvent(NewConnectionOpened(conn))
+	assert.False(t, conn.backupAcksEnabled())
+	assert.Equal(t, 0, bs.registrationCount())
+	require.Len(t, invoker.sentIDs(), 1)
+	assert.Equal(t, invoker.sentIDs(), invoker.removedIDs())
+}
+
+func newTestBackupListenerService(invoker *testBackupListenerInvoker) *BackupListenerService {
+	config := &pubcluster.Config{InvocationTimeout: types.Duration(time.Minute)}
+	return &BackupListenerService{
+		invocationFactory: NewConnectionInvocationFactory(config),
+		invocationService: invoker,
+		logger:            logger.LogAdaptor{Logger: logger.New()},
+		mu:                &sync.Mutex{},
+		correlationIDs:    map[int64]int64{},
+	}
+}
+
+func (bs *BackupListenerService) registrationCount() int {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+	return len(bs.correlationIDs)
+}
+
+// testBackupListenerInvoker completes the sent invocations with the given error, or with an empty response.
+type testBackupListenerInvoker struct {
+	err     error
+	mu      *sync.Mutex
+	sent    []int64
+	removed []int64
+}
+
+func newTestBackupListenerInvoker(err error) *testBackupListenerInvoker {
+	return &testBackupListenerInvoker{err: err, mu: &sync.Mutex{}}
+}
+
+func (iv *testBackupListenerInvoker) SendUrgentRequest(ctx context.Context, inv invocation.Invocation) error {
+	iv.mu.Lock()
+	iv.sent = append(iv.sent, inv.Request().CorrelationID())
+	iv.mu.Unlock()
+	if iv.err != nil {
+		inv.Complete(&proto.ClientMessage{Err: iv.err})
+	} else {
+		inv.Complete(proto.NewClientMessage(proto.NewFrame(make([]byte, proto.ResponseBackupAcksOffset+proto.ByteSizeInBytes))))
+	}
+	return nil
+}
+
+func (iv *testBackupListenerInvoker) Remove(correlationID int64) error {
+	iv.mu.Lock()
+	iv.removed = append(iv.removed, correlationID)
+	iv.mu.Unlock()
+	return nil
+}
+
+func (iv *testBackupListenerInvoker) sentIDs() []int64 {
+	iv.mu.Lock()
+	defer iv.mu.Unlock()
+	return append([]int64(nil), iv.sent...)
+}
+
+func (iv *testBackupListenerInvoker) removedIDs() []int64 {
+	iv.mu.Lock()
+	defer iv.mu.Unlock()
+	return append([]int64(nil), iv.removed...)
+}
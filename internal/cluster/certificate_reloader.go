@@ -0,0 +1,143 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cluster
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	pubcluster "github.com/hazelcast/hazelcast-go-client/cluster"
+)
+
+// fileStamp is used to detect changes to a file.
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+// certificateReloader keeps the TLS configuration up to date with the certificate files of an SSL configuration.
+type certificateReloader struct {
+	ssl       *pubcluster.SSLConfig
+	mu        *sync.RWMutex
+	tlsConfig *tls.Config
+	stamps    map[string]fileStamp
+	// notAfter is the earliest expiry time of the client certificates, it is zero if there are no client certificates.
+	notAfter time.Time
+}
+
+func newCertificateReloader(ssl *pubcluster.SSLConfig) *certificateReloader {
+	cfg := ssl.TLSConfig()
+	return &certificateReloader{
+		ssl:       ssl,
+		mu:        &sync.RWMutex{},
+		tlsConfig: cfg,
+		stamps:    certificateFileStamps(ssl.CertificateFiles()),
+		notAfter:  certificatesNotAfter(cfg.Certificates),
+	}
+}
+
+// TLSConfig returns the TLS configuration with the latest certificates.
+func (r *certificateReloader) TLSConfig() *tls.Config {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.tlsConfig
+}
+
+// Reload loads the certificates if any of the certificate files has changed,
+// or a client certificate expires before the next reload.
+// It returns true if the certificates were reloaded.
+// If the certificates cannot be loaded, the previous ones are kept and the reload is attempted again on the next call.
+// An error is returned if an expiring client certificate was not renewed.
+func (r *certificateReloader) Reload() (bool, error) {
+	stamps := certificateFileStamps(r.ssl.CertificateFiles())
+	r.mu.RLock()
+	changed := !equalFileStamps(stamps, r.stamps)
+	notAfter := r.notAfter
+	r.mu.RUnlock()
+	// the file stamps may not change if a certificate is renewed in place, so the expiring certificates are always reloaded
+	expiring := !notAfter.IsZero() && time.Now().Add(time.Duration(r.ssl.CertificateReloadInterval)).After(notAfter)
+	if !changed && !expiring {
+		return false, nil
+	}
+	cfg, err := r.ssl.ReloadTLSConfig()
+	if err != nil {
+		// the files may be in the middle of an update
+		return false, err
+	}
+	newNotAfter := certificatesNotAfter(cfg.Certificates)
+	if !changed && newNotAfter.Equal(notAfter) {
+		return false, fmt.Errorf("client certificate expires at %s and it was not renewed", notAfter.Format(time.RFC3339))
+	}
+	r.mu.Lock()
+	r.tlsConfig = cfg
+	r.stamps = stamps
+	r.notAfter = newNotAfter
+	r.mu.Unlock()
+	return true, nil
+}
+
+// certificatesNotAfter returns the earliest expiry time of the given certificates.
+// It returns the zero time if there are no certificates.
+func certificatesNotAfter(certs []tls.Certificate) time.Time {
+	var notAfter time.Time
+	for _, cert := range certs {
+		leaf := cert.Leaf
+		if leaf == nil {
+			if len(cert.Certificate) == 0 {
+				continue
+			}
+			var err error
+			if leaf, err = x509.ParseCertificate(cert.Certificate[0]); err != nil {
+				continue
+			}
+		}
+		if notAfter.IsZero() || leaf.NotAfter.Before(notAfter) {
+			notAfter = leaf.NotAfter
+		}
+	}
+	return notAfter
+}
+
+func certificateFileStamps(paths []string) map[string]fileStamp {
+	stamps := make(map[string]fileStamp, len(paths))
+	for _, path := range paths {
+		// a missing file is recorded with the zero stamp, so it is reloaded once it is created again
+		var stamp fileStamp
+		if fi, err := os.Stat(path); err == nil {
+			stamp = fileStamp{modTime: fi.ModTime(), size: fi.Size()}
+		}
+		stamps[path] = stamp
+	}
+	return stamps
+}
+
+func equalFileStamps(a, b map[string]fileStamp) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for path, stamp := range a {
+		other, ok := b[path]
+		if !ok || !stamp.modTime.Equal(other.modTime) || stamp.size != other.size {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,159 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cluster
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	pubcluster "github.com/hazelcast/hazelcast-go-client/cluster"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+func TestCertificateReloader_Reload(t *testing.T) {
+	dir := t.TempDir()
+	caPath := filepath.Join(dir, "ca.pem")
+	copyFile(t, "../../cluster/testdata/server1-cert.pem", caPath)
+	ssl := &pubcluster.SSLConfig{Enabled: true}
+	require.NoError(t, ssl.SetCAPath(caPath))
+	r := newCertificateReloader(ssl)
+	initial := r.TLSConfig()
+	reloaded, err := r.Reload()
+	require.NoError(t, err)
+	require.False(t, reloaded)
+	require.Same(t, initial, r.TLSConfig())
+	// an invalid file does not replace the current configuration
+	require.NoError(t, os.WriteFile(caPath, []byte("invalid"), 0600))
+	setModTime(t, caPath, time.Now().Add(time.Minute))
+	reloaded, err = r.Reload()
+	require.Error(t, err)
+	require.False(t, reloaded)
+	require.Same(t, initial, r.TLSConfig())
+	copyFile(t, "../../cluster/testdata/server2-cert.pem", caPath)
+	setModTime(t, caPath, time.Now().Add(2*time.Minute))
+	reloaded, err = r.Reload()
+	require.NoError(t, err)
+	require.True(t, reloaded)
+	require.NotSame(t, initial, r.TLSConfig())
+	require.False(t, initial.RootCAs.Equal(r.TLSConfig().RootCAs))
+}
+
+func TestCertificateReloader_ReloadExpiring(t *testing.T) {
+	dir := t.TempDir()
+	certPath := filepath.Join(dir, "cert.pem")
+	keyPath := filepath.Join(dir, "key.pem")
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	expiry := time.Now().Add(30 * time.Minute).Truncate(time.Second)
+	writeCertificate(t, certPath, key, expiry)
+	writeKey(t, keyPath, key)
+	modTime := time.Now().Add(-time.Hour)
+	setModTime(t, certPath, modTime)
+	ssl := &pubcluster.SSLConfig{Enabled: true, CertificateReloadInterval: types.Duration(time.Hour)}
+	require.NoError(t, ssl.AddClientCertAndKeyPath(certPath, keyPath))
+	r := newCertificateReloader(ssl)
+	initial := r.TLSConfig()
+	// the certificate expires before the next check, but it was not renewed
+	reloaded, err := r.Reload()
+	require.Error(t, err)
+	require.False(t, reloaded)
+	require.Same(t, initial, r.TLSConfig())
+	// the renewed certificate has the same size and modification time
+	renewedExpiry := expiry.Add(24 * time.Hour)
+	writeCertificate(t, certPath, key, renewedExpiry)
+	setModTime(t, certPath, modTime)
+	require.True(t, equalFileStamps(r.stamps, certificateFileStamps(ssl.CertificateFiles())))
+	reloaded, err = r.Reload()
+	require.NoError(t, err)
+	require.True(t, reloaded)
+	require.True(t, renewedExpiry.Equal(certificatesNotAfter(r.TLSConfig().Certificates)))
+	// the renewed certificate does not expire before the next check
+	reloaded, err = r.Reload()
+	require.NoError(t, err)
+	require.False(t, reloaded)
+}
+
+func TestCertificateReloader_ReloadTruncatedEncryptedKey(t *testing.T) {
+	dir := t.TempDir()
+	certPath := filepath.Join(dir, "cert.pem")
+	keyPath := filepath.Join(dir, "key.pem")
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	writeCertificate(t, certPath, key, time.Now().Add(24*time.Hour))
+	writeEncryptedKey(t, keyPath, key, "password")
+	ssl := &pubcluster.SSLConfig{Enabled: true}
+	require.NoError(t, ssl.AddClientCertAndEncryptedKeyPath(certPath, keyPath, "password"))
+	r := newCertificateReloader(ssl)
+	initial := r.TLSConfig()
+	// the key file is in the middle of an update
+	b, err := os.ReadFile(keyPath)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(keyPath, b[:len(b)/2], 0600))
+	setModTime(t, keyPath, time.Now().Add(time.Minute))
+	reloaded, err := r.Reload()
+	require.Error(t, err)
+	require.False(t, reloaded)
+	require.Same(t, initial, r.TLSConfig())
+}
+
+func writeCertificate(t *testing.T, path string, key crypto.Signer, notAfter time.Time) {
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "client"},
+		NotBefore:    notAfter.Add(-48 * time.Hour),
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, key.Public(), key)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+}
+
+func writeKey(t *testing.T, path string, key ed25519.PrivateKey) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0600))
+}
+
+func writeEncryptedKey(t *testing.T, path string, key *rsa.PrivateKey, password string) {
+	block, err := x509.EncryptPEMBlock(rand.Reader, "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(key), []byte(password), x509.PEMCipherAES256)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(block), 0600))
+}
+
+func copyFile(t *testing.T, src, dst string) {
+	b, err := os.ReadFile(src)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(dst, b, 0600))
+}
+
+func setModTime(t *testing.T, path string, modTime time.Time) {
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
@@ -74,6 +74,7 @@ type Connection struct {
 	connectionID              int64
 	connectedServerVersion    int32
 	status                    int32
+	backupAcks                int32
 }
 
 func (c *Connection) ConnectionID() int64 {
@@ -108,8 +109,13 @@ func (c *Connection) setMemberUUID(uuid types.UUID) {
 	c.memberUUID.Store(uuid)
 }
 
-func (c *Connection) start(networkCfg *pubcluster.NetworkConfig, addr pubcluster.Address) error {
-	socket, err := c.createSocket(networkCfg, addr)
+// OutstandingInvocations returns the number of invocations sent over this connection which are not completed yet.
+func (c *Connection) OutstandingInvocations() int {
+	return c.invocationService.OutstandingInvocations(c.connectionID)
+}
+
+func (c *Connection) start(networkCfg *pubcluster.NetworkConfig, tlsConfig *tls.Config, addr pubcluster.Address) error {
+	socket, err := c.createSocket(networkCfg, tlsConfig, addr)
 	if err != nil {
 		return err
 	}
@@ -136,7 +142,7 @@ func (c *Connection) sendProtocolStarter() error {
 	return err
 }
 
-func (c *Connection) createSocket(networkCfg *pubcluster.NetworkConfig, address pubcluster.Address) (net.Conn, error) {
+func (c *Connection) createSocket(networkCfg *pubcluster.NetworkConfig, tlsConfig *tls.Config, address pubcluster.Address) (net.Conn, error) {
 	conTimeout := positiveDurationOrMax(time.Duration(networkCfg.ConnectionTimeout))
 	if socket, err := c.dialToAddressWithTimeout(address, conTimeout); err != nil {
 		return nil, err
@@ -147,7 +153,7 @@ func (c *Connection) createSocket(networkCfg *pubcluster.NetworkConfig, address
 		c.logger.Debug(func() string {
 			return fmt.Sprintf("%d: SSL is enabled for connection", c.connectionID)
 		})
-		tlsCon := tls.Client(socket, networkCfg.SSL.TLSConfig())
+		tlsCon := tls.Client(socket, tlsConfig)
 		if err = tlsCon.Handshake(); err != nil {
 			return nil, err
 		}
@@ -288,6 +294,15 @@ func (c *Connection) send(inv invocation.Invocation) bool {
 	}
 }
 
+func (c *Connection) enableBackupAcks() {
+	atomic.StoreInt32(&c.backupAcks, 1)
+}
+
+// backupAcksEnabled returns true if the member sends the backup acknowledgements to the client over this connection.
+func (c *Connection) backupAcksEnabled() bool {
+	return atomic.LoadInt32(&c.backupAcks) == 1
+}
+
 func (c *Connection) write(clientMessage *proto.ClientMessage) error {
 	c.logger.Trace(func() string {
 		return fmt.Sprintf("%d: writing invocation with correlation ID: %d", c.connectionID, clientMessage.CorrelationID())
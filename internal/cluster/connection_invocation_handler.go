@@ -55,6 +55,7 @@ type ConnectionInvocationHandler struct {
 	connectionManager *ConnectionManager
 	clusterService    *Service
 	smart             bool
+	backupAcks        bool
 }
 
 func NewConnectionInvocationHandler(bundle ConnectionInvocationHandlerCreationBundle) *ConnectionInvocationHandler {
@@ -64,6 +65,7 @@ func NewConnectionInvocationHandler(bundle ConnectionInvocationHandlerCreationBu
 		clusterService:    bundle.ClusterService,
 		logger:            bundle.Logger,
 		smart:             !bundle.Config.Unisocket,
+		backupAcks:        BackupAckTimeout(bundle.Config) > 0,
 	}
 }
 
@@ -105,6 +107,11 @@ func (h *ConnectionInvocationHandler) invokeNonSmart(inv invocation.Invocation)
 }
 
 func (h *ConnectionInvocationHandler) sendToConnection(inv invocation.Invocation, conn *Connection) (int64, error) {
+	// listener requests may be shared between connections, so they are not modified
+	if h.backupAcks && inv.EventHandler() == nil {
+		// the invocation may be retried over a connection without the backup listener
+		inv.Request().SetBackupAware(conn.backupAcksEnabled())
+	}
 	if sent := conn.send(inv); !sent {
 		return 0, ihzerrors.NewIOError("packet not sent", nil)
 	}
@@ -18,10 +18,12 @@ package cluster
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"math"
 	"math/rand"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -58,6 +60,8 @@ const (
 const (
 	serializationVersion  = 1
 	initialMembersTimeout = 120 * time.Second
+	// recycleConnectionTimeout is the maximum time to wait for a recycled connection to be replaced and drained.
+	recycleConnectionTimeout = 30 * time.Second
 )
 
 var connectionManagerSubID = event.NextSubscriptionID()
@@ -138,6 +142,7 @@ type ConnectionManager struct {
 	clusterID            *types.UUID
 	prevClusterID        *types.UUID
 	failoverService      *FailoverService
+	certReloaders        map[*pubcluster.NetworkConfig]*certificateReloader
 	randGen              *rand.Rand
 	invoker              RandomTargetInvoker
 	clientName           string
@@ -167,6 +172,14 @@ func NewConnectionManager(bundle ConnectionManagerCreationBundle) *ConnectionMan
 		clusterIDMu:          &sync.Mutex{},
 		randGen:              rand.New(rand.NewSource(time.Now().Unix())),
 		doneChMu:             &sync.RWMutex{},
+		certReloaders:        makeCertificateReloaders(bundle.FailoverService),
+	}
+	manager.connMap.memberInfo = bundle.ClusterService.GetMemberByUUID
+	if s, ok := bundle.ClusterConfig.LoadBalancer().(pubcluster.LoadBalancerStarter); ok {
+		s.Start(pubcluster.LoadBalancerOptions{
+			OutstandingInvocations: manager.outstandingInvocations,
+			Labels:                 bundle.Labels,
+		})
 	}
 	return manager
 }
@@ -211,6 +224,10 @@ func (m *ConnectionManager) start(ctx context.Context) error {
 	m.invocationService.Pause(false)
 	m.eventDispatcher.Publish(lifecycle.NewLifecycleStateChanged(lifecycle.StateConnected))
 	atomic.StoreInt32(&m.state, ready)
+	doneCh := m.getDoneCh()
+	for networkCfg, r := range m.certReloaders {
+		go m.reloadCertificates(doneCh, r, networkCfg)
+	}
 	return nil
 }
 
@@ -334,6 +351,18 @@ func (m *ConnectionManager) ActiveConnections() []*Connection {
 	return m.connMap.ActiveConnections()
 }
 
+// outstandingInvocations returns the number of outstanding invocations of the connection to the given member.
+// It is called by the load balancer while the connection map is locked, so it must not lock the connection map.
+func (m *ConnectionManager) outstandingInvocations(member types.UUID) int {
+	if m.invocationService == nil {
+		return 0
+	}
+	if connID, ok := m.connMap.ConnectionIDForUUID(member); ok {
+		return m.invocationService.OutstandingInvocations(connID)
+	}
+	return 0
+}
+
 func (m *ConnectionManager) RandomConnection() *Connection {
 	return m.connMap.RandomConn()
 }
@@ -489,7 +518,7 @@ func (m *ConnectionManager) connectCluster(ctx context.Context, cluster *Candida
 
 func (m *ConnectionManager) ensureConnection(ctx context.Context, addr pubcluster.Address, networkCfg *pubcluster.NetworkConfig) (*Connection, error) {
 	conn := m.createDefaultConnection()
-	if err := conn.start(networkCfg, addr); err != nil {
+	if err := conn.start(networkCfg, m.tlsConfig(networkCfg), addr); err != nil {
 		return nil, ihzerrors.NewTargetDisconnectedError(err.Error(), err)
 	}
 	if err := m.authenticate(ctx, conn); err != nil {
@@ -512,6 +541,18 @@ func (m *ConnectionManager) createDefaultConnection() *Connection {
 }
 
 func (m *ConnectionManager) authenticate(ctx context.Context, conn *Connection) error {
+	conn, err := m.authenticateConnection(ctx, conn, nil)
+	if err != nil {
+		return err
+	}
+	m.eventDispatcher.Publish(NewConnectionOpened(conn))
+	return nil
+}
+
+// authenticateConnection authenticates the connection and adds it to the connection map.
+// If replaced is not nil, the connection takes the place of the replaced connection to the same member.
+// The connection opened event is not published.
+func (m *ConnectionManager) authenticateConnection(ctx context.Context, conn *Connection, replaced *Connection) (*Connection, error) {
 	cluster := m.failoverService.Current()
 	m.logger.Debug(func() string {
 		return fmt.Sprintf("authenticate: cluster name: %s; local: %s; remote: %s; addr: %s",
@@ -519,27 +560,33 @@ func (m *ConnectionManager) authenticate(ctx context.Context, conn *Connection)
 	})
 	credentials := cluster.Credentials
 	credentials.SetEndpoint(conn.LocalAddr())
-	request := m.encodeAuthenticationRequest()
+	request, err := m.encodeAuthenticationRequest(ctx, cluster.ClusterName, credentials)
+	if err != nil {
+		return nil, fmt.Errorf("authenticating: %w", err)
+	}
 	inv := m.invocationFactory.NewConnectionBoundInvocation(request, conn, nil, time.Now())
 	m.logger.Debug(func() string {
 		return fmt.Sprintf("authentication correlation ID: %d", inv.Request().CorrelationID())
 	})
 	if err := m.invocationService.SendUrgentRequest(ctx, inv); err != nil {
-		return fmt.Errorf("authenticating: %w", err)
+		return nil, fmt.Errorf("authenticating: %w", err)
 	}
 	result, err := inv.GetWithContext(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	conn, err = m.processAuthenticationResult(conn, result)
+	conn, err = m.processAuthenticationResult(conn, result, replaced)
 	if err != nil {
-		return err
+		if tc, ok := credentials.(*security.TokenCredentials); ok && errors.Is(err, hzerrors.ErrAuthentication) {
+			// the token may be revoked, request a new one for the next attempt
+			tc.Invalidate()
+		}
+		return nil, err
 	}
-	m.eventDispatcher.Publish(NewConnectionOpened(conn))
-	return nil
+	return conn, nil
 }
 
-func (m *ConnectionManager) processAuthenticationResult(conn *Connection, result *proto.ClientMessage) (*Connection, error) {
+func (m *ConnectionManager) processAuthenticationResult(conn *Connection, result *proto.ClientMessage, replaced *Connection) (*Connection, error) {
 	status, address, uuid, _, serverHazelcastVersion, partitionCount, newClusterID, failoverSupported := codec.DecodeClientAuthenticationResponse(result)
 	if m.failoverConfig.Enabled && !failoverSupported {
 		m.logger.Warnf("cluster does not support failover: this feature is available in Hazelcast Enterprise")
@@ -577,6 +624,17 @@ func (m *ConnectionManager) processAuthenticationResult(conn *Connection, result
 			m.clusterID = &newClusterID
 		}
 		m.clusterIDMu.Unlock()
+		if replaced != nil {
+			if !m.connMap.ReplaceConnection(replaced, conn, *address) {
+				// the replaced connection was closed or the member was restarted in the meantime
+				conn.close(nil)
+				return nil, fmt.Errorf("connection %d to member %s cannot be replaced: %w", replaced.ConnectionID(), replaced.MemberUUID(), hzerrors.ErrIllegalState)
+			}
+			m.logger.Debug(func() string {
+				return fmt.Sprintf("replaced connection %d to: %s", replaced.ConnectionID(), *address)
+			})
+			return conn, nil
+		}
 		if oldConn, ok := m.connMap.GetOrAddConnection(conn, *address); !ok {
 			// there is already a connection to this member
 			m.logger.Infof("duplicate connection to the same member with UUID: %s", conn.MemberUUID())
@@ -597,13 +655,24 @@ func (m *ConnectionManager) processAuthenticationResult(conn *Connection, result
 	return nil, hzerrors.ErrAuthentication
 }
 
-func (m *ConnectionManager) encodeAuthenticationRequest() *proto.ClientMessage {
-	clusterName := m.failoverService.Current().ClusterName
-	credentials := m.failoverService.Current().Credentials
-	if creds, ok := credentials.(*security.UsernamePasswordCredentials); ok {
-		return m.createAuthenticationRequest(clusterName, creds)
+func (m *ConnectionManager) encodeAuthenticationRequest(ctx context.Context, clusterName string, credentials security.Credentials) (*proto.ClientMessage, error) {
+	switch creds := credentials.(type) {
+	case *security.UsernamePasswordCredentials:
+		return m.createAuthenticationRequest(clusterName, creds), nil
+	case *security.TokenCredentials:
+		token, err := creds.Token(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("getting the authentication token: %w", err)
+		}
+		return m.createCustomAuthenticationRequest(clusterName, token), nil
+	case *security.CustomCredentials:
+		data, err := m.serializationService.ToData(creds.Value())
+		if err != nil {
+			return nil, fmt.Errorf("serializing the credentials: %w", err)
+		}
+		return m.createCustomAuthenticationRequest(clusterName, data.ToByteArray()), nil
 	}
-	panic("only username password credentials are supported")
+	panic(fmt.Sprintf("unsupported credentials: %T", credentials))
 }
 
 func (m *ConnectionManager) createAuthenticationRequest(clusterName string, creds *security.UsernamePasswordCredentials) *proto.ClientMessage {
@@ -620,6 +689,19 @@ func (m *ConnectionManager) createAuthenticationRequest(clusterName string, cred
 	)
 }
 
+func (m *ConnectionManager) createCustomAuthenticationRequest(clusterName string, credentials []byte) *proto.ClientMessage {
+	return codec.EncodeClientAuthenticationCustomRequest(
+		clusterName,
+		credentials,
+		m.clientUUID,
+		internal.ClientType,
+		byte(serializationVersion),
+		internal.ClientVersion,
+		m.clientName,
+		m.labels,
+	)
+}
+
 func (m *ConnectionManager) syncConnections() {
 	ticker := time.NewTicker(1 * time.Second)
 	defer ticker.Stop()
@@ -640,6 +722,109 @@ func (m *ConnectionManager) getDoneCh() <-chan struct{} {
 	return ch
 }
 
+// tlsConfig returns the TLS configuration for new connections using the given network configuration.
+func (m *ConnectionManager) tlsConfig(networkCfg *pubcluster.NetworkConfig) *tls.Config {
+	if r, ok := m.certReloaders[networkCfg]; ok {
+		return r.TLSConfig()
+	}
+	return networkCfg.SSL.TLSConfig()
+}
+
+// reloadCertificates checks the certificate files periodically and reloads them if they have changed.
+func (m *ConnectionManager) reloadCertificates(doneCh <-chan struct{}, r *certificateReloader, networkCfg *pubcluster.NetworkConfig) {
+	ticker := time.NewTicker(time.Duration(networkCfg.SSL.CertificateReloadInterval))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-doneCh:
+			return
+		case <-ticker.C:
+			reloaded, err := r.Reload()
+			if err != nil {
+				m.logger.Warnf("cluster.ConnectionManager: reloading TLS certificates: %s", err.Error())
+				continue
+			}
+			if !reloaded {
+				continue
+			}
+			m.logger.Infof("reloaded TLS certificates")
+			if networkCfg.SSL.RecycleConnections && m.networkConfig() == networkCfg {
+				m.recycleConnections(doneCh, networkCfg)
+			}
+		}
+	}
+}
+
+// recycleConnections replaces the active connections one at a time with connections which use the reloaded certificates.
+// A connection is closed only after its replacement is opened, so the client stays connected to the cluster.
+func (m *ConnectionManager) recycleConnections(doneCh <-chan struct{}, networkCfg *pubcluster.NetworkConfig) {
+	for _, conn := range m.connMap.ActiveConnections() {
+		select {
+		case <-doneCh:
+			return
+		default:
+		}
+		m.logger.Debug(func() string {
+			return fmt.Sprintf("cluster.ConnectionManager: recycling connection %d to %s", conn.ConnectionID(), conn.Endpoint())
+		})
+		if err := m.recycleConnection(doneCh, conn, networkCfg); err != nil {
+			// the old connection is kept, it is used until it is closed for another reason
+			m.logger.Warnf("cluster.ConnectionManager: recycling connection %d to %s: %s", conn.ConnectionID(), conn.Endpoint(), err.Error())
+		}
+	}
+}
+
+// recycleConnection opens a new connection to the member of the old connection and replaces the old connection with it.
+// The old connection is closed once the invocations sent over it are completed, or recycleConnectionTimeout passes.
+func (m *ConnectionManager) recycleConnection(doneCh <-chan struct{}, old *Connection, networkCfg *pubcluster.NetworkConfig) error {
+	ctx, cancel := context.WithTimeout(context.Background(), recycleConnectionTimeout)
+	defer cancel()
+	conn := m.createDefaultConnection()
+	if err := conn.start(networkCfg, m.tlsConfig(networkCfg), old.Endpoint()); err != nil {
+		return ihzerrors.NewTargetDisconnectedError(err.Error(), err)
+	}
+	if _, err := m.authenticateConnection(ctx, conn, old); err != nil {
+		conn.close(nil)
+		return err
+	}
+	// new invocations are not sent over the old connection after it is replaced.
+	m.waitOutstandingInvocations(doneCh, old)
+	// the old connection is closed before the new one is announced,
+	// so the listeners of the member are registered on the new connection.
+	old.close(nil)
+	m.eventDispatcher.Publish(NewConnectionOpened(conn))
+	return nil
+}
+
+// waitOutstandingInvocations waits until the invocations sent over the connection are completed.
+// It returns early if recycleConnectionTimeout passes or the connection manager is stopped.
+func (m *ConnectionManager) waitOutstandingInvocations(doneCh <-chan struct{}, conn *Connection) {
+	timer := time.NewTimer(recycleConnectionTimeout)
+	defer timer.Stop()
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for conn.OutstandingInvocations() > 0 {
+		select {
+		case <-doneCh:
+			return
+		case <-timer.C:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func makeCertificateReloaders(fs *FailoverService) map[*pubcluster.NetworkConfig]*certificateReloader {
+	reloaders := map[*pubcluster.NetworkConfig]*certificateReloader{}
+	for _, cc := range fs.candidateClusters {
+		ssl := &cc.NetworkCfg.SSL
+		if ssl.Enabled && ssl.CertificateReloadInterval > 0 {
+			reloaders[cc.NetworkCfg] = newCertificateReloader(ssl)
+		}
+	}
+	return reloaders
+}
+
 func (m *ConnectionManager) networkConfig() *pubcluster.NetworkConfig {
 	return m.failoverService.Current().NetworkCfg
 }
@@ -717,21 +902,29 @@ func (m *ConnectionManager) sendAllSchemas(ctx context.Context, schemas []*iseri
 }
 
 type connectionMap struct {
-	lb pubcluster.LoadBalancer
-	mu *sync.RWMutex
+	lb       pubcluster.LoadBalancer
+	memberLB pubcluster.MemberAwareLoadBalancer
+	// memberInfo returns the member with the given UUID, it is required if memberLB is set
+	memberInfo func(uuid types.UUID) *pubcluster.MemberInfo
+	mu         *sync.RWMutex
 	// addrToConn maps connection address to connection
 	addrToConn map[pubcluster.Address]*Connection
 	addrs      []pubcluster.Address
 	uuidToConn map[types.UUID]*Connection
+	// connIDs maps member UUID to connection ID, it is updated with uuidToConn but read without mu
+	connIDs    *sync.Map
 	candidates map[types.UUID]struct{}
 }
 
 func newConnectionMap(lb pubcluster.LoadBalancer) *connectionMap {
+	memberLB, _ := lb.(pubcluster.MemberAwareLoadBalancer)
 	return &connectionMap{
 		lb:         lb,
+		memberLB:   memberLB,
 		mu:         &sync.RWMutex{},
 		addrToConn: map[pubcluster.Address]*Connection{},
 		uuidToConn: map[types.UUID]*Connection{},
+		connIDs:    &sync.Map{},
 		candidates: map[types.UUID]struct{}{},
 	}
 }
@@ -740,6 +933,10 @@ func (m *connectionMap) Reset() {
 	m.mu.Lock()
 	m.addrToConn = map[pubcluster.Address]*Connection{}
 	m.uuidToConn = map[types.UUID]*Connection{}
+	m.connIDs.Range(func(key, _ interface{}) bool {
+		m.connIDs.Delete(key)
+		return true
+	})
 	m.candidates = map[types.UUID]struct{}{}
 	m.addrs = nil
 	m.mu.Unlock()
@@ -755,11 +952,35 @@ func (m *connectionMap) GetOrAddConnection(conn *Connection, addr pubcluster.Add
 		return old, false
 	}
 	m.uuidToConn[conn.MemberUUID()] = conn
+	m.connIDs.Store(conn.MemberUUID(), conn.connectionID)
 	m.addrToConn[addr] = conn
 	m.addrs = append(m.addrs, addr)
 	return conn, true
 }
 
+// ReplaceConnection replaces the old connection with the given connection to the same member.
+// It returns false if the old connection is not the current connection to the member of the given connection.
+func (m *connectionMap) ReplaceConnection(old *Connection, conn *Connection, addr pubcluster.Address) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	current, ok := m.uuidToConn[conn.MemberUUID()]
+	if !ok || current.connectionID != old.connectionID {
+		return false
+	}
+	for a, c := range m.addrToConn {
+		if c.connectionID == old.connectionID {
+			delete(m.addrToConn, a)
+			m.removeAddr(a)
+			break
+		}
+	}
+	m.uuidToConn[conn.MemberUUID()] = conn
+	m.connIDs.Store(conn.MemberUUID(), conn.connectionID)
+	m.addrToConn[addr] = conn
+	m.addrs = append(m.addrs, addr)
+	return true
+}
+
 // RemoveConnection removes a connection and returns the number of remaining connections.
 func (m *connectionMap) RemoveConnection(removedConn *Connection) int {
 	m.mu.Lock()
@@ -768,6 +989,7 @@ func (m *connectionMap) RemoveConnection(removedConn *Connection) int {
 		if conn.connectionID == removedConn.connectionID {
 			delete(m.addrToConn, addr)
 			delete(m.uuidToConn, conn.MemberUUID())
+			m.connIDs.Delete(conn.MemberUUID())
 			m.removeAddr(addr)
 			break
 		}
@@ -792,6 +1014,16 @@ func (m *connectionMap) GetConnectionForUUID(uuid types.UUID) *Connection {
 	return conn
 }
 
+// ConnectionIDForUUID returns the ID of the connection to the given member.
+// It does not lock the connection map, so it can be called by the load balancer.
+func (m *connectionMap) ConnectionIDForUUID(uuid types.UUID) (int64, bool) {
+	connID, ok := m.connIDs.Load(uuid)
+	if !ok {
+		return 0, false
+	}
+	return connID.(int64), true
+}
+
 func (m *connectionMap) GetConnectionForAddr(addr pubcluster.Address) *Connection {
 	m.mu.RLock()
 	conn := m.addrToConn[addr]
@@ -800,6 +1032,11 @@ func (m *connectionMap) GetConnectionForAddr(addr pubcluster.Address) *Connectio
 }
 
 func (m *connectionMap) RandomConn() *Connection {
+	if m.memberLB != nil && m.memberInfo != nil {
+		if conn := m.memberAwareConn(); conn != nil {
+			return conn
+		}
+	}
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	if len(m.addrs) == 0 {
@@ -827,6 +1064,45 @@ func (m *connectionMap) RandomConn() *Connection {
 	return nil
 }
 
+// memberAwareConn selects a connection using the member aware load balancer.
+// It returns nil if there are less than two connections or their members are not known.
+func (m *connectionMap) memberAwareConn() *Connection {
+	m.mu.RLock()
+	uuids := make([]types.UUID, 0, len(m.uuidToConn))
+	for uuid, conn := range m.uuidToConn {
+		if conn.isAlive() {
+			uuids = append(uuids, uuid)
+		}
+	}
+	m.mu.RUnlock()
+	if len(uuids) < 2 {
+		return nil
+	}
+	// members are looked up without holding the lock, since the cluster service has its own locks
+	members := make([]pubcluster.MemberInfo, 0, len(uuids))
+	for _, uuid := range uuids {
+		if mi := m.memberInfo(uuid); mi != nil {
+			members = append(members, *mi)
+		}
+	}
+	if len(members) == 0 {
+		return nil
+	}
+	// the connections are stored in a map, the members are sorted so the load balancer sees them in the same order every time
+	sort.Slice(members, func(i, j int) bool {
+		return uuidLess(members[i].UUID, members[j].UUID)
+	})
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	// load balancer mutates its own state
+	// so OneOfMembers should be called under write lock
+	member := m.memberLB.OneOfMembers(members)
+	if conn := m.uuidToConn[member.UUID]; conn != nil && conn.isAlive() {
+		return conn
+	}
+	return nil
+}
+
 func (m *connectionMap) ActiveConnections() []*Connection {
 	m.mu.RLock()
 	conns := make([]*Connection, 0, len(m.uuidToConn))
@@ -918,6 +1194,13 @@ func (m *connectionMap) removeAddr(addr pubcluster.Address) {
 	}
 }
 
+func uuidLess(a, b types.UUID) bool {
+	if a.MostSignificantBits() != b.MostSignificantBits() {
+		return a.MostSignificantBits() < b.MostSignificantBits()
+	}
+	return a.LeastSignificantBits() < b.LeastSignificantBits()
+}
+
 func nonRetryableConnectionErr(err error) bool {
 	var ne cb.NonRetryableError
 	return ne.Is(err) || errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled)
@@ -17,12 +17,21 @@
 package cluster
 
 import (
+	"context"
+	"fmt"
+	"sort"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	pubcluster "github.com/hazelcast/hazelcast-go-client/cluster"
+	"github.com/hazelcast/hazelcast-go-client/internal/event"
+	"github.com/hazelcast/hazelcast-go-client/internal/invocation"
+	"github.com/hazelcast/hazelcast-go-client/internal/logger"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
 	"github.com/hazelcast/hazelcast-go-client/types"
 )
 
@@ -53,6 +62,152 @@ func TestRemoveAddr(t *testing.T) {
 	}
 }
 
+func TestReplaceConnection(t *testing.T) {
+	cm := newConnectionMap(pubcluster.NewRoundRobinLoadBalancer())
+	uuid := types.NewUUID()
+	old := &Connection{connectionID: 1, memberUUID: valueOf(uuid), status: open}
+	cm.GetOrAddConnection(old, "1.2.3.4:5701")
+	conn := &Connection{connectionID: 2, memberUUID: valueOf(uuid), status: open}
+	assert.True(t, cm.ReplaceConnection(old, conn, "1.2.3.4:5701"))
+	assert.Same(t, conn, cm.GetConnectionForUUID(uuid))
+	assert.Same(t, conn, cm.GetConnectionForAddr("1.2.3.4:5701"))
+	assert.Equal(t, []pubcluster.Address{"1.2.3.4:5701"}, cm.addrs)
+	// removing the replaced connection keeps the new one
+	assert.Equal(t, 1, cm.RemoveConnection(old))
+	// the old connection is not the current connection anymore
+	other := &Connection{connectionID: 3, memberUUID: valueOf(uuid), status: open}
+	assert.False(t, cm.ReplaceConnection(old, other, "1.2.3.4:5701"))
+	assert.Same(t, conn, cm.GetConnectionForUUID(uuid))
+}
+
+func TestRandomConn_MemberAwareLoadBalancer(t *testing.T) {
+	lb := pubcluster.NewZoneAwareLoadBalancer("zone")
+	lb.Start(pubcluster.LoadBalancerOptions{Labels: []string{"zone2"}})
+	cm := newConnectionMap(lb)
+	members := map[types.UUID]*pubcluster.MemberInfo{}
+	cm.memberInfo = func(uuid types.UUID) *pubcluster.MemberInfo {
+		return members[uuid]
+	}
+	var conns []*Connection
+	for i, zone := range []string{"zone1", "zone2", "zone3"} {
+		uuid := types.NewUUID()
+		addr := pubcluster.Address(fmt.Sprintf("1.2.3.%d:5701", i))
+		conn := &Connection{memberUUID: valueOf(uuid), endpoint: valueOf(addr), status: open}
+		members[uuid] = &pubcluster.MemberInfo{UUID: uuid, Address: addr, Attributes: map[string]string{"zone": zone}}
+		cm.GetOrAddConnection(conn, addr)
+		conns = append(conns, conn)
+	}
+	for i := 0; i < 3; i++ {
+		assert.Same(t, conns[1], cm.RandomConn())
+	}
+	// the members are not known yet, so the address is used
+	cm.memberInfo = func(uuid types.UUID) *pubcluster.MemberInfo {
+		return nil
+	}
+	assert.NotNil(t, cm.RandomConn())
+}
+
+func TestRandomConn_MemberAwareLoadBalancerOrder(t *testing.T) {
+	lb := &recordingLoadBalancer{}
+	cm := newConnectionMap(lb)
+	members := map[types.UUID]*pubcluster.MemberInfo{}
+	cm.memberInfo = func(uuid types.UUID) *pubcluster.MemberInfo {
+		return members[uuid]
+	}
+	for i := 0; i < 8; i++ {
+		uuid := types.NewUUID()
+		addr := pubcluster.Address(fmt.Sprintf("1.2.3.%d:5701", i))
+		members[uuid] = &pubcluster.MemberInfo{UUID: uuid, Address: addr}
+		cm.GetOrAddConnection(&Connection{memberUUID: valueOf(uuid), endpoint: valueOf(addr), status: open}, addr)
+	}
+	for i := 0; i < 10; i++ {
+		assert.NotNil(t, cm.RandomConn())
+	}
+	assert.Len(t, lb.calls, 10)
+	for _, uuids := range lb.calls {
+		assert.Equal(t, lb.calls[0], uuids)
+		assert.True(t, sort.SliceIsSorted(uuids, func(i, j int) bool {
+			return uuidLess(uuids[i], uuids[j])
+		}))
+	}
+}
+
+func TestRandomConn_LeastOutstandingInvocationsLoadBalancer(t *testing.T) {
+	lg := logger.LogAdaptor{Logger: logger.New()}
+	ed := event.NewDispatchService(lg)
+	// all invocations are sent over the connection with ID 1
+	is := invocation.NewService(fixedGroupHandler(1), ed, lg, 0)
+	t.Cleanup(func() {
+		is.Stop()
+		_ = ed.Stop(context.Background())
+	})
+	lb := pubcluster.NewLeastOutstandingInvocationsLoadBalancer()
+	cm := newConnectionMap(lb)
+	m := &ConnectionManager{connMap: cm, invocationService: is}
+	lb.Start(pubcluster.LoadBalancerOptions{OutstandingInvocations: m.outstandingInvocations})
+	members := map[types.UUID]*pubcluster.MemberInfo{}
+	cm.memberInfo = func(uuid types.UUID) *pubcluster.MemberInfo {
+		return members[uuid]
+	}
+	conns := map[int64]*Connection{}
+	for i := int64(1); i <= 2; i++ {
+		uuid := types.NewUUID()
+		addr := pubcluster.Address(fmt.Sprintf("1.2.3.%d:5701", i))
+		members[uuid] = &pubcluster.MemberInfo{UUID: uuid, Address: addr}
+		conn := &Connection{connectionID: i, memberUUID: valueOf(uuid), endpoint: valueOf(addr), status: open}
+		cm.GetOrAddConnection(conn, addr)
+		conns[i] = conn
+	}
+	for i := int64(1); i <= 2; i++ {
+		msg := proto.NewClientMessage(proto.NewFrameWith(make([]byte, 64), proto.UnfragmentedMessage))
+		msg.SetCorrelationID(i)
+		inv := invocation.NewImpl(msg, -1, "", time.Now().Add(10*time.Second), false)
+		require.NoError(t, is.SendRequest(context.Background(), inv))
+	}
+	require.Eventually(t, func() bool {
+		return is.OutstandingInvocations(1) == 2
+	}, time.Second, 10*time.Millisecond)
+	// the load balancer looks up the outstanding invocations while the connection map is locked
+	connCh := make(chan *Connection, 4)
+	go func() {
+		for i := 0; i < 4; i++ {
+			connCh <- cm.RandomConn()
+		}
+	}()
+	for i := 0; i < 4; i++ {
+		select {
+		case conn := <-connCh:
+			assert.Same(t, conns[2], conn)
+		case <-time.After(5 * time.Second):
+			t.Fatal("RandomConn is blocked")
+		}
+	}
+}
+
+type fixedGroupHandler int64
+
+func (h fixedGroupHandler) Invoke(inv invocation.Invocation) (int64, error) {
+	return int64(h), nil
+}
+
+// recordingLoadBalancer records the order of the members it receives and returns the first one.
+type recordingLoadBalancer struct {
+	calls [][]types.UUID
+}
+
+func (lb *recordingLoadBalancer) OneOf(addrs []pubcluster.Address) pubcluster.Address {
+	return addrs[0]
+}
+
+func (lb *recordingLoadBalancer) OneOfMembers(members []pubcluster.MemberInfo) pubcluster.MemberInfo {
+	uuids := make([]types.UUID, len(members))
+	for i, m := range members {
+		uuids[i] = m.UUID
+	}
+	lb.calls = append(lb.calls, uuids)
+	return members[0]
+}
+
 func valueOf(value interface{}) atomic.Value {
 	v := atomic.Value{}
 	v.Store(value)
@@ -17,6 +17,7 @@
 package cluster
 
 import (
+	"fmt"
 	"sync/atomic"
 
 	pubcluster "github.com/hazelcast/hazelcast-go-client/cluster"
@@ -69,8 +70,26 @@ func NewFailoverService(logger logger.LogAdaptor, maxTries int, rootConfig pubcl
 	}
 }
 
-func makeCredentials(config *pubcluster.SecurityConfig) *security.UsernamePasswordCredentials {
-	return security.NewUsernamePasswordCredentials(config.Credentials.Username, config.Credentials.Password)
+func makeCredentials(config *pubcluster.SecurityConfig) security.Credentials {
+	// the pointers are replaced with values when the config is validated, they are handled in case the config was not validated
+	switch creds := config.GetCredentials().(type) {
+	case nil:
+		return security.NewUsernamePasswordCredentials(config.Credentials.Username, config.Credentials.Password)
+	case pubcluster.TokenCredentials:
+		return security.NewStaticTokenCredentials(creds.Token)
+	case *pubcluster.TokenCredentials:
+		return security.NewStaticTokenCredentials(creds.Token)
+	case pubcluster.TokenProvider:
+		return security.NewTokenCredentials(security.TokenProvider(creds))
+	case *pubcluster.TokenProvider:
+		return security.NewTokenCredentials(security.TokenProvider(*creds))
+	case pubcluster.CustomCredentials:
+		return security.NewCustomCredentials(creds.Value)
+	case *pubcluster.CustomCredentials:
+		return security.NewCustomCredentials(creds.Value)
+	default:
+		panic(fmt.Sprintf("unsupported credentials type: %T", creds))
+	}
 }
 
 func (s *FailoverService) Current() *CandidateCluster {
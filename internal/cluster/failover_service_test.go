@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pubcluster "github.com/hazelcast/hazelcast-go-client/cluster"
+	"github.com/hazelcast/hazelcast-go-client/internal/security"
+)
+
+func TestMakeCredentials(t *testing.T) {
+	testCases := []struct {
+		name        string
+		credentials pubcluster.Credentials
+		target      security.Credentials
+	}{
+		{name: "none", target: &security.UsernamePasswordCredentials{}},
+		{name: "token", credentials: pubcluster.TokenCredentials{Token: []byte("t")}, target: &security.TokenCredentials{}},
+		{name: "token pointer", credentials: &pubcluster.TokenCredentials{Token: []byte("t")}, target: &security.TokenCredentials{}},
+		{name: "custom", credentials: pubcluster.CustomCredentials{Value: "v"}, target: &security.CustomCredentials{}},
+		{name: "custom pointer", credentials: &pubcluster.CustomCredentials{Value: "v"}, target: &security.CustomCredentials{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := &pubcluster.SecurityConfig{}
+			cfg.SetCredentials(tc.credentials)
+			assert.IsType(t, tc.target, makeCredentials(cfg))
+		})
+	}
+}
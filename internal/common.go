@@ -17,7 +17,13 @@
 package internal
 
 const (
-	AggregateFactoryID = -29
+	AggregateFactoryID  = -29
+	ProjectionFactoryID = -30
+	TopicFactoryID      = -9
+	CacheFactoryID      = -25
+	ClusterFactoryID    = 0
+	// ProjectionIdentityClassID is the class ID of the identity projection.
+	ProjectionIdentityClassID = 2
 	// CurrentClientVersion should be manually set
 	CurrentClientVersion = "1.6.0"
 )
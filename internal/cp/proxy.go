@@ -52,14 +52,8 @@ Be aware of that while editing the fields and methods of proxy struct.
 */
 
 func newProxy(ss *iserialization.Service, invFactory *cluster.ConnectionInvocationFactory, is *invocation.Service, lg *logger.LogAdaptor, svc string, name string) *proxy {
-	circuitBreaker := cb.NewCircuitBreaker(
-		cb.MaxRetries(math.MaxInt32),
-		cb.MaxFailureCount(10),
-		cb.RetryPolicy(func(attempt int) time.Duration {
-			return time.Duration((attempt+1)*100) * time.Millisecond
-		}))
 	p := &proxy{
-		cb:         circuitBreaker,
+		cb:         newCircuitBreaker(),
 		invFactory: invFactory,
 		is:         is,
 		lg:         lg,
@@ -85,16 +79,29 @@ func (p *proxy) Destroy(ctx context.Context) error {
 }
 
 func (p *proxy) invokeOnRandomTarget(ctx context.Context, request *proto.ClientMessage, handler proto.ClientMessageHandler) (*proto.ClientMessage, error) {
+	return invokeOnRandomTarget(ctx, p.cb, p.invFactory, p.is, request, handler)
+}
+
+func newCircuitBreaker() *cb.CircuitBreaker {
+	return cb.NewCircuitBreaker(
+		cb.MaxRetries(math.MaxInt32),
+		cb.MaxFailureCount(10),
+		cb.RetryPolicy(func(attempt int) time.Duration {
+			return time.Duration((attempt+1)*100) * time.Millisecond
+		}))
+}
+
+func invokeOnRandomTarget(ctx context.Context, circuitBreaker *cb.CircuitBreaker, invFactory *cluster.ConnectionInvocationFactory, is *invocation.Service, request *proto.ClientMessage, handler proto.ClientMessageHandler) (*proto.ClientMessage, error) {
 	now := time.Now()
 	if ctx == nil {
 		ctx = context.Background()
 	}
-	response, err := p.cb.TryContext(ctx, func(ctx context.Context, attempt int) (interface{}, error) {
+	response, err := circuitBreaker.TryContext(ctx, func(ctx context.Context, attempt int) (interface{}, error) {
 		if attempt > 0 {
 			request = request.Copy()
 		}
-		inv := p.invFactory.NewInvocationOnRandomTarget(request, handler, now)
-		if err := p.is.SendRequest(ctx, inv); err != nil {
+		inv := invFactory.NewInvocationOnRandomTarget(request, handler, now)
+		if err := is.SendRequest(ctx, inv); err != nil {
 			return nil, err
 		}
 		return inv.GetWithContext(ctx)
@@ -103,5 +110,4 @@ func (p *proxy) invokeOnRandomTarget(ctx context.Context, request *proto.ClientM
 		return nil, err
 	}
 	return response.(*proto.ClientMessage), nil
-
 }
@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cp
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	pubtypes "github.com/hazelcast/hazelcast-go-client/types"
+)
+
+// maxAwaitTimeout is used as the await timeout when the context has no deadline.
+var maxAwaitTimeout = time.Duration(math.MaxInt64).Milliseconds()
+
+/*
+CountDownLatch is a linearizable and distributed synchronization aid, which works on top of the Raft consensus algorithm.
+
+CountDownLatch allows one or more callers to wait until a set of operations being performed by other callers completes.
+The latch is initialized with a count using TrySetCount.
+Await blocks until the count reaches zero due to calls of CountDown.
+After the count reaches zero, the latch can be reused by setting a new count with TrySetCount.
+See: https://docs.hazelcast.com/hazelcast/latest/data-structures/countdownlatch
+*/
+type CountDownLatch struct {
+	*proxy
+}
+
+/*
+CountDownLatch implementation is type aliased in the public API so all the exported fields and methods are directly accessible by users.
+Be aware of that while editing the fields and methods of both proxy and CountDownLatch structs.
+*/
+
+// TrySetCount sets the count to the given value if the current count is zero.
+// Returns true if the count was set, false if the current count is not zero.
+func (c *CountDownLatch) TrySetCount(ctx context.Context, count int) (bool, error) {
+	if count <= 0 {
+		return false, ihzerrors.NewIllegalArgumentError("count must be positive", nil)
+	}
+	request := codec.EncodeCountDownLatchTrySetCountRequest(c.groupID, c.name, int32(count))
+	response, err := c.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		return false, err
+	}
+	return codec.DecodeCountDownLatchTrySetCountResponse(response), nil
+}
+
+// CountDown decrements the count of the latch, releasing all waiting callers if the count reaches zero.
+// If the current count is zero, nothing happens.
+func (c *CountDownLatch) CountDown(ctx context.Context) error {
+	round, err := c.round(ctx)
+	if err != nil {
+		return err
+	}
+	// the same invocation UID and round make retries of the call idempotent.
+	request := codec.EncodeCountDownLatchCountDownRequest(c.groupID, c.name, pubtypes.NewUUID(), round)
+	_, err = c.invokeOnRandomTarget(ctx, request, nil)
+	return err
+}
+
+// Await waits until the count reaches zero or the deadline of the context passes.
+// Returns true if the count reached zero, false if the deadline passed before that.
+// If the context has no deadline, Await waits until the count reaches zero or the context is canceled.
+func (c *CountDownLatch) Await(ctx context.Context) (bool, error) {
+	timeoutMs := maxAwaitTimeout
+	deadline, hasDeadline := ctx.Deadline()
+	if hasDeadline {
+		timeoutMs = time.Until(deadline).Milliseconds()
+		if timeoutMs < 0 {
+			timeoutMs = 0
+		}
+	}
+	request := codec.EncodeCountDownLatchAwaitRequest(c.groupID, c.name, pubtypes.NewUUID(), timeoutMs)
+	response, err := c.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		// the deadline may pass on the client before the response of the cluster is received.
+		if hasDeadline && errors.Is(err, context.DeadlineExceeded) {
+			return false, nil
+		}
+		return false, err
+	}
+	return codec.DecodeCountDownLatchAwaitResponse(response), nil
+}
+
+// GetCount returns the current count.
+func (c *CountDownLatch) GetCount(ctx context.Context) (int, error) {
+	request := codec.EncodeCountDownLatchGetCountRequest(c.groupID, c.name)
+	response, err := c.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		return 0, err
+	}
+	return int(codec.DecodeCountDownLatchGetCountResponse(response)), nil
+}
+
+func (c *CountDownLatch) round(ctx context.Context) (int32, error) {
+	request := codec.EncodeCountDownLatchGetRoundRequest(c.groupID, c.name)
+	response, err := c.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		return 0, err
+	}
+	return codec.DecodeCountDownLatchGetRoundResponse(response), nil
+}
@@ -19,6 +19,7 @@ package cp
 import (
 	"context"
 	"strings"
+	"sync"
 
 	"github.com/hazelcast/hazelcast-go-client/internal/cluster"
 	"github.com/hazelcast/hazelcast-go-client/internal/cp/types"
@@ -30,11 +31,14 @@ import (
 )
 
 const (
-	atomicLongService   = "hz:raft:atomicLongService"
-	atomicRefService    = "hz:raft:atomicRefService"
-	cpMapService        = "hz:raft:mapService"
-	defaultGroupName    = "default"
-	metadataCPGroupName = "metadata"
+	atomicLongService     = "hz:raft:atomicLongService"
+	atomicRefService      = "hz:raft:atomicRefService"
+	cpMapService          = "hz:raft:mapService"
+	fencedLockService     = "hz:raft:lockService"
+	semaphoreService      = "hz:raft:semaphoreService"
+	countDownLatchService = "hz:raft:countDownLatchService"
+	defaultGroupName      = "default"
+	metadataCPGroupName   = "metadata"
 )
 
 type proxyFactory struct {
@@ -42,14 +46,22 @@ type proxyFactory struct {
 	ss         *iserialization.Service
 	invFactory *cluster.ConnectionInvocationFactory
 	lg         *logger.LogAdaptor
+	sm         *SessionManager
+	// lockProxiesMu protects lockProxies.
+	// FencedLock proxies are cached, since they keep the lock ownership state of the client.
+	lockProxiesMu *sync.Mutex
+	lockProxies   map[string]*FencedLock
 }
 
-func newProxyFactory(ss *iserialization.Service, invFactory *cluster.ConnectionInvocationFactory, is *invocation.Service, lg *logger.LogAdaptor) *proxyFactory {
+func newProxyFactory(ss *iserialization.Service, invFactory *cluster.ConnectionInvocationFactory, is *invocation.Service, lg *logger.LogAdaptor, sm *SessionManager) *proxyFactory {
 	return &proxyFactory{
-		is:         is,
-		invFactory: invFactory,
-		ss:         ss,
-		lg:         lg,
+		is:            is,
+		invFactory:    invFactory,
+		ss:            ss,
+		lg:            lg,
+		sm:            sm,
+		lockProxiesMu: &sync.Mutex{},
+		lockProxies:   map[string]*FencedLock{},
 	}
 }
 
@@ -75,6 +87,12 @@ func (m *proxyFactory) getOrCreateProxy(ctx context.Context, service string, nam
 		return &AtomicRef{p}, nil
 	case cpMapService:
 		return &Map{p}, nil
+	case fencedLockService:
+		return newFencedLock(p, m.sm), nil
+	case semaphoreService:
+		return m.newSemaphore(ctx, p, name)
+	case countDownLatchService:
+		return &CountDownLatch{p}, nil
 	}
 	return nil, hzerrors.NewIllegalArgumentError("requested data structure is not supported by Go Client CP Subsystem", nil)
 }
@@ -88,6 +106,17 @@ func (m *proxyFactory) createGroupID(ctx context.Context, p *proxy, proxyName st
 	return codec.DecodeCPGroupCreateCPGroupResponse(response), nil
 }
 
+func (m *proxyFactory) newSemaphore(ctx context.Context, p *proxy, proxyName string) (*Semaphore, error) {
+	// the mode of the semaphore is configured on the cluster side.
+	request := codec.EncodeSemaphoreGetSemaphoreTypeRequest(proxyName)
+	response, err := p.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		return nil, err
+	}
+	jdkCompatible := codec.DecodeSemaphoreGetSemaphoreTypeResponse(response)
+	return newSemaphore(p, m.sm, jdkCompatible), nil
+}
+
 func objectNameForProxy(name string) (string, error) {
 	// ported from: com.hazelcast.cp.internal.RaftService#getObjectNameForProxy
 	idx := strings.Index(name, "@")
@@ -148,3 +177,35 @@ func (m *proxyFactory) getMap(ctx context.Context, name string) (*Map, error) {
 	}
 	return p.(*Map), nil
 }
+
+func (m *proxyFactory) getFencedLock(ctx context.Context, name string) (*FencedLock, error) {
+	p, err := m.getOrCreateProxy(ctx, fencedLockService, name)
+	if err != nil {
+		return nil, err
+	}
+	lock := p.(*FencedLock)
+	m.lockProxiesMu.Lock()
+	defer m.lockProxiesMu.Unlock()
+	if existing, ok := m.lockProxies[name]; ok && existing.groupID == lock.groupID {
+		return existing, nil
+	}
+	// either there is no proxy or the group of the existing proxy was destroyed and recreated.
+	m.lockProxies[name] = lock
+	return lock, nil
+}
+
+func (m *proxyFactory) getSemaphore(ctx context.Context, name string) (*Semaphore, error) {
+	p, err := m.getOrCreateProxy(ctx, semaphoreService, name)
+	if err != nil {
+		return nil, err
+	}
+	return p.(*Semaphore), nil
+}
+
+func (m *proxyFactory) getCountDownLatch(ctx context.Context, name string) (*CountDownLatch, error) {
+	p, err := m.getOrCreateProxy(ctx, countDownLatchService, name)
+	if err != nil {
+		return nil, err
+	}
+	return p.(*CountDownLatch), nil
+}
@@ -0,0 +1,351 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	iproxy "github.com/hazelcast/hazelcast-go-client/internal/proxy"
+	pubtypes "github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// InvalidFence is returned by TryLock when the lock cannot be acquired.
+	InvalidFence = int64(0)
+)
+
+/*
+FencedLock is a linearizable and distributed implementation of a reentrant lock.
+It works on top of the Raft consensus algorithm and offers linearizability during crash failures and network partitions.
+
+The owner of a lock is the lock context of the given context, see hazelcast.NewLockContext.
+Calls with contexts that do not carry a lock context share the same owner.
+The lock is reentrant, the owner can acquire it multiple times and must release it the same number of times.
+
+Each successful acquire returns a fencing token, which is a monotonic 64-bit integer.
+Fencing tokens can be passed to external services or resources to prevent writes by stale lock holders.
+The lock is bound to the CP session of the client, if the session is closed by the cluster, for instance since the client could not send heartbeats, the lock is released and the owner loses the ownership.
+In that case, operations of the owner fail with hzerrors.ErrLockOwnershipLostException.
+See: https://docs.hazelcast.com/hazelcast/latest/data-structures/fencedlock
+*/
+type FencedLock struct {
+	*proxy
+	sm *SessionManager
+	mu *sync.Mutex
+	// lockedSessionIDs keeps the session IDs of the owners which hold the lock.
+	lockedSessionIDs map[int64]int64
+}
+
+/*
+FencedLock implementation is type aliased in the public API so all the exported fields and methods are directly accessible by users.
+Be aware of that while editing the fields and methods of both proxy and FencedLock structs.
+*/
+
+func newFencedLock(p *proxy, sm *SessionManager) *FencedLock {
+	return &FencedLock{
+		proxy:            p,
+		sm:               sm,
+		mu:               &sync.Mutex{},
+		lockedSessionIDs: map[int64]int64{},
+	}
+}
+
+// Lock acquires the lock and returns the fencing token.
+// If the lock is held by another owner, it blocks until the lock is released or the context is done.
+// Fails with hzerrors.ErrLockAcquireLimitReachedException if the reentrant lock limit is reached.
+func (f *FencedLock) Lock(ctx context.Context) (int64, error) {
+	threadID := iproxy.ExtractLockID(ctx)
+	invUID := pubtypes.NewUUID()
+	for {
+		sessionID, err := f.sm.acquireSession(ctx, f.groupID, 1)
+		if err != nil {
+			return InvalidFence, err
+		}
+		if err := f.verifyLockedSessionIDIfPresent(threadID, sessionID, true); err != nil {
+			return InvalidFence, err
+		}
+		request := codec.EncodeFencedLockLockRequest(f.groupID, f.name, sessionID, threadID, invUID)
+		response, err := f.invokeOnRandomTarget(ctx, request, nil)
+		if err != nil {
+			if errors.Is(err, hzerrors.ErrSessionExpiredException) {
+				f.sm.invalidateSession(f.groupID, sessionID)
+				if err := f.verifyNoLockedSessionIDPresent(threadID); err != nil {
+					return InvalidFence, err
+				}
+				continue
+			}
+			f.sm.releaseSession(f.groupID, sessionID, 1)
+			if errors.Is(err, hzerrors.ErrWaitKeyCancelledException) {
+				msg := fmt.Sprintf("lock %s not acquired because the lock call on the CP group was cancelled", f.name)
+				return InvalidFence, ihzerrors.NewClientError(msg, err, hzerrors.ErrIllegalMonitorState)
+			}
+			return InvalidFence, err
+		}
+		fence := codec.DecodeFencedLockLockResponse(response)
+		if fence == InvalidFence {
+			f.sm.releaseSession(f.groupID, sessionID, 1)
+			msg := fmt.Sprintf("lock %s reentrant lock limit is already reached", f.name)
+			return InvalidFence, ihzerrors.NewClientError(msg, nil, hzerrors.ErrLockAcquireLimitReachedException)
+		}
+		f.setLockedSessionID(threadID, sessionID)
+		return fence, nil
+	}
+}
+
+// TryLock acquires the lock only if it is free or already held by the owner at the time of invocation.
+// Returns the fencing token if the lock was acquired, InvalidFence otherwise.
+func (f *FencedLock) TryLock(ctx context.Context) (int64, error) {
+	return f.TryLockWithTimeout(ctx, 0)
+}
+
+// TryLockWithTimeout acquires the lock if it is free within the given timeout.
+// Returns the fencing token if the lock was acquired, InvalidFence otherwise.
+func (f *FencedLock) TryLockWithTimeout(ctx context.Context, timeout time.Duration) (int64, error) {
+	threadID := iproxy.ExtractLockID(ctx)
+	invUID := pubtypes.NewUUID()
+	if timeout < 0 {
+		timeout = 0
+	}
+	for {
+		start := time.Now()
+		sessionID, err := f.sm.acquireSession(ctx, f.groupID, 1)
+		if err != nil {
+			return InvalidFence, err
+		}
+		if err := f.verifyLockedSessionIDIfPresent(threadID, sessionID, true); err != nil {
+			return InvalidFence, err
+		}
+		request := codec.EncodeFencedLockTryLockRequest(f.groupID, f.name, sessionID, threadID, invUID, timeout.Milliseconds())
+		response, err := f.invokeOnRandomTarget(ctx, request, nil)
+		if err != nil {
+			if errors.Is(err, hzerrors.ErrSessionExpiredException) {
+				f.sm.invalidateSession(f.groupID, sessionID)
+				if err := f.verifyNoLockedSessionIDPresent(threadID); err != nil {
+					return InvalidFence, err
+				}
+				timeout -= time.Since(start)
+				if timeout <= 0 {
+					return InvalidFence, nil
+				}
+				continue
+			}
+			f.sm.releaseSession(f.groupID, sessionID, 1)
+			if errors.Is(err, hzerrors.ErrWaitKeyCancelledException) {
+				return InvalidFence, nil
+			}
+			return InvalidFence, err
+		}
+		fence := codec.DecodeFencedLockTryLockResponse(response)
+		if fence == InvalidFence {
+			f.sm.releaseSession(f.groupID, sessionID, 1)
+			return InvalidFence, nil
+		}
+		f.setLockedSessionID(threadID, sessionID)
+		return fence, nil
+	}
+}
+
+// Unlock releases the lock once.
+// The lock is released completely when the owner calls Unlock as many times as it acquired the lock.
+// Fails with hzerrors.ErrIllegalMonitorState if the lock is not held by the owner.
+func (f *FencedLock) Unlock(ctx context.Context) error {
+	threadID := iproxy.ExtractLockID(ctx)
+	sessionID := f.sm.sessionID(f.groupID)
+	if err := f.verifyLockedSessionIDIfPresent(threadID, sessionID, false); err != nil {
+		return err
+	}
+	if sessionID == noSessionID {
+		f.removeLockedSessionID(threadID)
+		return f.newIllegalMonitorStateError()
+	}
+	request := codec.EncodeFencedLockUnlockRequest(f.groupID, f.name, sessionID, threadID, pubtypes.NewUUID())
+	response, err := f.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		if errors.Is(err, hzerrors.ErrSessionExpiredException) {
+			f.sm.invalidateSession(f.groupID, sessionID)
+			f.removeLockedSessionID(threadID)
+			return f.newLockOwnershipLostError(sessionID)
+		}
+		if errors.Is(err, hzerrors.ErrIllegalMonitorState) {
+			f.removeLockedSessionID(threadID)
+		}
+		return err
+	}
+	if codec.DecodeFencedLockUnlockResponse(response) {
+		f.setLockedSessionID(threadID, sessionID)
+	} else {
+		f.removeLockedSessionID(threadID)
+	}
+	f.sm.releaseSession(f.groupID, sessionID, 1)
+	return nil
+}
+
+// GetFence returns the fencing token if the lock is held by the owner.
+// Fails with hzerrors.ErrIllegalMonitorState if the lock is not held by the owner.
+func (f *FencedLock) GetFence(ctx context.Context) (int64, error) {
+	threadID := iproxy.ExtractLockID(ctx)
+	sessionID := f.sm.sessionID(f.groupID)
+	if err := f.verifyLockedSessionIDIfPresent(threadID, sessionID, false); err != nil {
+		return InvalidFence, err
+	}
+	if sessionID == noSessionID {
+		f.removeLockedSessionID(threadID)
+		return InvalidFence, f.newIllegalMonitorStateError()
+	}
+	os, err := f.lockOwnership(ctx)
+	if err != nil {
+		return InvalidFence, err
+	}
+	if os.lockedBy(sessionID, threadID) {
+		f.setLockedSessionID(threadID, sessionID)
+		return os.fence, nil
+	}
+	if err := f.verifyNoLockedSessionIDPresent(threadID); err != nil {
+		return InvalidFence, err
+	}
+	return InvalidFence, f.newIllegalMonitorStateError()
+}
+
+// IsLocked returns true if the lock is held by any owner.
+func (f *FencedLock) IsLocked(ctx context.Context) (bool, error) {
+	os, locked, err := f.ownershipOfCaller(ctx)
+	if err != nil {
+		return false, err
+	}
+	return locked || os.locked(), nil
+}
+
+// IsLockedByContext returns true if the lock is held by the owner in the given context.
+func (f *FencedLock) IsLockedByContext(ctx context.Context) (bool, error) {
+	_, locked, err := f.ownershipOfCaller(ctx)
+	return locked, err
+}
+
+// GetLockCount returns the reentrant lock count if the lock is held by any owner.
+func (f *FencedLock) GetLockCount(ctx context.Context) (int, error) {
+	os, _, err := f.ownershipOfCaller(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return int(os.lockCount), nil
+}
+
+// ownershipOfCaller returns the lock ownership state and whether the lock is held by the owner in the given context.
+func (f *FencedLock) ownershipOfCaller(ctx context.Context) (lockOwnership, bool, error) {
+	threadID := iproxy.ExtractLockID(ctx)
+	sessionID := f.sm.sessionID(f.groupID)
+	if err := f.verifyLockedSessionIDIfPresent(threadID, sessionID, false); err != nil {
+		return lockOwnership{}, false, err
+	}
+	os, err := f.lockOwnership(ctx)
+	if err != nil {
+		return lockOwnership{}, false, err
+	}
+	if os.lockedBy(sessionID, threadID) {
+		f.setLockedSessionID(threadID, sessionID)
+		return os, true, nil
+	}
+	if err := f.verifyNoLockedSessionIDPresent(threadID); err != nil {
+		return lockOwnership{}, false, err
+	}
+	return os, false, nil
+}
+
+func (f *FencedLock) lockOwnership(ctx context.Context) (lockOwnership, error) {
+	request := codec.EncodeFencedLockGetLockOwnershipRequest(f.groupID, f.name)
+	response, err := f.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		return lockOwnership{}, err
+	}
+	fence, lockCount, sessionID, threadID := codec.DecodeFencedLockGetLockOwnershipResponse(response)
+	return lockOwnership{
+		fence:     fence,
+		lockCount: lockCount,
+		sessionID: sessionID,
+		threadID:  threadID,
+	}, nil
+}
+
+func (f *FencedLock) verifyLockedSessionIDIfPresent(threadID, sessionID int64, releaseSession bool) error {
+	f.mu.Lock()
+	lockedSessionID, ok := f.lockedSessionIDs[threadID]
+	if !ok || lockedSessionID == sessionID {
+		f.mu.Unlock()
+		return nil
+	}
+	delete(f.lockedSessionIDs, threadID)
+	f.mu.Unlock()
+	if releaseSession {
+		f.sm.releaseSession(f.groupID, sessionID, 1)
+	}
+	return f.newLockOwnershipLostError(lockedSessionID)
+}
+
+func (f *FencedLock) verifyNoLockedSessionIDPresent(threadID int64) error {
+	f.mu.Lock()
+	lockedSessionID, ok := f.lockedSessionIDs[threadID]
+	delete(f.lockedSessionIDs, threadID)
+	f.mu.Unlock()
+	if ok {
+		return f.newLockOwnershipLostError(lockedSessionID)
+	}
+	return nil
+}
+
+func (f *FencedLock) setLockedSessionID(threadID, sessionID int64) {
+	f.mu.Lock()
+	f.lockedSessionIDs[threadID] = sessionID
+	f.mu.Unlock()
+}
+
+func (f *FencedLock) removeLockedSessionID(threadID int64) {
+	f.mu.Lock()
+	delete(f.lockedSessionIDs, threadID)
+	f.mu.Unlock()
+}
+
+func (f *FencedLock) newIllegalMonitorStateError() error {
+	msg := fmt.Sprintf("current owner is not the owner of lock %s", f.name)
+	return ihzerrors.NewClientError(msg, nil, hzerrors.ErrIllegalMonitorState)
+}
+
+func (f *FencedLock) newLockOwnershipLostError(sessionID int64) error {
+	msg := fmt.Sprintf("current owner is not the owner of lock %s because its session %d is closed by the server", f.name, sessionID)
+	return ihzerrors.NewClientError(msg, nil, hzerrors.ErrLockOwnershipLostException)
+}
+
+type lockOwnership struct {
+	fence     int64
+	sessionID int64
+	threadID  int64
+	lockCount int32
+}
+
+func (o lockOwnership) locked() bool {
+	return o.fence != InvalidFence
+}
+
+func (o lockOwnership) lockedBy(sessionID, threadID int64) bool {
+	return o.locked() && o.sessionID == sessionID && o.threadID == threadID
+}
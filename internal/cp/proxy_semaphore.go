@@ -0,0 +1,262 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	pubtypes "github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// drainSessionAcquireCount is the number of session acquires for the drain call.
+	// Unused acquires are released after the call, according to the number of drained permits.
+	drainSessionAcquireCount = 1024
+	// waitForever is the timeout value for waiting without a timeout.
+	waitForever = int64(-1)
+)
+
+/*
+Semaphore is a linearizable and distributed semaphore, which works on top of the Raft consensus algorithm.
+
+Semaphore maintains a set of permits.
+Acquire blocks if necessary until a permit is available, and then takes it.
+Release adds a permit, potentially releasing a blocking acquirer.
+The semaphore has no permits when it is created, the permits should be set using Init before it is used.
+
+Semaphore works in one of the two modes, depending on the configuration of the semaphore in the cluster:
+
+In the session-aware mode, which is the default, permits are bound to the CP session of the client.
+If the client cannot send heartbeats and its session is closed by the cluster, its permits are released automatically.
+Permits can be released only by the owner which acquired them.
+
+In the sessionless (JDK-compatible) mode, permits are not bound to sessions and any owner can release permits which it did not acquire.
+Permits are not released automatically if the client crashes.
+
+The owner of permits is the lock context of the given context, see hazelcast.NewLockContext.
+Calls with contexts that do not carry a lock context share the same owner, so concurrent blocking acquires should use different lock contexts.
+See: https://docs.hazelcast.com/hazelcast/latest/data-structures/isemaphore
+*/
+type Semaphore struct {
+	*proxy
+	sm            *SessionManager
+	jdkCompatible bool
+}
+
+/*
+Semaphore implementation is type aliased in the public API so all the exported fields and methods are directly accessible by users.
+Be aware of that while editing the fields and methods of both proxy and Semaphore structs.
+*/
+
+func newSemaphore(p *proxy, sm *SessionManager, jdkCompatible bool) *Semaphore {
+	return &Semaphore{
+		proxy:         p,
+		sm:            sm,
+		jdkCompatible: jdkCompatible,
+	}
+}
+
+// Init sets the number of available permits if the semaphore is not initialized before.
+// Returns true if the permits were set, false if the semaphore was already initialized.
+func (s *Semaphore) Init(ctx context.Context, permits int) (bool, error) {
+	if permits < 0 {
+		return false, ihzerrors.NewIllegalArgumentError("permits must be non-negative", nil)
+	}
+	request := codec.EncodeSemaphoreInitRequest(s.groupID, s.name, int32(permits))
+	response, err := s.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		return false, err
+	}
+	return codec.DecodeSemaphoreInitResponse(response), nil
+}
+
+// Acquire acquires the given number of permits.
+// It blocks until the permits are available or the context is done.
+func (s *Semaphore) Acquire(ctx context.Context, permits int) error {
+	if permits <= 0 {
+		return ihzerrors.NewIllegalArgumentError("permits must be positive", nil)
+	}
+	if _, err := s.acquire(ctx, int32(permits), waitForever); err != nil {
+		if errors.Is(err, hzerrors.ErrWaitKeyCancelledException) {
+			msg := fmt.Sprintf("semaphore %s not acquired because the acquire call on the CP group was cancelled, possibly because of another call from the same owner", s.name)
+			return ihzerrors.NewClientError(msg, err, hzerrors.ErrIllegalState)
+		}
+		return err
+	}
+	return nil
+}
+
+// TryAcquire acquires the given number of permits if they are available within the given timeout.
+// Returns true if the permits were acquired.
+// If the timeout is zero, the call does not wait.
+func (s *Semaphore) TryAcquire(ctx context.Context, permits int, timeout time.Duration) (bool, error) {
+	if permits <= 0 {
+		return false, ihzerrors.NewIllegalArgumentError("permits must be positive", nil)
+	}
+	if timeout < 0 {
+		timeout = 0
+	}
+	acquired, err := s.acquire(ctx, int32(permits), timeout.Milliseconds())
+	if err != nil {
+		if errors.Is(err, hzerrors.ErrWaitKeyCancelledException) {
+			return false, nil
+		}
+		return false, err
+	}
+	return acquired, nil
+}
+
+// Release releases the given number of permits.
+// In the session-aware mode, the permits must have been acquired by the owner in the given context before.
+func (s *Semaphore) Release(ctx context.Context, permits int) error {
+	if permits <= 0 {
+		return ihzerrors.NewIllegalArgumentError("permits must be positive", nil)
+	}
+	threadID, err := s.sm.getOrCreateUniqueThreadID(ctx, s.groupID, s.name)
+	if err != nil {
+		return err
+	}
+	if s.jdkCompatible {
+		request := codec.EncodeSemaphoreReleaseRequest(s.groupID, s.name, noSessionID, threadID, pubtypes.NewUUID(), int32(permits))
+		_, err := s.invokeOnRandomTarget(ctx, request, nil)
+		return err
+	}
+	sessionID := s.sm.sessionID(s.groupID)
+	if sessionID == noSessionID {
+		return s.newNoPermitsError(nil)
+	}
+	defer s.sm.releaseSession(s.groupID, sessionID, int64(permits))
+	request := codec.EncodeSemaphoreReleaseRequest(s.groupID, s.name, sessionID, threadID, pubtypes.NewUUID(), int32(permits))
+	if _, err := s.invokeOnRandomTarget(ctx, request, nil); err != nil {
+		if errors.Is(err, hzerrors.ErrSessionExpiredException) {
+			s.sm.invalidateSession(s.groupID, sessionID)
+			return s.newNoPermitsError(err)
+		}
+		return err
+	}
+	return nil
+}
+
+// Drain acquires all available permits and returns the number of acquired permits.
+func (s *Semaphore) Drain(ctx context.Context) (int, error) {
+	threadID, err := s.sm.getOrCreateUniqueThreadID(ctx, s.groupID, s.name)
+	if err != nil {
+		return 0, err
+	}
+	invUID := pubtypes.NewUUID()
+	if s.jdkCompatible {
+		request := codec.EncodeSemaphoreDrainRequest(s.groupID, s.name, noSessionID, threadID, invUID)
+		response, err := s.invokeOnRandomTarget(ctx, request, nil)
+		if err != nil {
+			return 0, err
+		}
+		return int(codec.DecodeSemaphoreDrainResponse(response)), nil
+	}
+	for {
+		sessionID, err := s.sm.acquireSession(ctx, s.groupID, drainSessionAcquireCount)
+		if err != nil {
+			return 0, err
+		}
+		request := codec.EncodeSemaphoreDrainRequest(s.groupID, s.name, sessionID, threadID, invUID)
+		response, err := s.invokeOnRandomTarget(ctx, request, nil)
+		if err != nil {
+			if errors.Is(err, hzerrors.ErrSessionExpiredException) {
+				s.sm.invalidateSession(s.groupID, sessionID)
+				continue
+			}
+			s.sm.releaseSession(s.groupID, sessionID, drainSessionAcquireCount)
+			return 0, err
+		}
+		count := codec.DecodeSemaphoreDrainResponse(response)
+		s.sm.releaseSession(s.groupID, sessionID, drainSessionAcquireCount-int64(count))
+		return int(count), nil
+	}
+}
+
+// Destroy destroys the semaphore and removes the thread IDs kept for it.
+func (s *Semaphore) Destroy(ctx context.Context) error {
+	if err := s.proxy.Destroy(ctx); err != nil {
+		return err
+	}
+	s.sm.deleteObjectThreadIDs(s.groupID, s.name)
+	return nil
+}
+
+// AvailablePermits returns the number of available permits.
+func (s *Semaphore) AvailablePermits(ctx context.Context) (int, error) {
+	request := codec.EncodeSemaphoreAvailablePermitsRequest(s.groupID, s.name)
+	response, err := s.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		return 0, err
+	}
+	return int(codec.DecodeSemaphoreAvailablePermitsResponse(response)), nil
+}
+
+func (s *Semaphore) acquire(ctx context.Context, permits int32, timeoutMs int64) (bool, error) {
+	threadID, err := s.sm.getOrCreateUniqueThreadID(ctx, s.groupID, s.name)
+	if err != nil {
+		return false, err
+	}
+	invUID := pubtypes.NewUUID()
+	if s.jdkCompatible {
+		request := codec.EncodeSemaphoreAcquireRequest(s.groupID, s.name, noSessionID, threadID, invUID, permits, timeoutMs)
+		response, err := s.invokeOnRandomTarget(ctx, request, nil)
+		if err != nil {
+			return false, err
+		}
+		return codec.DecodeSemaphoreAcquireResponse(response), nil
+	}
+	for {
+		start := time.Now()
+		sessionID, err := s.sm.acquireSession(ctx, s.groupID, int64(permits))
+		if err != nil {
+			return false, err
+		}
+		request := codec.EncodeSemaphoreAcquireRequest(s.groupID, s.name, sessionID, threadID, invUID, permits, timeoutMs)
+		response, err := s.invokeOnRandomTarget(ctx, request, nil)
+		if err != nil {
+			if errors.Is(err, hzerrors.ErrSessionExpiredException) {
+				s.sm.invalidateSession(s.groupID, sessionID)
+				if timeoutMs != waitForever {
+					timeoutMs -= time.Since(start).Milliseconds()
+					if timeoutMs <= 0 {
+						return false, nil
+					}
+				}
+				continue
+			}
+			s.sm.releaseSession(s.groupID, sessionID, int64(permits))
+			return false, err
+		}
+		acquired := codec.DecodeSemaphoreAcquireResponse(response)
+		if !acquired {
+			s.sm.releaseSession(s.groupID, sessionID, int64(permits))
+		}
+		return acquired, nil
+	}
+}
+
+func (s *Semaphore) newNoPermitsError(err error) error {
+	msg := fmt.Sprintf("current owner does not hold permits of semaphore %s", s.name)
+	return ihzerrors.NewClientError(msg, err, hzerrors.ErrIllegalState)
+}
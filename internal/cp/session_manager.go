@@ -0,0 +1,346 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/cb"
+	"github.com/hazelcast/hazelcast-go-client/internal/cluster"
+	"github.com/hazelcast/hazelcast-go-client/internal/cp/types"
+	"github.com/hazelcast/hazelcast-go-client/internal/invocation"
+	"github.com/hazelcast/hazelcast-go-client/internal/logger"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	iproxy "github.com/hazelcast/hazelcast-go-client/internal/proxy"
+)
+
+const (
+	noSessionID = int64(-1)
+	// closeSessionsTimeout bounds the time spent for closing the sessions during shutdown.
+	closeSessionsTimeout = 5 * time.Second
+)
+
+// session is a CP session on a CP group.
+// acquireCount is the number of active users of the session, the session is kept alive while it is in use.
+type session struct {
+	creationTime time.Time
+	id           int64
+	ttl          time.Duration
+	acquireCount int64
+}
+
+func newSession(id int64, ttl time.Duration) *session {
+	return &session{
+		id:           id,
+		ttl:          ttl,
+		creationTime: time.Now(),
+	}
+}
+
+func (s *session) acquire(count int64) int64 {
+	atomic.AddInt64(&s.acquireCount, count)
+	return s.id
+}
+
+func (s *session) release(count int64) {
+	atomic.AddInt64(&s.acquireCount, -count)
+}
+
+func (s *session) inUse() bool {
+	return atomic.LoadInt64(&s.acquireCount) > 0
+}
+
+func (s *session) expired(now time.Time) bool {
+	expiration := s.creationTime.Add(s.ttl)
+	if expiration.Before(s.creationTime) {
+		// overflow
+		return false
+	}
+	return now.After(expiration)
+}
+
+func (s *session) valid() bool {
+	return s.inUse() || !s.expired(time.Now())
+}
+
+/*
+SessionManager manages the CP sessions of the client.
+
+Session-aware CP data structures, such as FencedLock, acquire a session on the CP group of the data structure before calling the cluster.
+Sessions are created lazily and kept alive by periodic heartbeats while they are in use.
+All sessions are closed when the client shuts down.
+*/
+type SessionManager struct {
+	cb           *cb.CircuitBreaker
+	invFactory   *cluster.ConnectionInvocationFactory
+	is           *invocation.Service
+	lg           *logger.LogAdaptor
+	mu           *sync.Mutex
+	sessions     map[types.RaftGroupID]*session
+	groupMus     map[types.RaftGroupID]*sync.Mutex
+	threadIDs    map[threadIDKey]int64
+	doneCh       chan struct{}
+	endpointName string
+	heartbeating bool
+	shutdown     bool
+}
+
+func NewSessionManager(invFactory *cluster.ConnectionInvocationFactory, is *invocation.Service, lg *logger.LogAdaptor, endpointName string) *SessionManager {
+	return &SessionManager{
+		cb:           newCircuitBreaker(),
+		invFactory:   invFactory,
+		is:           is,
+		lg:           lg,
+		mu:           &sync.Mutex{},
+		sessions:     map[types.RaftGroupID]*session{},
+		groupMus:     map[types.RaftGroupID]*sync.Mutex{},
+		threadIDs:    map[threadIDKey]int64{},
+		doneCh:       make(chan struct{}),
+		endpointName: endpointName,
+	}
+}
+
+// sessionID returns the ID of the current session on the given group, or noSessionID if there is no session.
+func (m *SessionManager) sessionID(groupID types.RaftGroupID) int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if s, ok := m.sessions[groupID]; ok {
+		return s.id
+	}
+	return noSessionID
+}
+
+// invalidateSession removes the given session, so that a new session is created on the next acquire.
+// The thread IDs of the group are removed with the session.
+func (m *SessionManager) invalidateSession(groupID types.RaftGroupID, sessionID int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if s, ok := m.sessions[groupID]; ok && s.id == sessionID {
+		delete(m.sessions, groupID)
+		m.deleteThreadIDs(groupID)
+	}
+}
+
+// deleteThreadIDs removes the thread IDs of the given group.
+// The owners of a closed session cannot hold locks anymore, so they get new thread IDs when they need one.
+// Must be called with m.mu locked.
+func (m *SessionManager) deleteThreadIDs(groupID types.RaftGroupID) {
+	for key := range m.threadIDs {
+		if key.groupID == groupID {
+			delete(m.threadIDs, key)
+		}
+	}
+}
+
+// deleteObjectThreadIDs removes the thread IDs of the given CP object.
+func (m *SessionManager) deleteObjectThreadIDs(groupID types.RaftGroupID, objectName string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for key := range m.threadIDs {
+		if key.groupID == groupID && key.objectName == objectName {
+			delete(m.threadIDs, key)
+		}
+	}
+}
+
+// threadIDKey identifies an owner of a CP object.
+// The owner is the lock context of the context, see iproxy.NewLockContext.
+type threadIDKey struct {
+	groupID    types.RaftGroupID
+	objectName string
+	lockID     int64
+}
+
+// getOrCreateUniqueThreadID returns an ID which is unique in the given group for the owner in the given context.
+// The ID is kept until the session of the group is closed or the object is destroyed, see deleteObjectThreadIDs.
+func (m *SessionManager) getOrCreateUniqueThreadID(ctx context.Context, groupID types.RaftGroupID, objectName string) (int64, error) {
+	key := threadIDKey{groupID: groupID, objectName: objectName, lockID: iproxy.ExtractLockID(ctx)}
+	gmu := m.groupMutex(groupID)
+	gmu.Lock()
+	defer gmu.Unlock()
+	m.mu.Lock()
+	id, ok := m.threadIDs[key]
+	m.mu.Unlock()
+	if ok {
+		return id, nil
+	}
+	request := codec.EncodeCPSessionGenerateThreadIdRequest(groupID)
+	response, err := m.invokeOnRandomTarget(ctx, request)
+	if err != nil {
+		return 0, err
+	}
+	id = codec.DecodeCPSessionGenerateThreadIdResponse(response)
+	m.mu.Lock()
+	m.threadIDs[key] = id
+	m.mu.Unlock()
+	return id, nil
+}
+
+// Shutdown stops the heartbeats and closes all sessions.
+func (m *SessionManager) Shutdown(ctx context.Context) {
+	m.mu.Lock()
+	if m.shutdown {
+		m.mu.Unlock()
+		return
+	}
+	m.shutdown = true
+	close(m.doneCh)
+	sessions := m.sessions
+	m.sessions = map[types.RaftGroupID]*session{}
+	m.threadIDs = map[threadIDKey]int64{}
+	m.mu.Unlock()
+	ctx, cancel := context.WithTimeout(ctx, closeSessionsTimeout)
+	defer cancel()
+	for groupID, s := range sessions {
+		request := codec.EncodeCPSessionCloseSessionRequest(groupID, s.id)
+		if _, err := m.invokeOnRandomTarget(ctx, request); err != nil {
+			m.lg.Debug(func() string {
+				return fmt.Sprintf("closing CP session %d of group %s: %s", s.id, groupID.Name, err.Error())
+			})
+		}
+	}
+}
+
+// acquireSession returns the ID of a valid session on the given group, creating the session if necessary.
+// The session must be released with releaseSession when it is not used anymore.
+func (m *SessionManager) acquireSession(ctx context.Context, groupID types.RaftGroupID, count int64) (int64, error) {
+	s, err := m.getOrCreateSession(ctx, groupID)
+	if err != nil {
+		return noSessionID, err
+	}
+	return s.acquire(count), nil
+}
+
+// releaseSession decrements the use count of the given session.
+func (m *SessionManager) releaseSession(groupID types.RaftGroupID, sessionID int64, count int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if s, ok := m.sessions[groupID]; ok && s.id == sessionID {
+		s.release(count)
+	}
+}
+
+func (m *SessionManager) getOrCreateSession(ctx context.Context, groupID types.RaftGroupID) (*session, error) {
+	if s, ok := m.validSession(groupID); ok {
+		return s, nil
+	}
+	// serialize session creation per group, so that a single session is created for concurrent acquires.
+	gmu := m.groupMutex(groupID)
+	gmu.Lock()
+	defer gmu.Unlock()
+	if s, ok := m.validSession(groupID); ok {
+		return s, nil
+	}
+	request := codec.EncodeCPSessionCreateSessionRequest(groupID, m.endpointName)
+	response, err := m.invokeOnRandomTarget(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	id, ttlMillis, heartbeatMillis := codec.DecodeCPSessionCreateSessionResponse(response)
+	s := newSession(id, time.Duration(ttlMillis)*time.Millisecond)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.shutdown {
+		return nil, hzerrors.ErrClientNotActive
+	}
+	if _, ok := m.sessions[groupID]; ok {
+		// the previous session expired without being used
+		m.deleteThreadIDs(groupID)
+	}
+	m.sessions[groupID] = s
+	if !m.heartbeating {
+		m.heartbeating = true
+		go m.heartbeat(time.Duration(heartbeatMillis) * time.Millisecond)
+	}
+	return s, nil
+}
+
+func (m *SessionManager) validSession(groupID types.RaftGroupID) (*session, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, ok := m.sessions[groupID]
+	if !ok || !s.valid() {
+		return nil, false
+	}
+	return s, true
+}
+
+func (m *SessionManager) groupMutex(groupID types.RaftGroupID) *sync.Mutex {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	mu, ok := m.groupMus[groupID]
+	if !ok {
+		mu = &sync.Mutex{}
+		m.groupMus[groupID] = mu
+	}
+	return mu
+}
+
+func (m *SessionManager) heartbeat(period time.Duration) {
+	if period <= 0 {
+		period = time.Duration(math.MaxInt64)
+	}
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.doneCh:
+			return
+		case <-ticker.C:
+			m.sendHeartbeats(period)
+		}
+	}
+}
+
+func (m *SessionManager) sendHeartbeats(timeout time.Duration) {
+	m.mu.Lock()
+	sessions := make(map[types.RaftGroupID]*session, len(m.sessions))
+	for groupID, s := range m.sessions {
+		if s.inUse() {
+			sessions[groupID] = s
+		}
+	}
+	m.mu.Unlock()
+	for groupID, s := range sessions {
+		go func(groupID types.RaftGroupID, s *session) {
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+			request := codec.EncodeCPSessionHeartbeatSessionRequest(groupID, s.id)
+			if _, err := m.invokeOnRandomTarget(ctx, request); err != nil {
+				if errors.Is(err, hzerrors.ErrSessionExpiredException) || errors.Is(err, hzerrors.ErrCPGroupDestroyedException) {
+					m.invalidateSession(groupID, s.id)
+					return
+				}
+				m.lg.Debug(func() string {
+					return fmt.Sprintf("sending heartbeat for CP session %d of group %s: %s", s.id, groupID.Name, err.Error())
+				})
+			}
+		}(groupID, s)
+	}
+}
+
+func (m *SessionManager) invokeOnRandomTarget(ctx context.Context, request *proto.ClientMessage) (*proto.ClientMessage, error) {
+	return invokeOnRandomTarget(ctx, m.cb, m.invFactory, m.is, request, nil)
+}
@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cp
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/cp/types"
+)
+
+func TestSession(t *testing.T) {
+	s := newSession(1, time.Minute)
+	assert.False(t, s.inUse())
+	assert.False(t, s.expired(time.Now()))
+	assert.True(t, s.expired(time.Now().Add(2*time.Minute)))
+	assert.Equal(t, int64(1), s.acquire(2))
+	assert.True(t, s.inUse())
+	s.release(1)
+	assert.True(t, s.inUse())
+	s.release(1)
+	assert.False(t, s.inUse())
+}
+
+func TestSession_ValidWhenInUse(t *testing.T) {
+	s := newSession(1, 0)
+	s.creationTime = time.Now().Add(-time.Second)
+	assert.False(t, s.valid())
+	s.acquire(1)
+	assert.True(t, s.valid())
+}
+
+func TestSessionManager_ThreadIDs(t *testing.T) {
+	m := NewSessionManager(nil, nil, nil, "")
+	group1 := types.RaftGroupID{Name: "group1", Id: 1}
+	group2 := types.RaftGroupID{Name: "group2", Id: 2}
+	m.sessions[group1] = newSession(10, time.Minute)
+	m.threadIDs[threadIDKey{groupID: group1, objectName: "sem", lockID: 1}] = 100
+	m.threadIDs[threadIDKey{groupID: group1, objectName: "sem", lockID: 2}] = 101
+	m.threadIDs[threadIDKey{groupID: group2, objectName: "sem", lockID: 1}] = 200
+	m.threadIDs[threadIDKey{groupID: group2, objectName: "other", lockID: 1}] = 201
+	// a stale session does not remove the thread IDs
+	m.invalidateSession(group1, 11)
+	assert.Len(t, m.threadIDs, 4)
+	m.invalidateSession(group1, 10)
+	assert.Len(t, m.threadIDs, 2)
+	// destroying an object removes only its thread IDs
+	m.deleteObjectThreadIDs(group2, "sem")
+	assert.Equal(t, map[threadIDKey]int64{{groupID: group2, objectName: "other", lockID: 1}: 201}, m.threadIDs)
+	m.Shutdown(context.Background())
+	assert.Empty(t, m.threadIDs)
+}
+
+func TestLockOwnership(t *testing.T) {
+	o := lockOwnership{fence: InvalidFence}
+	assert.False(t, o.locked())
+	assert.False(t, o.lockedBy(1, 1))
+	o = lockOwnership{fence: 10, sessionID: 1, threadID: 2, lockCount: 1}
+	assert.True(t, o.locked())
+	assert.True(t, o.lockedBy(1, 2))
+	assert.False(t, o.lockedBy(1, 3))
+	assert.False(t, o.lockedBy(2, 2))
+}
@@ -43,9 +43,9 @@ type Subsystem struct {
 	proxyFactory *proxyFactory
 }
 
-func NewSubsystem(ss *iserialization.Service, cif *cluster.ConnectionInvocationFactory, is *invocation.Service, l *logger.LogAdaptor) Subsystem {
+func NewSubsystem(ss *iserialization.Service, cif *cluster.ConnectionInvocationFactory, is *invocation.Service, l *logger.LogAdaptor, sm *SessionManager) Subsystem {
 	return Subsystem{
-		proxyFactory: newProxyFactory(ss, cif, is, l),
+		proxyFactory: newProxyFactory(ss, cif, is, l, sm),
 	}
 }
 
@@ -63,3 +63,18 @@ func (c Subsystem) GetAtomicRef(ctx context.Context, name string) (*AtomicRef, e
 func (c Subsystem) GetMap(ctx context.Context, name string) (*Map, error) {
 	return c.proxyFactory.getMap(ctx, name)
 }
+
+// GetLock returns the distributed FencedLock instance with given name.
+func (c Subsystem) GetLock(ctx context.Context, name string) (*FencedLock, error) {
+	return c.proxyFactory.getFencedLock(ctx, name)
+}
+
+// GetSemaphore returns the distributed Semaphore instance with given name.
+func (c Subsystem) GetSemaphore(ctx context.Context, name string) (*Semaphore, error) {
+	return c.proxyFactory.getSemaphore(ctx, name)
+}
+
+// GetCountDownLatch returns the distributed CountDownLatch instance with given name.
+func (c Subsystem) GetCountDownLatch(ctx context.Context, name string) (*CountDownLatch, error) {
+	return c.proxyFactory.getCountDownLatch(ctx, name)
+}
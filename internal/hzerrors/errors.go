@@ -159,6 +159,10 @@ func NewInvalidConfigurationError(msg string, err error) *ClientError {
 	return NewClientError(msg, err, hzerrors.ErrInvalidConfiguration)
 }
 
+func NewClassCastError(msg string, err error) *ClientError {
+	return NewClientError(msg, err, hzerrors.ErrClassCast)
+}
+
 func IsRetryable(err error) bool {
 	// check whether the error is retryable
 	if _, ok := err.(*hzerrors.RetryableError); ok {
@@ -28,8 +28,11 @@ import (
 	"github.com/hazelcast/hazelcast-go-client/internal/event"
 	"github.com/hazelcast/hazelcast-go-client/internal/logger"
 	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
 )
 
+const maxBackupTimeoutCheckInterval = 100 * time.Millisecond
+
 var (
 	serviceSubID = event.NextSubscriptionID()
 	stateSubID   = event.NextSubscriptionID()
@@ -40,12 +43,16 @@ type Handler interface {
 }
 
 type Service struct {
-	handler         Handler
-	requestCh       chan Invocation
-	responseCh      chan *proto.ClientMessage
-	doneCh          chan struct{}
-	groupLostCh     chan *GroupLostEvent
-	invocations     map[int64]Invocation
+	handler     Handler
+	requestCh   chan Invocation
+	responseCh  chan *proto.ClientMessage
+	doneCh      chan struct{}
+	groupLostCh chan *GroupLostEvent
+	invocations map[int64]Invocation
+	// backups contains the backup acknowledgement state of the invocations, keyed by correlation ID
+	backups map[int64]*pendingBackups
+	// groups contains the group IDs of the sent invocations which are not completed yet, keyed by correlation ID
+	groups          map[int64]int64
 	urgentRequestCh chan Invocation
 	eventDispatcher *event.DispatchService
 	// removeCh carries correlationIDs to be removed
@@ -53,25 +60,44 @@ type Service struct {
 	executor *stripeExecutor
 	logger   logger.LogAdaptor
 	stateMu  *sync.RWMutex
-	running  bool
-	paused   int32
+	// outstanding contains the number of the sent invocations which are not completed yet, keyed by group ID
+	outstanding   map[int64]int
+	outstandingMu *sync.RWMutex
+	// backupAckTimeout is the maximum time to wait for the backup acknowledgements after the response is received.
+	// Backup acknowledgements are not tracked if it is zero.
+	backupAckTimeout time.Duration
+	running          bool
+	paused           int32
+}
+
+// pendingBackups keeps the response of an invocation until its backups are acknowledged.
+type pendingBackups struct {
+	receivedAt time.Time
+	response   *proto.ClientMessage
+	expected   int
+	acked      int
 }
 
-func NewService(handler Handler, ed *event.DispatchService, lg logger.LogAdaptor) *Service {
+func NewService(handler Handler, ed *event.DispatchService, lg logger.LogAdaptor, backupAckTimeout time.Duration) *Service {
 	s := &Service{
-		requestCh:       make(chan Invocation),
-		urgentRequestCh: make(chan Invocation),
-		responseCh:      make(chan *proto.ClientMessage),
-		removeCh:        make(chan int64),
-		doneCh:          make(chan struct{}),
-		groupLostCh:     make(chan *GroupLostEvent),
-		invocations:     map[int64]Invocation{},
-		handler:         handler,
-		eventDispatcher: ed,
-		logger:          lg,
-		stateMu:         &sync.RWMutex{},
-		running:         true,
-		executor:        newStripeExecutor(),
+		requestCh:        make(chan Invocation),
+		urgentRequestCh:  make(chan Invocation),
+		responseCh:       make(chan *proto.ClientMessage),
+		removeCh:         make(chan int64),
+		doneCh:           make(chan struct{}),
+		groupLostCh:      make(chan *GroupLostEvent),
+		invocations:      map[int64]Invocation{},
+		backups:          map[int64]*pendingBackups{},
+		groups:           map[int64]int64{},
+		outstanding:      map[int64]int{},
+		outstandingMu:    &sync.RWMutex{},
+		handler:          handler,
+		eventDispatcher:  ed,
+		logger:           lg,
+		stateMu:          &sync.RWMutex{},
+		running:          true,
+		executor:         newStripeExecutor(),
+		backupAckTimeout: backupAckTimeout,
 	}
 	s.eventDispatcher.Subscribe(EventGroupLost, serviceSubID, func(event event.Event) {
 		go func() {
@@ -174,6 +200,16 @@ func (s *Service) Pause(paused bool) {
 }
 
 func (s *Service) processIncoming() {
+	var backupTimeoutCh <-chan time.Time
+	if s.backupAckTimeout > 0 {
+		interval := s.backupAckTimeout
+		if interval > maxBackupTimeoutCheckInterval {
+			interval = maxBackupTimeoutCheckInterval
+		}
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		backupTimeoutCh = ticker.C
+	}
 loop:
 	for {
 		select {
@@ -187,6 +223,8 @@ loop:
 			s.removeCorrelationID(id)
 		case e := <-s.groupLostCh:
 			s.handleGroupLost(e)
+		case now := <-backupTimeoutCh:
+			s.checkBackupTimeouts(now)
 		case <-s.doneCh:
 			break loop
 		}
@@ -196,6 +234,11 @@ loop:
 		invocation.Close()
 	}
 	s.invocations = nil
+	s.backups = nil
+	s.groups = nil
+	s.outstandingMu.Lock()
+	s.outstanding = map[int64]int{}
+	s.outstandingMu.Unlock()
 }
 
 func (s *Service) sendInvocation(invocation Invocation) {
@@ -210,6 +253,7 @@ func (s *Service) sendInvocation(invocation Invocation) {
 		return
 	}
 	invocation.SetGroup(gid)
+	s.invocationSent(corrID, gid)
 }
 
 func (s *Service) handleClientMessage(msg *proto.ClientMessage) {
@@ -218,7 +262,12 @@ func (s *Service) handleClientMessage(msg *proto.ClientMessage) {
 		s.handleError(correlationID, msg.Err)
 		return
 	}
-	if msg.HasEventFlag() || msg.HasBackupEventFlag() {
+	if msg.HasBackupEventFlag() {
+		// backup events are handled here, since they should not wait behind the other events
+		codec.HandleClientLocalBackupListener(msg, s.handleBackupAck)
+		return
+	}
+	if msg.HasEventFlag() {
 		if inv, found := s.invocations[correlationID]; !found {
 			s.logger.Trace(func() string {
 				return fmt.Sprintf("invocation with unknown correlation ID: %d", correlationID)
@@ -236,6 +285,15 @@ func (s *Service) handleClientMessage(msg *proto.ClientMessage) {
 		}
 		return
 	}
+	if acks := int(msg.NumberOfBackupAcks()); acks > 0 && s.backupAckTimeout > 0 {
+		if s.waitBackups(correlationID, msg, acks) {
+			return
+		}
+	}
+	s.completeInvocation(correlationID, msg)
+}
+
+func (s *Service) completeInvocation(correlationID int64, msg *proto.ClientMessage) {
 	if inv := s.unregisterInvocation(correlationID); inv != nil {
 		inv.Complete(msg)
 	} else {
@@ -245,8 +303,64 @@ func (s *Service) handleClientMessage(msg *proto.ClientMessage) {
 	}
 }
 
+// waitBackups stores the response until the expected number of backups are acknowledged.
+// It returns false if the backups were already acknowledged, so the invocation can be completed.
+func (s *Service) waitBackups(correlationID int64, msg *proto.ClientMessage, expected int) bool {
+	if _, ok := s.invocations[correlationID]; !ok {
+		return false
+	}
+	pb := s.backups[correlationID]
+	if pb == nil {
+		pb = &pendingBackups{}
+		s.backups[correlationID] = pb
+	}
+	if pb.acked >= expected {
+		delete(s.backups, correlationID)
+		return false
+	}
+	pb.response = msg
+	pb.expected = expected
+	pb.receivedAt = time.Now()
+	return true
+}
+
+func (s *Service) handleBackupAck(correlationID int64) {
+	if _, ok := s.invocations[correlationID]; !ok {
+		// the invocation was completed before, e.g., the backup acknowledgement timed out
+		return
+	}
+	pb := s.backups[correlationID]
+	if pb == nil {
+		// the backup acknowledgement may arrive before the response
+		pb = &pendingBackups{}
+		s.backups[correlationID] = pb
+	}
+	pb.acked++
+	if pb.response != nil && pb.acked >= pb.expected {
+		delete(s.backups, correlationID)
+		s.completeInvocation(correlationID, pb.response)
+	}
+}
+
+// checkBackupTimeouts completes the invocations which did not receive all of their backup acknowledgements in time.
+// The operation was run by the member, so the invocation is completed with the response.
+func (s *Service) checkBackupTimeouts(now time.Time) {
+	for correlationID, pb := range s.backups {
+		if pb.response == nil || now.Sub(pb.receivedAt) < s.backupAckTimeout {
+			continue
+		}
+		s.logger.Debug(func() string {
+			return fmt.Sprintf("invocation %d received %d of %d backup acknowledgements in %s", correlationID, pb.acked, pb.expected, s.backupAckTimeout)
+		})
+		delete(s.backups, correlationID)
+		s.completeInvocation(correlationID, pb.response)
+	}
+}
+
 func (s *Service) removeCorrelationID(id int64) {
 	delete(s.invocations, id)
+	delete(s.backups, id)
+	s.invocationDone(id)
 }
 
 func (s *Service) handleError(correlationID int64, invocationErr error) {
@@ -276,6 +390,8 @@ func (s *Service) registerInvocation(invocation Invocation) {
 
 func (s *Service) unregisterInvocation(correlationID int64) Invocation {
 	if invocation, ok := s.invocations[correlationID]; ok {
+		// the invocation is completed even if it is kept for its events
+		s.invocationDone(correlationID)
 		if invocation.EventHandler() == nil {
 			// invocations with event handlers are removed with RemoveListener functions
 			s.removeCorrelationID(correlationID)
@@ -285,6 +401,40 @@ func (s *Service) unregisterInvocation(correlationID int64) Invocation {
 	return nil
 }
 
+// OutstandingInvocations returns the number of the invocations sent to the given group which are not completed yet.
+// The invocations are counted until they are completed with a response or an error, or removed.
+func (s *Service) OutstandingInvocations(groupID int64) int {
+	s.outstandingMu.RLock()
+	defer s.outstandingMu.RUnlock()
+	return s.outstanding[groupID]
+}
+
+func (s *Service) invocationSent(correlationID int64, groupID int64) {
+	// a retried invocation is sent with the same correlation ID
+	s.invocationDone(correlationID)
+	s.groups[correlationID] = groupID
+	s.outstandingMu.Lock()
+	s.outstanding[groupID]++
+	s.outstandingMu.Unlock()
+}
+
+// invocationDone stops counting the invocation with the given correlation ID as outstanding.
+// It is no-op if the invocation was not sent or it was already done.
+func (s *Service) invocationDone(correlationID int64) {
+	groupID, ok := s.groups[correlationID]
+	if !ok {
+		return
+	}
+	delete(s.groups, correlationID)
+	s.outstandingMu.Lock()
+	if n := s.outstanding[groupID] - 1; n > 0 {
+		s.outstanding[groupID] = n
+	} else {
+		delete(s.outstanding, groupID)
+	}
+	s.outstandingMu.Unlock()
+}
+
 func (s *Service) handleGroupLost(e *GroupLostEvent) {
 	s.stateMu.RLock()
 	defer s.stateMu.RUnlock()
@@ -293,6 +443,12 @@ func (s *Service) handleGroupLost(e *GroupLostEvent) {
 	}
 	for corrID, inv := range s.invocations {
 		if inv.Group() == e.GroupID && !inv.Request().HasEventFlag() {
+			if pb, ok := s.backups[corrID]; ok && pb.response != nil {
+				// the member sent the response before the connection was lost, only the backup acknowledgements are missing
+				delete(s.backups, corrID)
+				s.completeInvocation(corrID, pb.response)
+				continue
+			}
 			s.handleError(corrID, e.Err)
 		}
 	}
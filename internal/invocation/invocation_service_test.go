@@ -0,0 +1,146 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package invocation_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/event"
+	"github.com/hazelcast/hazelcast-go-client/internal/invocation"
+	"github.com/hazelcast/hazelcast-go-client/internal/logger"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+)
+
+func TestService_BackupAcks(t *testing.T) {
+	s := newTestService(t, time.Minute)
+	inv := sendTestInvocation(t, s, 1)
+	require.NoError(t, s.WriteResponse(newTestResponse(1, 2)))
+	require.NoError(t, s.WriteResponse(newTestBackupEvent(1)))
+	assertNotCompleted(t, inv)
+	require.NoError(t, s.WriteResponse(newTestBackupEvent(1)))
+	msg, err := inv.Get()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), msg.CorrelationID())
+}
+
+func TestService_BackupAcks_BeforeResponse(t *testing.T) {
+	s := newTestService(t, time.Minute)
+	inv := sendTestInvocation(t, s, 1)
+	require.NoError(t, s.WriteResponse(newTestBackupEvent(1)))
+	require.NoError(t, s.WriteResponse(newTestResponse(1, 1)))
+	_, err := inv.Get()
+	require.NoError(t, err)
+}
+
+func TestService_BackupAcks_Timeout(t *testing.T) {
+	s := newTestService(t, 50*time.Millisecond)
+	inv := sendTestInvocation(t, s, 1)
+	start := time.Now()
+	require.NoError(t, s.WriteResponse(newTestResponse(1, 1)))
+	// the invocation is completed with the response when the backup acks time out
+	_, err := inv.Get()
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(50*time.Millisecond))
+}
+
+func TestService_BackupAcks_Disabled(t *testing.T) {
+	s := newTestService(t, 0)
+	inv := sendTestInvocation(t, s, 1)
+	require.NoError(t, s.WriteResponse(newTestResponse(1, 1)))
+	_, err := inv.Get()
+	require.NoError(t, err)
+}
+
+func TestService_OutstandingInvocations(t *testing.T) {
+	s := newTestService(t, 0)
+	completed := sendTestInvocation(t, s, 1)
+	failed := sendTestInvocation(t, s, 2)
+	sendTestInvocation(t, s, 3)
+	assert.Eventually(t, func() bool {
+		return s.OutstandingInvocations(1) == 3
+	}, time.Second, 10*time.Millisecond)
+	require.NoError(t, s.WriteResponse(newTestResponse(1, 0)))
+	_, err := completed.Get()
+	require.NoError(t, err)
+	failure := newTestResponse(2, 0)
+	failure.Err = errors.New("failed")
+	require.NoError(t, s.WriteResponse(failure))
+	_, err = failed.Get()
+	require.Error(t, err)
+	// cancelled invocations are removed without a response
+	require.NoError(t, s.Remove(3))
+	assert.Eventually(t, func() bool {
+		return s.OutstandingInvocations(1) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+type testHandler struct{}
+
+func (testHandler) Invoke(inv invocation.Invocation) (int64, error) {
+	return 1, nil
+}
+
+func newTestService(t *testing.T, backupAckTimeout time.Duration) *invocation.Service {
+	lg := logger.LogAdaptor{Logger: logger.New()}
+	ed := event.NewDispatchService(lg)
+	s := invocation.NewService(testHandler{}, ed, lg, backupAckTimeout)
+	t.Cleanup(func() {
+		s.Stop()
+		_ = ed.Stop(context.Background())
+	})
+	return s
+}
+
+func sendTestInvocation(t *testing.T, s *invocation.Service, correlationID int64) *invocation.Impl {
+	msg := proto.NewClientMessage(proto.NewFrameWith(make([]byte, 64), proto.UnfragmentedMessage))
+	msg.SetCorrelationID(correlationID)
+	inv := invocation.NewImpl(msg, -1, "", time.Now().Add(10*time.Second), false)
+	require.NoError(t, s.SendRequest(context.Background(), inv))
+	return inv
+}
+
+func newTestResponse(correlationID int64, backupAcks uint8) *proto.ClientMessage {
+	content := make([]byte, proto.ResponseBackupAcksOffset+proto.ByteSizeInBytes)
+	content[proto.ResponseBackupAcksOffset] = backupAcks
+	msg := proto.NewClientMessage(proto.NewFrameWith(content, proto.UnfragmentedMessage))
+	msg.SetCorrelationID(correlationID)
+	return msg
+}
+
+func newTestBackupEvent(sourceCorrelationID int64) *proto.ClientMessage {
+	content := make([]byte, codec.ClientLocalBackupListenerEventBackupSourceInvocationCorrelationIdOffset+proto.LongSizeInBytes)
+	codec.FixSizedTypesCodec.EncodeLong(content, codec.ClientLocalBackupListenerEventBackupSourceInvocationCorrelationIdOffset, sourceCorrelationID)
+	msg := proto.NewClientMessage(proto.NewFrameWith(content, proto.UnfragmentedMessage|proto.IsEventFlag|proto.BackupEventFlag))
+	msg.SetMessageType(codec.ClientLocalBackupListenerCodecEventBackupMessageType)
+	// backup events have the correlation ID of the listener registration
+	msg.SetCorrelationID(1000)
+	return msg
+}
+
+func assertNotCompleted(t *testing.T, inv *invocation.Impl) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err := inv.GetWithContext(ctx)
+	require.Error(t, err)
+}
@@ -450,6 +450,12 @@ func xmlConfig(clusterName string, port int) string {
 					<class-name>com.hazelcast.client.test.SampleMapStore</class-name>
 				</map-store>
 			</map>
+			<map name="event-journal-map*">
+				<event-journal enabled="true"/>
+			</map>
+			<cache name="test-cache*">
+				<statistics-enabled>false</statistics-enabled>
+			</cache>
 			<serialization>
 				<data-serializable-factories>
 					<data-serializable-factory factory-id="66">com.hazelcast.client.test.IdentifiedFactory</data-serializable-factory>
@@ -0,0 +1,75 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package jet
+
+import "fmt"
+
+// JobStatus is the status of a Jet job.
+type JobStatus int32
+
+// see: com.hazelcast.jet.core.JobStatus
+const (
+	// JobStatusNotRunning is the status of a job which is submitted but not started yet.
+	JobStatusNotRunning JobStatus = 0
+	// JobStatusStarting is the status of a job which is being started.
+	JobStatusStarting JobStatus = 1
+	// JobStatusRunning is the status of a running job.
+	JobStatusRunning JobStatus = 2
+	// JobStatusSuspended is the status of a suspended job.
+	JobStatusSuspended JobStatus = 3
+	// JobStatusSuspendedExportingSnapshot is the status of a suspended job which is exporting a snapshot.
+	JobStatusSuspendedExportingSnapshot JobStatus = 4
+	// JobStatusCompleting is the status of a job which is completing, either successfully or with a failure.
+	JobStatusCompleting JobStatus = 5
+	// JobStatusFailed is the status of a job which failed or was canceled.
+	JobStatusFailed JobStatus = 6
+	// JobStatusCompleted is the status of a job which completed successfully.
+	JobStatusCompleted JobStatus = 7
+)
+
+// IsTerminal returns true if the job is completed or failed.
+func (s JobStatus) IsTerminal() bool {
+	return s == JobStatusFailed || s == JobStatusCompleted
+}
+
+// IsActive returns true if the job is not completed and not failed.
+func (s JobStatus) IsActive() bool {
+	return !s.IsTerminal()
+}
+
+func (s JobStatus) String() string {
+	switch s {
+	case JobStatusNotRunning:
+		return "NOT_RUNNING"
+	case JobStatusStarting:
+		return "STARTING"
+	case JobStatusRunning:
+		return "RUNNING"
+	case JobStatusSuspended:
+		return "SUSPENDED"
+	case JobStatusSuspendedExportingSnapshot:
+		return "SUSPENDED_EXPORTING_SNAPSHOT"
+	case JobStatusCompleting:
+		return "COMPLETING"
+	case JobStatusFailed:
+		return "FAILED"
+	case JobStatusCompleted:
+		return "COMPLETED"
+	default:
+		return fmt.Sprintf("UNKNOWN(%d)", int32(s))
+	}
+}
@@ -0,0 +1,252 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package jet
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/client"
+	"github.com/hazelcast/hazelcast-go-client/internal/cluster"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+/*
+Service manages the Jet jobs running in the cluster.
+
+Jobs are created by submitting SQL statements, such as CREATE JOB, or using the Java API.
+Service can be used to list the jobs, query their status, and cancel, suspend, resume or restart them.
+Light jobs, such as the ones created by streaming SQL queries, are not managed by Service.
+
+Job operations are sent to the master member of the cluster, which coordinates the jobs.
+*/
+type Service struct {
+	invoker        *client.Invoker
+	clusterService *cluster.Service
+}
+
+func NewService(invoker *client.Invoker, clusterService *cluster.Service) *Service {
+	return &Service{
+		invoker:        invoker,
+		clusterService: clusterService,
+	}
+}
+
+// GetJobs returns all the jobs submitted to the cluster, including the completed ones which were not purged yet.
+func (s *Service) GetJobs(ctx context.Context) ([]*Job, error) {
+	summaries, err := s.getSummaries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	jobs := make([]*Job, 0, len(summaries))
+	for _, sm := range summaries {
+		if sm.LightJob {
+			continue
+		}
+		jobs = append(jobs, newJob(s, sm))
+	}
+	return jobs, nil
+}
+
+// GetJob returns the job with the given ID.
+// Returns nil if the job does not exist.
+func (s *Service) GetJob(ctx context.Context, id int64) (*Job, error) {
+	summaries, err := s.getSummaries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, sm := range summaries {
+		if !sm.LightJob && sm.JobId == id {
+			return newJob(s, sm), nil
+		}
+	}
+	return nil, nil
+}
+
+// GetJobByName returns the active job with the given name.
+// If there is no active job with the given name, returns the job which was submitted last with that name.
+// Returns nil if the job does not exist.
+func (s *Service) GetJobByName(ctx context.Context, name string) (*Job, error) {
+	summaries, err := s.getSummaries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var found *codec.JobAndSqlSummary
+	for i, sm := range summaries {
+		if sm.LightJob || sm.NameOrId != name {
+			continue
+		}
+		if JobStatus(sm.Status).IsActive() {
+			return newJob(s, sm), nil
+		}
+		if found == nil || sm.SubmissionTime > found.SubmissionTime {
+			found = &summaries[i]
+		}
+	}
+	if found == nil {
+		return nil, nil
+	}
+	return newJob(s, *found), nil
+}
+
+func (s *Service) getSummaries(ctx context.Context) ([]codec.JobAndSqlSummary, error) {
+	request := codec.EncodeJetGetJobAndSqlSummaryListRequest()
+	resp, err := s.invokeOnMaster(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return codec.DecodeJetGetJobAndSqlSummaryListResponse(resp), nil
+}
+
+func (s *Service) invokeOnMaster(ctx context.Context, request *proto.ClientMessage) (*proto.ClientMessage, error) {
+	// the oldest member in the member list is the master.
+	members := s.clusterService.OrderedMembers()
+	if len(members) == 0 {
+		return nil, hzerrors.ErrClientOffline
+	}
+	master := members[0]
+	return s.invoker.InvokeOnMember(ctx, request, &master)
+}
+
+// see: com.hazelcast.jet.impl.TerminationMode
+const (
+	terminateModeRestartGraceful int32 = 0
+	terminateModeSuspendGraceful int32 = 2
+	terminateModeCancelForceful  int32 = 5
+)
+
+// Job is a Jet job running in the cluster.
+type Job struct {
+	service        *Service
+	name           string
+	submissionTime time.Time
+	id             int64
+}
+
+func newJob(s *Service, sm codec.JobAndSqlSummary) *Job {
+	name := sm.NameOrId
+	// jobs without a name are identified with the formatted job ID.
+	if name == idToString(sm.JobId) {
+		name = ""
+	}
+	return &Job{
+		service:        s,
+		id:             sm.JobId,
+		name:           name,
+		submissionTime: time.UnixMilli(sm.SubmissionTime),
+	}
+}
+
+// ID returns the ID of the job.
+func (j *Job) ID() int64 {
+	return j.id
+}
+
+// IDString returns the ID of the job in the format used by the members, e.g., 0a1b-2c3d-4e5f-6789.
+func (j *Job) IDString() string {
+	return idToString(j.id)
+}
+
+// Name returns the name of the job, or an empty string if the job has no name.
+func (j *Job) Name() string {
+	return j.name
+}
+
+// SubmissionTime returns the time when the job was submitted.
+func (j *Job) SubmissionTime() time.Time {
+	return j.submissionTime
+}
+
+// Status returns the current status of the job.
+func (j *Job) Status(ctx context.Context) (JobStatus, error) {
+	request := codec.EncodeJetGetJobStatusRequest(j.id)
+	resp, err := j.service.invokeOnMaster(ctx, request)
+	if err != nil {
+		return 0, err
+	}
+	return JobStatus(codec.DecodeJetGetJobStatusResponse(resp)), nil
+}
+
+// Cancel cancels the job.
+// The job is canceled asynchronously, use Status to see when it is completed.
+// Snapshots of the job are not kept, so the job cannot be resumed.
+func (j *Job) Cancel(ctx context.Context) error {
+	return j.terminate(ctx, terminateModeCancelForceful)
+}
+
+// Suspend suspends the job gracefully, so it can be resumed later.
+// The job is suspended asynchronously, use Status to see when it is suspended.
+// Only the jobs with processing guarantees can be suspended gracefully.
+func (j *Job) Suspend(ctx context.Context) error {
+	return j.terminate(ctx, terminateModeSuspendGraceful)
+}
+
+// Restart restarts the job gracefully.
+// The job is restarted asynchronously.
+func (j *Job) Restart(ctx context.Context) error {
+	return j.terminate(ctx, terminateModeRestartGraceful)
+}
+
+// Resume resumes the suspended job.
+func (j *Job) Resume(ctx context.Context) error {
+	request := codec.EncodeJetResumeJobRequest(j.id)
+	_, err := j.service.invokeOnMaster(ctx, request)
+	return err
+}
+
+// ExportSnapshot exports a snapshot of the job state with the given name.
+// The job keeps running after the snapshot is exported.
+// The exported snapshot can be used to start a new job, see the Jet documentation for details.
+func (j *Job) ExportSnapshot(ctx context.Context, name string) error {
+	return j.exportSnapshot(ctx, name, false)
+}
+
+// CancelAndExportSnapshot exports a snapshot of the job state with the given name and cancels the job.
+func (j *Job) CancelAndExportSnapshot(ctx context.Context, name string) error {
+	return j.exportSnapshot(ctx, name, true)
+}
+
+// String returns the name and ID of the job.
+func (j *Job) String() string {
+	if j.name == "" {
+		return fmt.Sprintf("Job{id=%s}", j.IDString())
+	}
+	return fmt.Sprintf("Job{id=%s, name=%s}", j.IDString(), j.name)
+}
+
+func (j *Job) exportSnapshot(ctx context.Context, name string, cancel bool) error {
+	request := codec.EncodeJetExportSnapshotRequest(j.id, name, cancel)
+	_, err := j.service.invokeOnMaster(ctx, request)
+	return err
+}
+
+func (j *Job) terminate(ctx context.Context, mode int32) error {
+	// the light job coordinator is nil for normal jobs.
+	request := codec.EncodeJetTerminateJobRequest(j.id, mode, types.UUID{})
+	_, err := j.service.invokeOnMaster(ctx, request)
+	return err
+}
+
+// idToString formats the job ID in the same way with com.hazelcast.jet.Util#idToString
+func idToString(id int64) string {
+	u := uint64(id)
+	return fmt.Sprintf("%04x-%04x-%04x-%04x", u>>48&0xffff, u>>32&0xffff, u>>16&0xffff, u&0xffff)
+}
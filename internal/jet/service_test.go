@@ -0,0 +1,37 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package jet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIDToString(t *testing.T) {
+	assert.Equal(t, "0000-0000-0000-0001", idToString(1))
+	assert.Equal(t, "0a1b-2c3d-4e5f-6789", idToString(0x0a1b2c3d4e5f6789))
+	assert.Equal(t, "ffff-ffff-ffff-ffff", idToString(-1))
+}
+
+func TestJobStatus(t *testing.T) {
+	assert.Equal(t, "RUNNING", JobStatusRunning.String())
+	assert.Equal(t, "UNKNOWN(42)", JobStatus(42).String())
+	assert.True(t, JobStatusCompleted.IsTerminal())
+	assert.True(t, JobStatusFailed.IsTerminal())
+	assert.True(t, JobStatusSuspended.IsActive())
+}
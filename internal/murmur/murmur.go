@@ -71,6 +71,127 @@ func M3A(key []byte, offset int32, len int, seed uint32) int32 {
 	return int32(h1)
 }
 
+func Default64(key []byte, offset int32, len int) int64 {
+	return M64(key, offset, len, defaultSeed)
+}
+
+// M64 computes MurmurHash3 for x64, 64-bit (MurmurHash3_x64_64).
+// It is the same variant the members use, so the hashes are compatible with the ones computed by the Java client.
+func M64(key []byte, offset int32, len int, seed uint32) int64 {
+	// the seed is an int on the members, so it is sign extended.
+	s := uint64(int64(int32(seed)))
+	h1 := 0x9368e53c2f6af274 ^ s
+	h2 := 0x586dcd208f7cd3fd ^ s
+	var c1 uint64 = 0x87c37b91114253d5
+	var c2 uint64 = 0x4cf5ad432745937f
+	var k1, k2 uint64
+	// body
+	for i := 0; i < len/16; i++ {
+		pos := int(offset) + i*16
+		k1 = binary.LittleEndian.Uint64(key[pos:])
+		k2 = binary.LittleEndian.Uint64(key[pos+8:])
+		h1, h2, c1, c2 = bmix64(h1, h2, k1, k2, c1, c2)
+	}
+
+	// tail
+	// bytes are sign extended in the tail, as it is done by the members.
+	k1, k2 = 0, 0
+	tail := key[int(offset)+(len>>4)<<4:]
+	switch len & 15 {
+	case 15:
+		k2 ^= uint64(int64(int8(tail[14]))) << 48
+		fallthrough
+	case 14:
+		k2 ^= uint64(int64(int8(tail[13]))) << 40
+		fallthrough
+	case 13:
+		k2 ^= uint64(int64(int8(tail[12]))) << 32
+		fallthrough
+	case 12:
+		k2 ^= uint64(int64(int8(tail[11]))) << 24
+		fallthrough
+	case 11:
+		k2 ^= uint64(int64(int8(tail[10]))) << 16
+		fallthrough
+	case 10:
+		k2 ^= uint64(int64(int8(tail[9]))) << 8
+		fallthrough
+	case 9:
+		k2 ^= uint64(int64(int8(tail[8])))
+		fallthrough
+	case 8:
+		k1 ^= uint64(int64(int8(tail[7]))) << 56
+		fallthrough
+	case 7:
+		k1 ^= uint64(int64(int8(tail[6]))) << 48
+		fallthrough
+	case 6:
+		k1 ^= uint64(int64(int8(tail[5]))) << 40
+		fallthrough
+	case 5:
+		k1 ^= uint64(int64(int8(tail[4]))) << 32
+		fallthrough
+	case 4:
+		k1 ^= uint64(int64(int8(tail[3]))) << 24
+		fallthrough
+	case 3:
+		k1 ^= uint64(int64(int8(tail[2]))) << 16
+		fallthrough
+	case 2:
+		k1 ^= uint64(int64(int8(tail[1]))) << 8
+		fallthrough
+	case 1:
+		k1 ^= uint64(int64(int8(tail[0])))
+		h1, h2, _, _ = bmix64(h1, h2, k1, k2, c1, c2)
+	}
+
+	// finalization
+	h2 ^= uint64(len)
+	h1 += h2
+	h2 += h1
+	h1 = fmix64(h1)
+	h2 = fmix64(h2)
+
+	return int64(h1 + h2)
+}
+
+func bmix64(h1, h2, k1, k2, c1, c2 uint64) (uint64, uint64, uint64, uint64) {
+	k1 *= c1
+	k1 = rotl64(k1, 23)
+	k1 *= c2
+	h1 ^= k1
+	h1 += h2
+
+	h2 = rotl64(h2, 41)
+
+	k2 *= c2
+	k2 = rotl64(k2, 23)
+	k2 *= c1
+	h2 ^= k2
+	h2 += h1
+
+	h1 = h1*3 + 0x52dce729
+	h2 = h2*3 + 0x38495ab5
+
+	c1 = c1*5 + 0x7b7d159c
+	c2 = c2*5 + 0x6bce6396
+	return h1, h2, c1, c2
+}
+
+func rotl64(x uint64, r uint8) uint64 {
+	return (x << r) | (x >> (64 - r))
+}
+
+func fmix64(k uint64) uint64 {
+	k ^= k >> 33
+	k *= 0xff51afd7ed558ccd
+	k ^= k >> 33
+	k *= 0xc4ceb9fe1a85ec53
+	k ^= k >> 33
+
+	return k
+}
+
 func rotl32(x uint32, r uint8) uint32 {
 	return (x << r) | (x >> (32 - r))
 }
@@ -49,3 +49,96 @@ func TestMurmur3A(t *testing.T) {
 		}
 	}
 }
+
+// The expected values of the tests below are computed with a port of HashUtil.MurmurHash3_x64_64 of Hazelcast,
+// which follows the Java semantics for the sign extension of the seed and the tail bytes.
+
+func TestMurmur64_KnownAnswers(t *testing.T) {
+	testCases := []struct {
+		key      string
+		seed     uint32
+		expected int64
+	}{
+		{key: "", seed: defaultSeed, expected: 9168145165656307917},
+		{key: "a", seed: defaultSeed, expected: 1026673004001772663},
+		{key: "key-1", seed: defaultSeed, expected: -1733864005987780522},
+		{key: "hazelcast", seed: defaultSeed, expected: -5410766897535987873},
+		// a multiple of 16 bytes, without a tail
+		{key: "0123456789abcdef", seed: defaultSeed, expected: -8662831058301528006},
+		{key: "The quick brown fox jumps over the lazy dog", seed: defaultSeed, expected: -6294855256513888441},
+		{key: "", seed: 0, expected: -7781342737886326986},
+		{key: "hazelcast", seed: 0, expected: 8008912873139483539},
+		// the seed is negative on the members
+		{key: "", seed: 0x9747b28c, expected: -4582578146627449948},
+		{key: "a", seed: 0x9747b28c, expected: 3869411341071419255},
+		{key: "hazelcast", seed: 0x9747b28c, expected: 3173396765219167495},
+		{key: "0123456789abcdef", seed: 0x9747b28c, expected: 7092591712332224450},
+		{key: "The quick brown fox jumps over the lazy dog", seed: 0x9747b28c, expected: 3395587117405628256},
+	}
+	for _, tc := range testCases {
+		if hash := M64([]byte(tc.key), 0, len(tc.key), tc.seed); hash != tc.expected {
+			t.Errorf("expected %d but was %d for %q with seed %#x", tc.expected, hash, tc.key, tc.seed)
+		}
+	}
+}
+
+func TestMurmur64_TailLengths(t *testing.T) {
+	// the bytes are negative, so the sign extension in the tail is covered.
+	key := make([]byte, 40)
+	for i := range key {
+		key[i] = byte(0xF0 + i)
+	}
+	expected := []int64{
+		9168145165656307917,
+		2874245001482947164,
+		3506670568656274987,
+		-2587707512573805183,
+		4258390735136193745,
+		-1934321294228006667,
+		-5599252657615018774,
+		-6866444395851368565,
+		-4543664836224596819,
+		1909646335381620208,
+		-1859704792205988977,
+		-8718926721524412597,
+		2112243107355021151,
+		5435919354412769828,
+		332745194947516003,
+		-2738921930011356851,
+		541832174137108936,
+	}
+	for l, e := range expected {
+		if hash := Default64(key, 0, l); hash != e {
+			t.Errorf("expected %d but was %d for length %d", e, hash, l)
+		}
+	}
+	for l, e := range map[int]int64{31: 3487053373048627592, 32: 1704644106862768772, 33: 7305780538994401606} {
+		if hash := Default64(key, 0, l); hash != e {
+			t.Errorf("expected %d but was %d for length %d", e, hash, l)
+		}
+	}
+}
+
+func TestMurmur64(t *testing.T) {
+	// cover all tail lengths, and negative bytes in the tail.
+	key := make([]byte, 40)
+	for i := range key {
+		key[i] = byte(0xF0 + i)
+	}
+	seen := map[int64]int{}
+	for l := 0; l <= 32; l++ {
+		hash := Default64(key, 0, l)
+		if hash != Default64(key, 0, l) {
+			t.Fatalf("hash is not deterministic for length %d", l)
+		}
+		// the hash must only depend on the bytes in the range.
+		shifted := append([]byte{1, 2, 3}, key...)
+		if shifted := Default64(shifted, 3, l); shifted != hash {
+			t.Fatalf("expected %d but was %d for offset 3 and length %d", hash, shifted, l)
+		}
+		if prev, ok := seen[hash]; ok {
+			t.Fatalf("lengths %d and %d have the same hash", prev, l)
+		}
+		seen[hash] = l
+	}
+}
@@ -37,15 +37,23 @@ type Manager struct {
 	state        int32
 }
 
+// NewManager creates the Near Cache manager for maps.
 func NewManager(ic *client.Client, reconInterval, maxMiss int) *Manager {
+	mf := NewInvalidationMetaDataFetcher(ic.ClusterService, ic.InvocationService, ic.InvocationFactory, ic.Logger)
+	return newManager(ic, mf, reconInterval, maxMiss)
+}
+
+// NewCacheManager creates the Near Cache manager for caches.
+func NewCacheManager(ic *client.Client, reconInterval, maxMiss int) *Manager {
+	mf := NewCacheInvalidationMetaDataFetcher(ic.ClusterService, ic.InvocationService, ic.InvocationFactory, ic.Logger)
+	return newManager(ic, mf, reconInterval, maxMiss)
+}
+
+func newManager(ic *client.Client, mf InvalidationMetaDataFetcher, reconInterval, maxMiss int) *Manager {
 	doneCh := make(chan struct{})
-	cs := ic.ClusterService
-	is := ic.InvocationService
 	ss := ic.SerializationService
 	ps := ic.PartitionService
-	inf := ic.InvocationFactory
 	lg := ic.Logger
-	mf := NewInvalidationMetaDataFetcher(cs, is, inf, lg)
 	uuid := ic.ConnectionManager.ClientUUID()
 	rt := NewReparingTask(reconInterval, maxMiss, ss, ps, lg, mf, uuid, doneCh)
 	ncm := &Manager{
@@ -81,7 +89,7 @@ func (m *Manager) GetOrCreateNearCache(name string, cfg nearcache.Config) *NearC
 	m.nearCachesMu.Lock()
 	nc, ok = m.nearCaches[name]
 	if !ok {
-		nc = NewNearCache(&cfg, m.ss, m.lg)
+		nc = NewNearCache(name, &cfg, m.ss, m.lg)
 		m.nearCaches[name] = nc
 	}
 	m.nearCachesMu.Unlock()
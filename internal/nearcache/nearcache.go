@@ -17,6 +17,8 @@
 package nearcache
 
 import (
+	"context"
+	"fmt"
 	"os"
 	"sync/atomic"
 	"time"
@@ -43,14 +45,18 @@ const (
 )
 
 type NearCache struct {
-	store  *RecordStore
-	cfg    *nearcache.Config
-	lg     ilogger.LogAdaptor
-	doneCh chan struct{}
-	state  int32
-}
-
-func NewNearCache(cfg *nearcache.Config, ss *serialization.Service, lg ilogger.LogAdaptor) *NearCache {
+	store     *RecordStore
+	cfg       *nearcache.Config
+	preloader *Preloader
+	lg        ilogger.LogAdaptor
+	doneCh    chan struct{}
+	name      string
+	state     int32
+	// preloadState is set to 1 when the preload is started.
+	preloadState int32
+}
+
+func NewNearCache(name string, cfg *nearcache.Config, ss *serialization.Service, lg ilogger.LogAdaptor) *NearCache {
 	var rc nearCacheRecordValueConverter
 	var se nearCacheStorageEstimator
 	if cfg.InMemoryFormat == nearcache.InMemoryFormatBinary {
@@ -67,6 +73,10 @@ func NewNearCache(cfg *nearcache.Config, ss *serialization.Service, lg ilogger.L
 		store:  NewRecordStore(cfg, ss, rc, se),
 		lg:     lg,
 		doneCh: make(chan struct{}),
+		name:   name,
+	}
+	if pc := cfg.Preloader(); pc.Enabled {
+		nc.preloader = NewPreloader(name, pc, ss)
 	}
 	if cfg.TimeToLiveSeconds > 0 || cfg.MaxIdleSeconds > 0 {
 		delay := nc.parseDurationOrDefault(EnvExpirationTaskInitialDelay, defaultExpirationTaskInitialDelay)
@@ -113,7 +123,29 @@ func (nc NearCache) Size() int {
 }
 
 func (nc NearCache) Stats() nearcache.Stats {
-	return nc.store.Stats()
+	stats := nc.store.Stats()
+	if nc.preloader != nil {
+		nc.preloader.UpdateStats(&stats)
+	}
+	return stats
+}
+
+// Preload loads the keys stored by the preloader using the given function and starts storing the keys periodically.
+// It does nothing if the preloader is not enabled or the preload was already started.
+func (nc *NearCache) Preload(ctx context.Context, fn PreloadFunc) error {
+	if nc.preloader == nil || !atomic.CompareAndSwapInt32(&nc.preloadState, 0, 1) {
+		return nil
+	}
+	count, err := nc.preloader.Load(ctx, fn)
+	// keys are stored even if the preload fails, so a corrupt key file is replaced.
+	go nc.startPreloaderStoreTask()
+	if err != nil {
+		return fmt.Errorf("preloading Near Cache %s: %w", nc.name, err)
+	}
+	nc.lg.Debug(func() string {
+		return fmt.Sprintf("preloaded %d keys to Near Cache %s", count, nc.name)
+	})
+	return nil
 }
 
 // InvalidationRequests returns the invalidation requests.
@@ -167,6 +199,37 @@ func (nc *NearCache) startExpirationTask(delay, timeout time.Duration) {
 	}
 }
 
+func (nc *NearCache) startPreloaderStoreTask() {
+	pc := nc.preloader.cfg
+	select {
+	case <-nc.doneCh:
+		return
+	case <-time.After(time.Duration(pc.StoreInitialDelaySeconds) * time.Second):
+	}
+	nc.storeKeys()
+	ticker := time.NewTicker(time.Duration(pc.StoreIntervalSeconds) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-nc.doneCh:
+			return
+		case <-ticker.C:
+			nc.storeKeys()
+		}
+	}
+}
+
+func (nc *NearCache) storeKeys() {
+	keys := nc.store.Keys()
+	if err := nc.preloader.Store(keys); err != nil {
+		nc.lg.Warnf("storing keys of Near Cache %s: %s", nc.name, err.Error())
+		return
+	}
+	nc.lg.Debug(func() string {
+		return fmt.Sprintf("stored %d keys of Near Cache %s to %s", len(keys), nc.name, nc.preloader.Filename())
+	})
+}
+
 func (nc *NearCache) parseDurationOrDefault(envName string, d time.Duration) time.Duration {
 	str := os.Getenv(envName)
 	if str == "" {
@@ -0,0 +1,240 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nearcache
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/nearcache"
+)
+
+const (
+	// preloaderMagic is written at the start of the key files.
+	preloaderMagic         uint32 = 0xE1D0C0DE
+	preloaderFileVersion   int32  = 1
+	preloaderLoadBatchSize        = 100
+	// keys larger than this are considered corrupt.
+	preloaderMaxKeySize = 64 * 1024 * 1024
+)
+
+var preloaderUnsafeChars = regexp.MustCompile(`[^a-zA-Z0-9._-]`)
+
+// PreloadFunc loads the values of the given keys to the Near Cache.
+type PreloadFunc func(ctx context.Context, keys []interface{}) error
+
+// Preloader stores the keys of a Near Cache to a file and loads them back.
+// See: com.hazelcast.internal.nearcache.impl.preloader.NearCachePreloader
+type Preloader struct {
+	ss        *serialization.Service
+	statsMu   *sync.Mutex
+	filename  string
+	lastStats nearcache.Stats
+	cfg       nearcache.PreloaderConfig
+}
+
+// NewPreloader creates a preloader for the Near Cache with the given name.
+func NewPreloader(name string, cfg nearcache.PreloaderConfig, ss *serialization.Service) *Preloader {
+	// make sure the defaults are set
+	_ = cfg.Validate()
+	filename := fmt.Sprintf("nearCache-%s.store", preloaderUnsafeChars.ReplaceAllString(name, "_"))
+	return &Preloader{
+		ss:       ss,
+		statsMu:  &sync.Mutex{},
+		filename: filepath.Join(cfg.Directory, filename),
+		cfg:      cfg,
+	}
+}
+
+// Filename returns the path of the key file.
+func (p *Preloader) Filename() string {
+	return p.filename
+}
+
+// Load reads the keys from the key file and passes them to the given function in batches.
+// It does nothing if the key file does not exist.
+func (p *Preloader) Load(ctx context.Context, fn PreloadFunc) (int, error) {
+	f, err := os.Open(p.filename)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("opening Near Cache key file: %w", err)
+	}
+	defer f.Close()
+	r := bufio.NewReader(f)
+	if err := readPreloaderHeader(r); err != nil {
+		return 0, err
+	}
+	var loaded int
+	batch := make([]interface{}, 0, preloaderLoadBatchSize)
+	for {
+		keyData, err := readPreloaderKey(r)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return loaded, err
+		}
+		key, err := p.ss.ToObject(keyData)
+		if err != nil {
+			return loaded, fmt.Errorf("deserializing Near Cache key: %w", err)
+		}
+		batch = append(batch, key)
+		if len(batch) == preloaderLoadBatchSize {
+			if err := fn(ctx, batch); err != nil {
+				return loaded, err
+			}
+			loaded += len(batch)
+			batch = make([]interface{}, 0, preloaderLoadBatchSize)
+		}
+	}
+	if len(batch) > 0 {
+		if err := fn(ctx, batch); err != nil {
+			return loaded, err
+		}
+		loaded += len(batch)
+	}
+	return loaded, nil
+}
+
+// Store writes the given keys to the key file and updates the persistence statistics.
+// The keys are first written to a temporary file, which replaces the key file when complete.
+func (p *Preloader) Store(keys []interface{}) error {
+	start := time.Now()
+	written, err := p.store(keys)
+	p.statsMu.Lock()
+	defer p.statsMu.Unlock()
+	p.lastStats.LastPersistenceTime = start
+	p.lastStats.LastPersistenceDuration = time.Since(start)
+	if err != nil {
+		p.lastStats.LastPersistenceFailure = err.Error()
+		return err
+	}
+	p.lastStats.PersistenceCount++
+	p.lastStats.LastPersistenceKeyCount = int64(len(keys))
+	p.lastStats.LastPersistenceWrittenBytes = written
+	p.lastStats.LastPersistenceFailure = ""
+	return nil
+}
+
+func (p *Preloader) store(keys []interface{}) (int64, error) {
+	if p.cfg.Directory != "" {
+		if err := os.MkdirAll(p.cfg.Directory, 0o755); err != nil {
+			return 0, fmt.Errorf("creating Near Cache key directory: %w", err)
+		}
+	}
+	tmpFilename := p.filename + "~"
+	f, err := os.Create(tmpFilename)
+	if err != nil {
+		return 0, fmt.Errorf("creating Near Cache key file: %w", err)
+	}
+	written, err := p.writeKeys(f, keys)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmpFilename)
+		return 0, fmt.Errorf("writing Near Cache key file: %w", err)
+	}
+	if err := os.Rename(tmpFilename, p.filename); err != nil {
+		os.Remove(tmpFilename)
+		return 0, fmt.Errorf("replacing Near Cache key file: %w", err)
+	}
+	return written, nil
+}
+
+func (p *Preloader) writeKeys(w io.Writer, keys []interface{}) (int64, error) {
+	bw := bufio.NewWriter(w)
+	var buf [8]byte
+	binary.BigEndian.PutUint32(buf[:4], preloaderMagic)
+	binary.BigEndian.PutUint32(buf[4:], uint32(preloaderFileVersion))
+	if _, err := bw.Write(buf[:]); err != nil {
+		return 0, err
+	}
+	written := int64(len(buf))
+	for _, key := range keys {
+		keyData, err := p.ss.ToData(key)
+		if err != nil {
+			return 0, fmt.Errorf("serializing Near Cache key: %w", err)
+		}
+		binary.BigEndian.PutUint32(buf[:4], uint32(len(keyData)))
+		if _, err := bw.Write(buf[:4]); err != nil {
+			return 0, err
+		}
+		if _, err := bw.Write(keyData); err != nil {
+			return 0, err
+		}
+		written += int64(4 + len(keyData))
+	}
+	return written, bw.Flush()
+}
+
+// UpdateStats copies the persistence statistics to the given stats.
+func (p *Preloader) UpdateStats(stats *nearcache.Stats) {
+	p.statsMu.Lock()
+	defer p.statsMu.Unlock()
+	stats.PersistenceCount = p.lastStats.PersistenceCount
+	stats.LastPersistenceKeyCount = p.lastStats.LastPersistenceKeyCount
+	stats.LastPersistenceWrittenBytes = p.lastStats.LastPersistenceWrittenBytes
+	stats.LastPersistenceTime = p.lastStats.LastPersistenceTime
+	stats.LastPersistenceDuration = p.lastStats.LastPersistenceDuration
+	stats.LastPersistenceFailure = p.lastStats.LastPersistenceFailure
+}
+
+func readPreloaderHeader(r io.Reader) error {
+	var buf [8]byte
+	if _, err := io.ReadFull(r, buf[:]); err != nil {
+		return fmt.Errorf("reading Near Cache key file header: %w", err)
+	}
+	if magic := binary.BigEndian.Uint32(buf[:4]); magic != preloaderMagic {
+		return fmt.Errorf("invalid Near Cache key file: unexpected magic bytes: %x", magic)
+	}
+	if version := int32(binary.BigEndian.Uint32(buf[4:])); version != preloaderFileVersion {
+		return fmt.Errorf("invalid Near Cache key file: unsupported version: %d", version)
+	}
+	return nil
+}
+
+func readPreloaderKey(r io.Reader) (serialization.Data, error) {
+	var buf [4]byte
+	if _, err := io.ReadFull(r, buf[:]); err != nil {
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		return nil, fmt.Errorf("reading Near Cache key size: %w", err)
+	}
+	size := binary.BigEndian.Uint32(buf[:])
+	if size > preloaderMaxKeySize {
+		return nil, fmt.Errorf("invalid Near Cache key file: key too large: %d", size)
+	}
+	keyData := make([]byte, size)
+	if _, err := io.ReadFull(r, keyData); err != nil {
+		return nil, fmt.Errorf("reading Near Cache key: %w", err)
+	}
+	return keyData, nil
+}
@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nearcache
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/nearcache"
+	pubserialization "github.com/hazelcast/hazelcast-go-client/serialization"
+)
+
+func TestPreloader_StoreLoad(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "keys")
+	p := newTestPreloader(t, "my/map", dir)
+	assert.Equal(t, filepath.Join(dir, "nearCache-my_map.store"), p.Filename())
+	keys := make([]interface{}, 250)
+	for i := range keys {
+		keys[i] = int64(i)
+	}
+	require.NoError(t, p.Store(keys))
+	var stats nearcache.Stats
+	p.UpdateStats(&stats)
+	assert.Equal(t, int64(1), stats.PersistenceCount)
+	assert.Equal(t, int64(250), stats.LastPersistenceKeyCount)
+	assert.Greater(t, stats.LastPersistenceWrittenBytes, int64(250*4))
+	assert.Equal(t, "", stats.LastPersistenceFailure)
+	assert.False(t, stats.LastPersistenceTime.IsZero())
+	var batches [][]interface{}
+	count, err := p.Load(context.Background(), func(ctx context.Context, keys []interface{}) error {
+		batches = append(batches, keys)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 250, count)
+	require.Len(t, batches, 3)
+	assert.Len(t, batches[2], 50)
+	var loaded []interface{}
+	for _, b := range batches {
+		loaded = append(loaded, b...)
+	}
+	sort.Slice(loaded, func(i, j int) bool {
+		return loaded[i].(int64) < loaded[j].(int64)
+	})
+	assert.Equal(t, keys, loaded)
+}
+
+func TestPreloader_LoadMissingFile(t *testing.T) {
+	p := newTestPreloader(t, "map", t.TempDir())
+	count, err := p.Load(context.Background(), func(ctx context.Context, keys []interface{}) error {
+		t.Fatal("should not be called")
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func TestPreloader_LoadCorruptFile(t *testing.T) {
+	dir := t.TempDir()
+	p := newTestPreloader(t, "map", dir)
+	require.NoError(t, os.WriteFile(p.Filename(), []byte("not a key file"), 0o644))
+	_, err := p.Load(context.Background(), func(ctx context.Context, keys []interface{}) error {
+		return nil
+	})
+	require.Error(t, err)
+}
+
+func TestPreloader_StoreFailure(t *testing.T) {
+	dir := t.TempDir()
+	// the directory cannot be created, since a file with the same name exists
+	blocker := filepath.Join(dir, "blocker")
+	require.NoError(t, os.WriteFile(blocker, nil, 0o644))
+	p := newTestPreloader(t, "map", blocker)
+	require.Error(t, p.Store([]interface{}{"k"}))
+	var stats nearcache.Stats
+	p.UpdateStats(&stats)
+	assert.Equal(t, int64(0), stats.PersistenceCount)
+	assert.NotEqual(t, "", stats.LastPersistenceFailure)
+}
+
+func newTestPreloader(t *testing.T, name, dir string) *Preloader {
+	ss, err := serialization.NewService(&pubserialization.Config{}, nil)
+	require.NoError(t, err)
+	return NewPreloader(name, nearcache.PreloaderConfig{Enabled: true, Directory: dir}, ss)
+}
@@ -289,6 +289,22 @@ func (rs *RecordStore) InvalidationRequests() int64 {
 	return atomic.LoadInt64(&rs.stats.InvalidationRequests)
 }
 
+// Keys returns the keys of the records which have values.
+// Serialized keys are returned as serialization.Data.
+func (rs *RecordStore) Keys() []interface{} {
+	rs.recordsMu.RLock()
+	defer rs.recordsMu.RUnlock()
+	keys := make([]interface{}, 0, len(rs.records))
+	for k, rec := range rs.records {
+		if rec.ReservationID() != RecordReadPermitted {
+			// the value is not loaded yet
+			continue
+		}
+		keys = append(keys, rs.unMakeMapKey(k))
+	}
+	return keys
+}
+
 func (rs *RecordStore) Size() int {
 	rs.recordsMu.RLock()
 	size := len(rs.records)
@@ -493,23 +493,37 @@ func (mc *MetaDataContainer) AddAndGetMissedSequenceCount(count int64) int64 {
 // An instance of this task is responsible for fetching of all Near Caches' remote metadata like last sequence numbers and partition UUIDs.
 // port of: com.hazelcast.internal.nearcache.impl.invalidation.InvalidationMetaDataFetcher
 // port of: com.hazelcast.client.map.impl.nearcache.invalidation.ClientMapInvalidationMetaDataFetcher
+// port of: com.hazelcast.client.cache.impl.nearcache.invalidation.ClientCacheInvalidationMetaDataFetcher
 type InvalidationMetaDataFetcher struct {
-	cs         *cluster.Service
-	is         *invocation.Service
-	invFactory *cluster.ConnectionInvocationFactory
-	lg         ilogger.LogAdaptor
+	cs             *cluster.Service
+	is             *invocation.Service
+	invFactory     *cluster.ConnectionInvocationFactory
+	lg             ilogger.LogAdaptor
+	encodeRequest  func(names []string, uuid types.UUID) *proto.ClientMessage
+	decodeResponse func(msg *proto.ClientMessage) (namePartitionSequenceList []proto.Pair, partitionUuidList []proto.Pair)
 }
 
+// NewInvalidationMetaDataFetcher creates a metadata fetcher for Map Near Caches.
 func NewInvalidationMetaDataFetcher(cs *cluster.Service, is *invocation.Service, invFactory *cluster.ConnectionInvocationFactory, lg ilogger.LogAdaptor) InvalidationMetaDataFetcher {
 	df := InvalidationMetaDataFetcher{
-		cs:         cs,
-		is:         is,
-		invFactory: invFactory,
-		lg:         lg,
+		cs:             cs,
+		is:             is,
+		invFactory:     invFactory,
+		lg:             lg,
+		encodeRequest:  codec.EncodeMapFetchNearCacheInvalidationMetadataRequest,
+		decodeResponse: codec.DecodeMapFetchNearCacheInvalidationMetadataResponse,
 	}
 	return df
 }
 
+// NewCacheInvalidationMetaDataFetcher creates a metadata fetcher for Cache Near Caches.
+func NewCacheInvalidationMetaDataFetcher(cs *cluster.Service, is *invocation.Service, invFactory *cluster.ConnectionInvocationFactory, lg ilogger.LogAdaptor) InvalidationMetaDataFetcher {
+	df := NewInvalidationMetaDataFetcher(cs, is, invFactory, lg)
+	df.encodeRequest = codec.EncodeCacheFetchNearCacheInvalidationMetadataRequest
+	df.decodeResponse = codec.DecodeCacheFetchNearCacheInvalidationMetadataResponse
+	return df
+}
+
 func (df InvalidationMetaDataFetcher) Init(ctx context.Context, handler *RepairingHandler) bool {
 	// port of: com.hazelcast.internal.nearcache.impl.invalidation.InvalidationMetaDataFetcher#init
 	handlers := map[string]*RepairingHandler{
@@ -564,7 +578,7 @@ func (df InvalidationMetaDataFetcher) fetchMembersMetadataFor(ctx context.Contex
 
 func (df InvalidationMetaDataFetcher) fetchMetaDataOf(ctx context.Context, mem pubcluster.MemberInfo, names []string) (*cluster.MemberBoundInvocation, error) {
 	// port of: com.hazelcast.client.map.impl.nearcache.invalidation.ClientMapInvalidationMetaDataFetcher#fetchMetadataOf
-	msg := codec.EncodeMapFetchNearCacheInvalidationMetadataRequest(names, mem.UUID)
+	msg := df.encodeRequest(names, mem.UUID)
 	inv := df.invFactory.NewMemberBoundInvocation(msg, &mem, time.Now())
 	if err := df.is.SendRequest(ctx, inv); err != nil {
 		return nil, err
@@ -580,7 +594,7 @@ func (df *InvalidationMetaDataFetcher) extractMemberMetadata(ctx context.Context
 	if err != nil {
 		return nil, nil, err
 	}
-	npsPairs, psPairs := codec.DecodeMapFetchNearCacheInvalidationMetadataResponse(res)
+	npsPairs, psPairs := df.decodeResponse(res)
 	return npsPairs, psPairs, nil
 }
 
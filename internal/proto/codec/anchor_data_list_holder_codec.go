@@ -0,0 +1,46 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+)
+
+// AnchorDataListHolder contains the anchors of a paging predicate in serialized form.
+// Each anchor is the last entry of a page.
+type AnchorDataListHolder struct {
+	AnchorPageList []int32
+	AnchorDataList []proto.Pair
+}
+
+func EncodeAnchorDataListHolder(clientMessage *proto.ClientMessage, anchorDataListHolder AnchorDataListHolder) {
+	clientMessage.AddFrame(proto.BeginFrame.Copy())
+	EncodeListInteger(clientMessage, anchorDataListHolder.AnchorPageList)
+	EncodeEntryListForDataAndData(clientMessage, anchorDataListHolder.AnchorDataList)
+	clientMessage.AddFrame(proto.EndFrame.Copy())
+}
+
+func DecodeAnchorDataListHolder(frameIterator *proto.ForwardFrameIterator) AnchorDataListHolder {
+	// begin frame
+	frameIterator.Next()
+	anchorPageList := DecodeListInteger(frameIterator)
+	anchorDataList := DecodeEntryListForDataAndData(frameIterator)
+	CodecUtil.FastForwardToEndFrame(frameIterator)
+	return AnchorDataListHolder{
+		AnchorPageList: anchorPageList,
+		AnchorDataList: anchorDataList,
+	}
+}
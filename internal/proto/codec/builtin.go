@@ -356,6 +356,27 @@ func DecodeEntryListIntegerLong(iterator *proto.ForwardFrameIterator) []proto.Pa
 	return result
 }
 
+func EncodeEntryListIntegerInteger(message *proto.ClientMessage, entries []proto.Pair) {
+	content := make([]byte, len(entries)*proto.EntryListIntegerIntegerSizeInBytes)
+	for i, entry := range entries {
+		FixSizedTypesCodec.EncodeInt(content, int32(i*proto.EntryListIntegerIntegerSizeInBytes), entry.Key.(int32))
+		FixSizedTypesCodec.EncodeInt(content, int32(i*proto.EntryListIntegerIntegerSizeInBytes+proto.IntSizeInBytes), entry.Value.(int32))
+	}
+	message.AddFrame(proto.NewFrame(content))
+}
+
+func DecodeEntryListIntegerInteger(iterator *proto.ForwardFrameIterator) []proto.Pair {
+	frame := iterator.Next()
+	entryCount := len(frame.Content) / proto.EntryListIntegerIntegerSizeInBytes
+	result := make([]proto.Pair, entryCount)
+	for i := 0; i < entryCount; i++ {
+		key := FixSizedTypesCodec.DecodeInt(frame.Content, int32(i*proto.EntryListIntegerIntegerSizeInBytes))
+		value := FixSizedTypesCodec.DecodeInt(frame.Content, int32(i*proto.EntryListIntegerIntegerSizeInBytes+proto.IntSizeInBytes))
+		result[i] = proto.NewPair(key, value)
+	}
+	return result
+}
+
 func EncodeBoolean(buffer []byte, offset int32, value bool) {
 	if value {
 		buffer[offset] = 1
@@ -614,6 +635,20 @@ func DecodeListMultiFrameForData(frameIterator *proto.ForwardFrameIterator) []is
 	return result
 }
 
+func DecodeListMultiFrameForDataContainsNullable(frameIterator *proto.ForwardFrameIterator) []iserialization.Data {
+	result := make([]iserialization.Data, 0)
+	frameIterator.Next()
+	for !CodecUtil.NextFrameIsDataStructureEndFrame(frameIterator) {
+		if CodecUtil.NextFrameIsNullFrame(frameIterator) {
+			result = append(result, nil)
+			continue
+		}
+		result = append(result, DecodeData(frameIterator))
+	}
+	frameIterator.Next()
+	return result
+}
+
 func DecodeListMultiFrameWithListInteger(frameIterator *proto.ForwardFrameIterator) [][]int32 {
 	var result [][]int32
 	DecodeListMultiFrame(frameIterator, func(fi *proto.ForwardFrameIterator) {
@@ -746,6 +781,25 @@ func DecodeLongArray(frameIterator *proto.ForwardFrameIterator) []int64 {
 	return result
 }
 
+func EncodeFloatArray(message *proto.ClientMessage, entries []float32) {
+	itemCount := len(entries)
+	frame := proto.NewFrame(make([]byte, itemCount*proto.FloatSizeInBytes))
+	for i := 0; i < itemCount; i++ {
+		iserialization.WriteFloat32(frame.Content, int32(i*proto.FloatSizeInBytes), entries[i], binary.LittleEndian)
+	}
+	message.AddFrame(frame)
+}
+
+func DecodeFloatArray(frameIterator *proto.ForwardFrameIterator) []float32 {
+	frame := frameIterator.Next()
+	itemCount := len(frame.Content) / proto.FloatSizeInBytes
+	result := make([]float32, itemCount)
+	for i := 0; i < itemCount; i++ {
+		result[i] = iserialization.ReadFloat32(frame.Content, int32(i*proto.FloatSizeInBytes), binary.LittleEndian)
+	}
+	return result
+}
+
 func EncodeMapForStringAndString(message *proto.ClientMessage, values map[string]string) {
 	message.AddFrame(proto.BeginFrame.Copy())
 	for key, value := range values {
@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// hex: 0x131D00
+	CacheAddNearCacheInvalidationListenerCodecRequestMessageType = int32(1252608)
+	// hex: 0x131D01
+	CacheAddNearCacheInvalidationListenerCodecResponseMessageType = int32(1252609)
+
+	// hex: 0x131D02
+	CacheAddNearCacheInvalidationListenerCodecCacheInvalidationEventMessageType = int32(1252610)
+
+	// hex: 0x131D03
+	CacheAddNearCacheInvalidationListenerCodecCacheBatchInvalidationEventMessageType = int32(1252611)
+
+	CacheAddNearCacheInvalidationListenerCodecRequestLocalOnlyOffset  = proto.PartitionIDOffset + proto.IntSizeInBytes
+	CacheAddNearCacheInvalidationListenerCodecRequestInitialFrameSize = CacheAddNearCacheInvalidationListenerCodecRequestLocalOnlyOffset + proto.BooleanSizeInBytes
+
+	CacheAddNearCacheInvalidationListenerResponseResponseOffset                    = proto.ResponseBackupAcksOffset + proto.ByteSizeInBytes
+	CacheAddNearCacheInvalidationListenerEventCacheInvalidationSourceUuidOffset    = proto.PartitionIDOffset + proto.IntSizeInBytes
+	CacheAddNearCacheInvalidationListenerEventCacheInvalidationPartitionUuidOffset = CacheAddNearCacheInvalidationListenerEventCacheInvalidationSourceUuidOffset + proto.UuidSizeInBytes
+	CacheAddNearCacheInvalidationListenerEventCacheInvalidationSequenceOffset      = CacheAddNearCacheInvalidationListenerEventCacheInvalidationPartitionUuidOffset + proto.UuidSizeInBytes
+)
+
+// Adds listener to cache. This listener will be used to listen near cache invalidation events.
+
+func EncodeCacheAddNearCacheInvalidationListenerRequest(name string, localOnly bool) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, CacheAddNearCacheInvalidationListenerCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeBoolean(initialFrame.Content, CacheAddNearCacheInvalidationListenerCodecRequestLocalOnlyOffset, localOnly)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(CacheAddNearCacheInvalidationListenerCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+
+	return clientMessage
+}
+
+func DecodeCacheAddNearCacheInvalidationListenerResponse(clientMessage *proto.ClientMessage) types.UUID {
+	frameIterator := clientMessage.FrameIterator()
+	initialFrame := frameIterator.Next()
+
+	return FixSizedTypesCodec.DecodeUUID(initialFrame.Content, CacheAddNearCacheInvalidationListenerResponseResponseOffset)
+}
+
+func HandleCacheAddNearCacheInvalidationListener(clientMessage *proto.ClientMessage, handleCacheInvalidationEvent func(name string, key iserialization.Data, sourceUuid types.UUID, partitionUuid types.UUID, sequence int64), handleCacheBatchInvalidationEvent func(name string, keys []iserialization.Data, sourceUuids []types.UUID, partitionUuids []types.UUID, sequences []int64)) {
+	messageType := clientMessage.Type()
+	frameIterator := clientMessage.FrameIterator()
+	if messageType == CacheAddNearCacheInvalidationListenerCodecCacheInvalidationEventMessageType {
+		initialFrame := frameIterator.Next()
+		sourceUuid := FixSizedTypesCodec.DecodeUUID(initialFrame.Content, CacheAddNearCacheInvalidationListenerEventCacheInvalidationSourceUuidOffset)
+		partitionUuid := FixSizedTypesCodec.DecodeUUID(initialFrame.Content, CacheAddNearCacheInvalidationListenerEventCacheInvalidationPartitionUuidOffset)
+		sequence := FixSizedTypesCodec.DecodeLong(initialFrame.Content, CacheAddNearCacheInvalidationListenerEventCacheInvalidationSequenceOffset)
+		name := DecodeString(frameIterator)
+		key := CodecUtil.DecodeNullableForData(frameIterator)
+		handleCacheInvalidationEvent(name, key, sourceUuid, partitionUuid, sequence)
+		return
+	}
+	if messageType == CacheAddNearCacheInvalidationListenerCodecCacheBatchInvalidationEventMessageType {
+		// empty initial frame
+		frameIterator.Next()
+		name := DecodeString(frameIterator)
+		keys := DecodeListMultiFrameForData(frameIterator)
+		sourceUuids := DecodeListUUID(frameIterator)
+		partitionUuids := DecodeListUUID(frameIterator)
+		sequences := DecodeListLong(frameIterator)
+		handleCacheBatchInvalidationEvent(name, keys, sourceUuids, partitionUuids, sequences)
+		return
+	}
+}
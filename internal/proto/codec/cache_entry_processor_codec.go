@@ -0,0 +1,60 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+const (
+	// hex: 0x130800
+	CacheEntryProcessorCodecRequestMessageType = int32(1247232)
+	// hex: 0x130801
+	CacheEntryProcessorCodecResponseMessageType = int32(1247233)
+
+	CacheEntryProcessorCodecRequestCompletionIdOffset = proto.PartitionIDOffset + proto.IntSizeInBytes
+	CacheEntryProcessorCodecRequestInitialFrameSize   = CacheEntryProcessorCodecRequestCompletionIdOffset + proto.IntSizeInBytes
+)
+
+// Invokes the entry processor against the entry with the given key.
+
+func EncodeCacheEntryProcessorRequest(name string, key iserialization.Data, entryProcessor iserialization.Data, arguments []iserialization.Data, completionId int32) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, CacheEntryProcessorCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeInt(initialFrame.Content, CacheEntryProcessorCodecRequestCompletionIdOffset, completionId)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(CacheEntryProcessorCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+	EncodeData(clientMessage, key)
+	EncodeData(clientMessage, entryProcessor)
+	EncodeListMultiFrameForData(clientMessage, arguments)
+
+	return clientMessage
+}
+
+func DecodeCacheEntryProcessorResponse(clientMessage *proto.ClientMessage) iserialization.Data {
+	frameIterator := clientMessage.FrameIterator()
+	// empty initial frame
+	frameIterator.Next()
+
+	return CodecUtil.DecodeNullableForData(frameIterator)
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+const (
+	CacheEventDataCodecCacheEventTypeFieldOffset         = 0
+	CacheEventDataCodecOldValueAvailableFieldOffset      = CacheEventDataCodecCacheEventTypeFieldOffset + proto.IntSizeInBytes
+	CacheEventDataCodecOldValueAvailableInitialFrameSize = CacheEventDataCodecOldValueAvailableFieldOffset + proto.BooleanSizeInBytes
+)
+
+// CacheEventData is a single entry event of a cache event message.
+type CacheEventData struct {
+	Name              string
+	Key               iserialization.Data
+	Value             iserialization.Data
+	OldValue          iserialization.Data
+	CacheEventType    int32
+	OldValueAvailable bool
+}
+
+func EncodeCacheEventData(clientMessage *proto.ClientMessage, cacheEventData CacheEventData) {
+	clientMessage.AddFrame(proto.BeginFrame.Copy())
+	initialFrame := proto.NewFrame(make([]byte, CacheEventDataCodecOldValueAvailableInitialFrameSize))
+	FixSizedTypesCodec.EncodeInt(initialFrame.Content, CacheEventDataCodecCacheEventTypeFieldOffset, cacheEventData.CacheEventType)
+	FixSizedTypesCodec.EncodeBoolean(initialFrame.Content, CacheEventDataCodecOldValueAvailableFieldOffset, cacheEventData.OldValueAvailable)
+	clientMessage.AddFrame(initialFrame)
+
+	EncodeString(clientMessage, cacheEventData.Name)
+	CodecUtil.EncodeNullableForData(clientMessage, cacheEventData.Key)
+	CodecUtil.EncodeNullableForData(clientMessage, cacheEventData.Value)
+	CodecUtil.EncodeNullableForData(clientMessage, cacheEventData.OldValue)
+
+	clientMessage.AddFrame(proto.EndFrame.Copy())
+}
+
+func DecodeCacheEventData(frameIterator *proto.ForwardFrameIterator) CacheEventData {
+	// begin frame
+	frameIterator.Next()
+	initialFrame := frameIterator.Next()
+	cacheEventType := FixSizedTypesCodec.DecodeInt(initialFrame.Content, CacheEventDataCodecCacheEventTypeFieldOffset)
+	oldValueAvailable := FixSizedTypesCodec.DecodeBoolean(initialFrame.Content, CacheEventDataCodecOldValueAvailableFieldOffset)
+
+	name := DecodeString(frameIterator)
+	key := CodecUtil.DecodeNullableForData(frameIterator)
+	value := CodecUtil.DecodeNullableForData(frameIterator)
+	oldValue := CodecUtil.DecodeNullableForData(frameIterator)
+	CodecUtil.FastForwardToEndFrame(frameIterator)
+	return CacheEventData{
+		Name:              name,
+		Key:               key,
+		Value:             value,
+		OldValue:          oldValue,
+		CacheEventType:    cacheEventType,
+		OldValueAvailable: oldValueAvailable,
+	}
+}
+
+func EncodeListMultiFrameForCacheEventData(clientMessage *proto.ClientMessage, values []CacheEventData) {
+	clientMessage.AddFrame(proto.BeginFrame.Copy())
+	for _, v := range values {
+		EncodeCacheEventData(clientMessage, v)
+	}
+	clientMessage.AddFrame(proto.EndFrame.Copy())
+}
+
+func DecodeListMultiFrameForCacheEventData(frameIterator *proto.ForwardFrameIterator) []CacheEventData {
+	var result []CacheEventData
+	frameIterator.Next()
+	for !CodecUtil.NextFrameIsDataStructureEndFrame(frameIterator) {
+		result = append(result, DecodeCacheEventData(frameIterator))
+	}
+	frameIterator.Next()
+	return result
+}
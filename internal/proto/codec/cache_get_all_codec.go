@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+const (
+	// hex: 0x130900
+	CacheGetAllCodecRequestMessageType = int32(1247488)
+	// hex: 0x130901
+	CacheGetAllCodecResponseMessageType = int32(1247489)
+
+	CacheGetAllCodecRequestInitialFrameSize = proto.PartitionIDOffset + proto.IntSizeInBytes
+)
+
+// Gets a collection of entries from the cache with custom expiry policy, returning them as Map of the values
+// associated with the set of keys requested.
+
+func EncodeCacheGetAllRequest(name string, keys []iserialization.Data, expiryPolicy iserialization.Data) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, CacheGetAllCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(CacheGetAllCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+	EncodeListMultiFrameForData(clientMessage, keys)
+	CodecUtil.EncodeNullableForData(clientMessage, expiryPolicy)
+
+	return clientMessage
+}
+
+func DecodeCacheGetAllResponse(clientMessage *proto.ClientMessage) []proto.Pair {
+	frameIterator := clientMessage.FrameIterator()
+	// empty initial frame
+	frameIterator.Next()
+
+	return DecodeEntryListForDataAndData(frameIterator)
+}
@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+const (
+	// hex: 0x130A00
+	CacheGetAndRemoveCodecRequestMessageType = int32(1247744)
+	// hex: 0x130A01
+	CacheGetAndRemoveCodecResponseMessageType = int32(1247745)
+
+	CacheGetAndRemoveCodecRequestCompletionIdOffset = proto.PartitionIDOffset + proto.IntSizeInBytes
+	CacheGetAndRemoveCodecRequestInitialFrameSize   = CacheGetAndRemoveCodecRequestCompletionIdOffset + proto.IntSizeInBytes
+)
+
+// Atomically removes the entry for a key only if currently mapped to some value.
+
+func EncodeCacheGetAndRemoveRequest(name string, key iserialization.Data, completionId int32) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, CacheGetAndRemoveCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeInt(initialFrame.Content, CacheGetAndRemoveCodecRequestCompletionIdOffset, completionId)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(CacheGetAndRemoveCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+	EncodeData(clientMessage, key)
+
+	return clientMessage
+}
+
+func DecodeCacheGetAndRemoveResponse(clientMessage *proto.ClientMessage) iserialization.Data {
+	frameIterator := clientMessage.FrameIterator()
+	// empty initial frame
+	frameIterator.Next()
+
+	return CodecUtil.DecodeNullableForData(frameIterator)
+}
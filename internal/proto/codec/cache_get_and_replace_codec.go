@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+const (
+	// hex: 0x130B00
+	CacheGetAndReplaceCodecRequestMessageType = int32(1248000)
+	// hex: 0x130B01
+	CacheGetAndReplaceCodecResponseMessageType = int32(1248001)
+
+	CacheGetAndReplaceCodecRequestCompletionIdOffset = proto.PartitionIDOffset + proto.IntSizeInBytes
+	CacheGetAndReplaceCodecRequestInitialFrameSize   = CacheGetAndReplaceCodecRequestCompletionIdOffset + proto.IntSizeInBytes
+)
+
+// Atomically replaces the assigned value of the given key by the specified value using a custom
+// ExpiryPolicy and returns the previously assigned value.
+
+func EncodeCacheGetAndReplaceRequest(name string, key iserialization.Data, value iserialization.Data, expiryPolicy iserialization.Data, completionId int32) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, CacheGetAndReplaceCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeInt(initialFrame.Content, CacheGetAndReplaceCodecRequestCompletionIdOffset, completionId)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(CacheGetAndReplaceCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+	EncodeData(clientMessage, key)
+	EncodeData(clientMessage, value)
+	CodecUtil.EncodeNullableForData(clientMessage, expiryPolicy)
+
+	return clientMessage
+}
+
+func DecodeCacheGetAndReplaceResponse(clientMessage *proto.ClientMessage) iserialization.Data {
+	frameIterator := clientMessage.FrameIterator()
+	// empty initial frame
+	frameIterator.Next()
+
+	return CodecUtil.DecodeNullableForData(frameIterator)
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+const (
+	// hex: 0x160300
+	ContinuousQueryPublisherCreateCodecRequestMessageType = int32(1442560)
+	// hex: 0x160301
+	ContinuousQueryPublisherCreateCodecResponseMessageType = int32(1442561)
+
+	ContinuousQueryPublisherCreateCodecRequestBatchSizeOffset    = proto.PartitionIDOffset + proto.IntSizeInBytes
+	ContinuousQueryPublisherCreateCodecRequestBufferSizeOffset   = ContinuousQueryPublisherCreateCodecRequestBatchSizeOffset + proto.IntSizeInBytes
+	ContinuousQueryPublisherCreateCodecRequestDelaySecondsOffset = ContinuousQueryPublisherCreateCodecRequestBufferSizeOffset + proto.IntSizeInBytes
+	ContinuousQueryPublisherCreateCodecRequestPopulateOffset     = ContinuousQueryPublisherCreateCodecRequestDelaySecondsOffset + proto.LongSizeInBytes
+	ContinuousQueryPublisherCreateCodecRequestCoalesceOffset     = ContinuousQueryPublisherCreateCodecRequestPopulateOffset + proto.BooleanSizeInBytes
+	ContinuousQueryPublisherCreateCodecRequestInitialFrameSize   = ContinuousQueryPublisherCreateCodecRequestCoalesceOffset + proto.BooleanSizeInBytes
+)
+
+// Creates the publisher of a query cache which does not include the values, and returns the initial keys if populate is set.
+
+func EncodeContinuousQueryPublisherCreateRequest(mapName string, cacheName string, predicate iserialization.Data, batchSize int32, bufferSize int32, delaySeconds int64, populate bool, coalesce bool) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(true)
+
+	initialFrame := proto.NewFrameWith(make([]byte, ContinuousQueryPublisherCreateCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeInt(initialFrame.Content, ContinuousQueryPublisherCreateCodecRequestBatchSizeOffset, batchSize)
+	FixSizedTypesCodec.EncodeInt(initialFrame.Content, ContinuousQueryPublisherCreateCodecRequestBufferSizeOffset, bufferSize)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, ContinuousQueryPublisherCreateCodecRequestDelaySecondsOffset, delaySeconds)
+	FixSizedTypesCodec.EncodeBoolean(initialFrame.Content, ContinuousQueryPublisherCreateCodecRequestPopulateOffset, populate)
+	FixSizedTypesCodec.EncodeBoolean(initialFrame.Content, ContinuousQueryPublisherCreateCodecRequestCoalesceOffset, coalesce)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(ContinuousQueryPublisherCreateCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, mapName)
+	EncodeString(clientMessage, cacheName)
+	EncodeData(clientMessage, predicate)
+
+	return clientMessage
+}
+
+func DecodeContinuousQueryPublisherCreateResponse(clientMessage *proto.ClientMessage) []iserialization.Data {
+	frameIterator := clientMessage.FrameIterator()
+	// empty initial frame
+	frameIterator.Next()
+
+	return DecodeListMultiFrameForData(frameIterator)
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+const (
+	// hex: 0x160200
+	ContinuousQueryPublisherCreateWithValueCodecRequestMessageType = int32(1442304)
+	// hex: 0x160201
+	ContinuousQueryPublisherCreateWithValueCodecResponseMessageType = int32(1442305)
+
+	ContinuousQueryPublisherCreateWithValueCodecRequestBatchSizeOffset    = proto.PartitionIDOffset + proto.IntSizeInBytes
+	ContinuousQueryPublisherCreateWithValueCodecRequestBufferSizeOffset   = ContinuousQueryPublisherCreateWithValueCodecRequestBatchSizeOffset + proto.IntSizeInBytes
+	ContinuousQueryPublisherCreateWithValueCodecRequestDelaySecondsOffset = ContinuousQueryPublisherCreateWithValueCodecRequestBufferSizeOffset + proto.IntSizeInBytes
+	ContinuousQueryPublisherCreateWithValueCodecRequestPopulateOffset     = ContinuousQueryPublisherCreateWithValueCodecRequestDelaySecondsOffset + proto.LongSizeInBytes
+	ContinuousQueryPublisherCreateWithValueCodecRequestCoalesceOffset     = ContinuousQueryPublisherCreateWithValueCodecRequestPopulateOffset + proto.BooleanSizeInBytes
+	ContinuousQueryPublisherCreateWithValueCodecRequestInitialFrameSize   = ContinuousQueryPublisherCreateWithValueCodecRequestCoalesceOffset + proto.BooleanSizeInBytes
+)
+
+// Creates the publisher of a query cache which includes the values, and returns the initial entries if populate is set.
+
+func EncodeContinuousQueryPublisherCreateWithValueRequest(mapName string, cacheName string, predicate iserialization.Data, batchSize int32, bufferSize int32, delaySeconds int64, populate bool, coalesce bool) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(true)
+
+	initialFrame := proto.NewFrameWith(make([]byte, ContinuousQueryPublisherCreateWithValueCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeInt(initialFrame.Content, ContinuousQueryPublisherCreateWithValueCodecRequestBatchSizeOffset, batchSize)
+	FixSizedTypesCodec.EncodeInt(initialFrame.Content, ContinuousQueryPublisherCreateWithValueCodecRequestBufferSizeOffset, bufferSize)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, ContinuousQueryPublisherCreateWithValueCodecRequestDelaySecondsOffset, delaySeconds)
+	FixSizedTypesCodec.EncodeBoolean(initialFrame.Content, ContinuousQueryPublisherCreateWithValueCodecRequestPopulateOffset, populate)
+	FixSizedTypesCodec.EncodeBoolean(initialFrame.Content, ContinuousQueryPublisherCreateWithValueCodecRequestCoalesceOffset, coalesce)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(ContinuousQueryPublisherCreateWithValueCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, mapName)
+	EncodeString(clientMessage, cacheName)
+	EncodeData(clientMessage, predicate)
+
+	return clientMessage
+}
+
+func DecodeContinuousQueryPublisherCreateWithValueResponse(clientMessage *proto.ClientMessage) []proto.Pair {
+	frameIterator := clientMessage.FrameIterator()
+	// empty initial frame
+	frameIterator.Next()
+
+	return DecodeEntryListForDataAndData(frameIterator)
+}
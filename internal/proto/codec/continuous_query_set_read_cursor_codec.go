@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+)
+
+const (
+	// hex: 0x160500
+	ContinuousQuerySetReadCursorCodecRequestMessageType = int32(1443072)
+	// hex: 0x160501
+	ContinuousQuerySetReadCursorCodecResponseMessageType = int32(1443073)
+
+	ContinuousQuerySetReadCursorCodecRequestSequenceOffset   = proto.PartitionIDOffset + proto.IntSizeInBytes
+	ContinuousQuerySetReadCursorCodecRequestInitialFrameSize = ContinuousQuerySetReadCursorCodecRequestSequenceOffset + proto.LongSizeInBytes
+
+	ContinuousQuerySetReadCursorResponseResponseOffset = proto.ResponseBackupAcksOffset + proto.ByteSizeInBytes
+)
+
+// Sets the read cursor of the publisher to the given sequence, so the events starting from that sequence are sent again.
+
+func EncodeContinuousQuerySetReadCursorRequest(mapName string, cacheName string, sequence int64) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(true)
+
+	initialFrame := proto.NewFrameWith(make([]byte, ContinuousQuerySetReadCursorCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, ContinuousQuerySetReadCursorCodecRequestSequenceOffset, sequence)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(ContinuousQuerySetReadCursorCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, mapName)
+	EncodeString(clientMessage, cacheName)
+
+	return clientMessage
+}
+
+func DecodeContinuousQuerySetReadCursorResponse(clientMessage *proto.ClientMessage) bool {
+	frameIterator := clientMessage.FrameIterator()
+	initialFrame := frameIterator.Next()
+
+	return FixSizedTypesCodec.DecodeBoolean(initialFrame.Content, ContinuousQuerySetReadCursorResponseResponseOffset)
+}
@@ -0,0 +1,50 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/vector"
+)
+
+const (
+	// hex: 0x1B1400
+	DynamicConfigAddVectorCollectionConfigCodecRequestMessageType = int32(1774592)
+	// hex: 0x1B1401
+	DynamicConfigAddVectorCollectionConfigCodecResponseMessageType = int32(1774593)
+
+	DynamicConfigAddVectorCollectionConfigCodecRequestInitialFrameSize = proto.PartitionIDOffset + proto.IntSizeInBytes
+)
+
+// Adds a new vector collection configuration to a running cluster.
+// If a vector collection configuration with the given name already exists, then
+// the new configuration is ignored and the existing one is preserved.
+
+func EncodeDynamicConfigAddVectorCollectionConfigRequest(name string, indexConfigs []vector.IndexConfig) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, DynamicConfigAddVectorCollectionConfigCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(DynamicConfigAddVectorCollectionConfigCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+	EncodeListMultiFrameForVectorIndexConfig(clientMessage, indexConfigs)
+
+	return clientMessage
+}
@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// hex: 0x080300
+	ExecutorServiceCancelOnPartitionCodecRequestMessageType = int32(525056)
+	// hex: 0x080301
+	ExecutorServiceCancelOnPartitionCodecResponseMessageType = int32(525057)
+
+	ExecutorServiceCancelOnPartitionCodecRequestUuidOffset       = proto.PartitionIDOffset + proto.IntSizeInBytes
+	ExecutorServiceCancelOnPartitionCodecRequestInterruptOffset  = ExecutorServiceCancelOnPartitionCodecRequestUuidOffset + proto.UuidSizeInBytes
+	ExecutorServiceCancelOnPartitionCodecRequestInitialFrameSize = ExecutorServiceCancelOnPartitionCodecRequestInterruptOffset + proto.BooleanSizeInBytes
+
+	ExecutorServiceCancelOnPartitionResponseResponseOffset = proto.ResponseBackupAcksOffset + proto.ByteSizeInBytes
+)
+
+// Cancels the task running on the member that owns the partition with the given id.
+
+func EncodeExecutorServiceCancelOnPartitionRequest(uuid types.UUID, interrupt bool) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, ExecutorServiceCancelOnPartitionCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeUUID(initialFrame.Content, ExecutorServiceCancelOnPartitionCodecRequestUuidOffset, uuid)
+	FixSizedTypesCodec.EncodeBoolean(initialFrame.Content, ExecutorServiceCancelOnPartitionCodecRequestInterruptOffset, interrupt)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(ExecutorServiceCancelOnPartitionCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	return clientMessage
+}
+
+func DecodeExecutorServiceCancelOnPartitionResponse(clientMessage *proto.ClientMessage) bool {
+	frameIterator := clientMessage.FrameIterator()
+	initialFrame := frameIterator.Next()
+
+	return FixSizedTypesCodec.DecodeBoolean(initialFrame.Content, ExecutorServiceCancelOnPartitionResponseResponseOffset)
+}
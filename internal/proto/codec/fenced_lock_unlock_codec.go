@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	cptypes "github.com/hazelcast/hazelcast-go-client/internal/cp/types"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	FencedLockUnlockCodecRequestMessageType  = int32(0x070300)
+	FencedLockUnlockCodecResponseMessageType = int32(0x070301)
+
+	FencedLockUnlockCodecRequestSessionIdOffset     = proto.PartitionIDOffset + proto.IntSizeInBytes
+	FencedLockUnlockCodecRequestThreadIdOffset      = FencedLockUnlockCodecRequestSessionIdOffset + proto.LongSizeInBytes
+	FencedLockUnlockCodecRequestInvocationUidOffset = FencedLockUnlockCodecRequestThreadIdOffset + proto.LongSizeInBytes
+	FencedLockUnlockCodecRequestInitialFrameSize    = FencedLockUnlockCodecRequestInvocationUidOffset + proto.UuidSizeInBytes
+
+	FencedLockUnlockResponseResponseOffset = proto.ResponseBackupAcksOffset + proto.ByteSizeInBytes
+)
+
+// Unlocks the given FencedLock on the given CP group. If the lock is
+// not acquired, the call fails with IllegalMonitorStateException.
+// If the session is closed while holding the lock, the call fails with
+// LockOwnershipLostException. Returns true if the lock is still held by
+// the caller after a successful unlock() call, false otherwise.
+
+func EncodeFencedLockUnlockRequest(groupId cptypes.RaftGroupID, name string, sessionId int64, threadId int64, invocationUid types.UUID) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, FencedLockUnlockCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, FencedLockUnlockCodecRequestSessionIdOffset, sessionId)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, FencedLockUnlockCodecRequestThreadIdOffset, threadId)
+	FixSizedTypesCodec.EncodeUUID(initialFrame.Content, FencedLockUnlockCodecRequestInvocationUidOffset, invocationUid)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(FencedLockUnlockCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeRaftGroupId(clientMessage, groupId)
+	EncodeString(clientMessage, name)
+
+	return clientMessage
+}
+
+func DecodeFencedLockUnlockResponse(clientMessage *proto.ClientMessage) bool {
+	frameIterator := clientMessage.FrameIterator()
+	initialFrame := frameIterator.Next()
+
+	return FixSizedTypesCodec.DecodeBoolean(initialFrame.Content, FencedLockUnlockResponseResponseOffset)
+}
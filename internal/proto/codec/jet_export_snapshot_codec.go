@@ -0,0 +1,50 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+)
+
+const (
+	// hex: 0xFE0A00
+	JetExportSnapshotCodecRequestMessageType = int32(16648704)
+	// hex: 0xFE0A01
+	JetExportSnapshotCodecResponseMessageType = int32(16648705)
+
+	JetExportSnapshotCodecRequestJobIdOffset      = proto.PartitionIDOffset + proto.IntSizeInBytes
+	JetExportSnapshotCodecRequestCancelJobOffset  = JetExportSnapshotCodecRequestJobIdOffset + proto.LongSizeInBytes
+	JetExportSnapshotCodecRequestInitialFrameSize = JetExportSnapshotCodecRequestCancelJobOffset + proto.BooleanSizeInBytes
+)
+
+// Exports a state snapshot of the job with the given name, and optionally cancels the job.
+
+func EncodeJetExportSnapshotRequest(jobId int64, name string, cancelJob bool) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, JetExportSnapshotCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, JetExportSnapshotCodecRequestJobIdOffset, jobId)
+	FixSizedTypesCodec.EncodeBoolean(initialFrame.Content, JetExportSnapshotCodecRequestCancelJobOffset, cancelJob)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(JetExportSnapshotCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+
+	return clientMessage
+}
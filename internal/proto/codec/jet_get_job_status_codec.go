@@ -0,0 +1,55 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+)
+
+const (
+	// hex: 0xFE0300
+	JetGetJobStatusCodecRequestMessageType = int32(16646912)
+	// hex: 0xFE0301
+	JetGetJobStatusCodecResponseMessageType = int32(16646913)
+
+	JetGetJobStatusCodecRequestJobIdOffset      = proto.PartitionIDOffset + proto.IntSizeInBytes
+	JetGetJobStatusCodecRequestInitialFrameSize = JetGetJobStatusCodecRequestJobIdOffset + proto.LongSizeInBytes
+
+	JetGetJobStatusResponseResponseOffset = proto.ResponseBackupAcksOffset + proto.ByteSizeInBytes
+)
+
+// Returns the status of the job.
+
+func EncodeJetGetJobStatusRequest(jobId int64) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(true)
+
+	initialFrame := proto.NewFrameWith(make([]byte, JetGetJobStatusCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, JetGetJobStatusCodecRequestJobIdOffset, jobId)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(JetGetJobStatusCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	return clientMessage
+}
+
+func DecodeJetGetJobStatusResponse(clientMessage *proto.ClientMessage) int32 {
+	frameIterator := clientMessage.FrameIterator()
+	initialFrame := frameIterator.Next()
+
+	return FixSizedTypesCodec.DecodeInt(initialFrame.Content, JetGetJobStatusResponseResponseOffset)
+}
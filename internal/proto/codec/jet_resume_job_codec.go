@@ -0,0 +1,46 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+)
+
+const (
+	// hex: 0xFE0900
+	JetResumeJobCodecRequestMessageType = int32(16648448)
+	// hex: 0xFE0901
+	JetResumeJobCodecResponseMessageType = int32(16648449)
+
+	JetResumeJobCodecRequestJobIdOffset      = proto.PartitionIDOffset + proto.IntSizeInBytes
+	JetResumeJobCodecRequestInitialFrameSize = JetResumeJobCodecRequestJobIdOffset + proto.LongSizeInBytes
+)
+
+// Resumes the suspended job.
+
+func EncodeJetResumeJobRequest(jobId int64) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, JetResumeJobCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, JetResumeJobCodecRequestJobIdOffset, jobId)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(JetResumeJobCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	return clientMessage
+}
@@ -0,0 +1,51 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// hex: 0xFE0200
+	JetTerminateJobCodecRequestMessageType = int32(16646656)
+	// hex: 0xFE0201
+	JetTerminateJobCodecResponseMessageType = int32(16646657)
+
+	JetTerminateJobCodecRequestJobIdOffset               = proto.PartitionIDOffset + proto.IntSizeInBytes
+	JetTerminateJobCodecRequestTerminateModeOffset       = JetTerminateJobCodecRequestJobIdOffset + proto.LongSizeInBytes
+	JetTerminateJobCodecRequestLightJobCoordinatorOffset = JetTerminateJobCodecRequestTerminateModeOffset + proto.IntSizeInBytes
+	JetTerminateJobCodecRequestInitialFrameSize          = JetTerminateJobCodecRequestLightJobCoordinatorOffset + proto.UuidSizeInBytes
+)
+
+// Terminates the job with the given terminate mode.
+
+func EncodeJetTerminateJobRequest(jobId int64, terminateMode int32, lightJobCoordinator types.UUID) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, JetTerminateJobCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, JetTerminateJobCodecRequestJobIdOffset, jobId)
+	FixSizedTypesCodec.EncodeInt(initialFrame.Content, JetTerminateJobCodecRequestTerminateModeOffset, terminateMode)
+	FixSizedTypesCodec.EncodeUUID(initialFrame.Content, JetTerminateJobCodecRequestLightJobCoordinatorOffset, lightJobCoordinator)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(JetTerminateJobCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	return clientMessage
+}
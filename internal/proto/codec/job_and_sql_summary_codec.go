@@ -0,0 +1,165 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+)
+
+const (
+	JobAndSqlSummaryCodecLightJobFieldOffset           = 0
+	JobAndSqlSummaryCodecJobIdFieldOffset              = JobAndSqlSummaryCodecLightJobFieldOffset + proto.BooleanSizeInBytes
+	JobAndSqlSummaryCodecExecutionIdFieldOffset        = JobAndSqlSummaryCodecJobIdFieldOffset + proto.LongSizeInBytes
+	JobAndSqlSummaryCodecStatusFieldOffset             = JobAndSqlSummaryCodecExecutionIdFieldOffset + proto.LongSizeInBytes
+	JobAndSqlSummaryCodecSubmissionTimeFieldOffset     = JobAndSqlSummaryCodecStatusFieldOffset + proto.IntSizeInBytes
+	JobAndSqlSummaryCodecCompletionTimeFieldOffset     = JobAndSqlSummaryCodecSubmissionTimeFieldOffset + proto.LongSizeInBytes
+	JobAndSqlSummaryCodecUserCancelledFieldOffset      = JobAndSqlSummaryCodecCompletionTimeFieldOffset + proto.LongSizeInBytes
+	JobAndSqlSummaryCodecUserCancelledInitialFrameSize = JobAndSqlSummaryCodecUserCancelledFieldOffset + proto.BooleanSizeInBytes
+	SqlSummaryCodecUnboundedFieldOffset                = 0
+	SqlSummaryCodecUnboundedInitialFrameSize           = SqlSummaryCodecUnboundedFieldOffset + proto.BooleanSizeInBytes
+)
+
+// SqlSummary is the summary of the SQL query which created a job.
+type SqlSummary struct {
+	Query     string
+	Unbounded bool
+}
+
+// JobAndSqlSummary is the summary of a Jet job.
+type JobAndSqlSummary struct {
+	SqlSummary      *SqlSummary
+	NameOrId        string
+	FailureText     string
+	SuspensionCause string
+	JobId           int64
+	ExecutionId     int64
+	SubmissionTime  int64
+	CompletionTime  int64
+	Status          int32
+	LightJob        bool
+	UserCancelled   bool
+}
+
+func EncodeSqlSummary(clientMessage *proto.ClientMessage, summary SqlSummary) {
+	clientMessage.AddFrame(proto.BeginFrame.Copy())
+	initialFrame := proto.NewFrame(make([]byte, SqlSummaryCodecUnboundedInitialFrameSize))
+	FixSizedTypesCodec.EncodeBoolean(initialFrame.Content, SqlSummaryCodecUnboundedFieldOffset, summary.Unbounded)
+	clientMessage.AddFrame(initialFrame)
+
+	EncodeString(clientMessage, summary.Query)
+
+	clientMessage.AddFrame(proto.EndFrame.Copy())
+}
+
+func DecodeSqlSummary(frameIterator *proto.ForwardFrameIterator) SqlSummary {
+	// begin frame
+	frameIterator.Next()
+	initialFrame := frameIterator.Next()
+	unbounded := FixSizedTypesCodec.DecodeBoolean(initialFrame.Content, SqlSummaryCodecUnboundedFieldOffset)
+
+	query := DecodeString(frameIterator)
+	CodecUtil.FastForwardToEndFrame(frameIterator)
+	return SqlSummary{
+		Query:     query,
+		Unbounded: unbounded,
+	}
+}
+
+func EncodeJobAndSqlSummary(clientMessage *proto.ClientMessage, summary JobAndSqlSummary) {
+	clientMessage.AddFrame(proto.BeginFrame.Copy())
+	initialFrame := proto.NewFrame(make([]byte, JobAndSqlSummaryCodecUserCancelledInitialFrameSize))
+	FixSizedTypesCodec.EncodeBoolean(initialFrame.Content, JobAndSqlSummaryCodecLightJobFieldOffset, summary.LightJob)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, JobAndSqlSummaryCodecJobIdFieldOffset, summary.JobId)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, JobAndSqlSummaryCodecExecutionIdFieldOffset, summary.ExecutionId)
+	FixSizedTypesCodec.EncodeInt(initialFrame.Content, JobAndSqlSummaryCodecStatusFieldOffset, summary.Status)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, JobAndSqlSummaryCodecSubmissionTimeFieldOffset, summary.SubmissionTime)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, JobAndSqlSummaryCodecCompletionTimeFieldOffset, summary.CompletionTime)
+	FixSizedTypesCodec.EncodeBoolean(initialFrame.Content, JobAndSqlSummaryCodecUserCancelledFieldOffset, summary.UserCancelled)
+	clientMessage.AddFrame(initialFrame)
+
+	EncodeString(clientMessage, summary.NameOrId)
+	CodecUtil.EncodeNullableForString(clientMessage, summary.FailureText)
+	if summary.SqlSummary == nil {
+		clientMessage.AddFrame(proto.NullFrame.Copy())
+	} else {
+		EncodeSqlSummary(clientMessage, *summary.SqlSummary)
+	}
+	CodecUtil.EncodeNullableForString(clientMessage, summary.SuspensionCause)
+
+	clientMessage.AddFrame(proto.EndFrame.Copy())
+}
+
+func DecodeJobAndSqlSummary(frameIterator *proto.ForwardFrameIterator) JobAndSqlSummary {
+	// begin frame
+	frameIterator.Next()
+	initialFrame := frameIterator.Next()
+	lightJob := FixSizedTypesCodec.DecodeBoolean(initialFrame.Content, JobAndSqlSummaryCodecLightJobFieldOffset)
+	jobId := FixSizedTypesCodec.DecodeLong(initialFrame.Content, JobAndSqlSummaryCodecJobIdFieldOffset)
+	executionId := FixSizedTypesCodec.DecodeLong(initialFrame.Content, JobAndSqlSummaryCodecExecutionIdFieldOffset)
+	status := FixSizedTypesCodec.DecodeInt(initialFrame.Content, JobAndSqlSummaryCodecStatusFieldOffset)
+	submissionTime := FixSizedTypesCodec.DecodeLong(initialFrame.Content, JobAndSqlSummaryCodecSubmissionTimeFieldOffset)
+	completionTime := FixSizedTypesCodec.DecodeLong(initialFrame.Content, JobAndSqlSummaryCodecCompletionTimeFieldOffset)
+	var userCancelled bool
+	// userCancelled is sent by the members since protocol version 2.7
+	if len(initialFrame.Content) >= JobAndSqlSummaryCodecUserCancelledInitialFrameSize {
+		userCancelled = FixSizedTypesCodec.DecodeBoolean(initialFrame.Content, JobAndSqlSummaryCodecUserCancelledFieldOffset)
+	}
+
+	nameOrId := DecodeString(frameIterator)
+	failureText := CodecUtil.DecodeNullableForString(frameIterator)
+	var sqlSummary *SqlSummary
+	if !CodecUtil.NextFrameIsNullFrame(frameIterator) {
+		s := DecodeSqlSummary(frameIterator)
+		sqlSummary = &s
+	}
+	var suspensionCause string
+	if !frameIterator.PeekNext().IsEndFrame() {
+		suspensionCause = CodecUtil.DecodeNullableForString(frameIterator)
+	}
+	CodecUtil.FastForwardToEndFrame(frameIterator)
+	return JobAndSqlSummary{
+		SqlSummary:      sqlSummary,
+		NameOrId:        nameOrId,
+		FailureText:     failureText,
+		SuspensionCause: suspensionCause,
+		JobId:           jobId,
+		ExecutionId:     executionId,
+		SubmissionTime:  submissionTime,
+		CompletionTime:  completionTime,
+		Status:          status,
+		LightJob:        lightJob,
+		UserCancelled:   userCancelled,
+	}
+}
+
+func EncodeListMultiFrameForJobAndSqlSummary(clientMessage *proto.ClientMessage, values []JobAndSqlSummary) {
+	clientMessage.AddFrame(proto.BeginFrame.Copy())
+	for _, v := range values {
+		EncodeJobAndSqlSummary(clientMessage, v)
+	}
+	clientMessage.AddFrame(proto.EndFrame.Copy())
+}
+
+func DecodeListMultiFrameForJobAndSqlSummary(frameIterator *proto.ForwardFrameIterator) []JobAndSqlSummary {
+	var result []JobAndSqlSummary
+	frameIterator.Next()
+	for !CodecUtil.NextFrameIsDataStructureEndFrame(frameIterator) {
+		result = append(result, DecodeJobAndSqlSummary(frameIterator))
+	}
+	frameIterator.Next()
+	return result
+}
@@ -0,0 +1,52 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+)
+
+func TestJobAndSqlSummaryCodec(t *testing.T) {
+	summaries := []JobAndSqlSummary{
+		{
+			NameOrId:       "job-1",
+			JobId:          42,
+			ExecutionId:    43,
+			SubmissionTime: 1000,
+			Status:         2,
+			SqlSummary:     &SqlSummary{Query: "SELECT 1", Unbounded: true},
+		},
+		{
+			NameOrId:        "0000-0000-0000-002c",
+			FailureText:     "failed",
+			SuspensionCause: "requested by user",
+			JobId:           44,
+			CompletionTime:  2000,
+			Status:          6,
+			LightJob:        true,
+			UserCancelled:   true,
+		},
+	}
+	message := proto.NewClientMessageForEncode()
+	EncodeListMultiFrameForJobAndSqlSummary(message, summaries)
+	decoded := DecodeListMultiFrameForJobAndSqlSummary(message.FrameIterator())
+	assert.Equal(t, summaries, decoded)
+}
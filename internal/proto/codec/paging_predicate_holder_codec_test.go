@@ -0,0 +1,46 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+func TestPagingPredicateHolderCodec(t *testing.T) {
+	holder := PagingPredicateHolder{
+		AnchorDataListHolder: AnchorDataListHolder{
+			AnchorPageList: []int32{0, 1},
+			AnchorDataList: []proto.Pair{
+				proto.NewPair(iserialization.Data("key-1"), iserialization.Data("value-1")),
+				proto.NewPair(iserialization.Data("key-2"), iserialization.Data("value-2")),
+			},
+		},
+		PredicateData:   iserialization.Data("predicate"),
+		PageSize:        10,
+		Page:            2,
+		IterationTypeID: 1,
+	}
+	message := proto.NewClientMessageForEncode()
+	EncodePagingPredicateHolder(message, holder)
+	decoded := DecodePagingPredicateHolder(message.FrameIterator())
+	assert.Equal(t, holder, decoded)
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+const (
+	QueryCacheEventDataCodecSequenceFieldOffset         = 0
+	QueryCacheEventDataCodecEventTypeFieldOffset        = QueryCacheEventDataCodecSequenceFieldOffset + proto.LongSizeInBytes
+	QueryCacheEventDataCodecPartitionIdFieldOffset      = QueryCacheEventDataCodecEventTypeFieldOffset + proto.IntSizeInBytes
+	QueryCacheEventDataCodecPartitionIdInitialFrameSize = QueryCacheEventDataCodecPartitionIdFieldOffset + proto.IntSizeInBytes
+)
+
+// QueryCacheEventData is a single event sent to a query cache.
+type QueryCacheEventData struct {
+	Key         iserialization.Data
+	NewValue    iserialization.Data
+	Sequence    int64
+	EventType   int32
+	PartitionID int32
+}
+
+func EncodeQueryCacheEventData(clientMessage *proto.ClientMessage, eventData QueryCacheEventData) {
+	clientMessage.AddFrame(proto.BeginFrame.Copy())
+	initialFrame := proto.NewFrame(make([]byte, QueryCacheEventDataCodecPartitionIdInitialFrameSize))
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, QueryCacheEventDataCodecSequenceFieldOffset, eventData.Sequence)
+	FixSizedTypesCodec.EncodeInt(initialFrame.Content, QueryCacheEventDataCodecEventTypeFieldOffset, eventData.EventType)
+	FixSizedTypesCodec.EncodeInt(initialFrame.Content, QueryCacheEventDataCodecPartitionIdFieldOffset, eventData.PartitionID)
+	clientMessage.AddFrame(initialFrame)
+
+	CodecUtil.EncodeNullableForData(clientMessage, eventData.Key)
+	CodecUtil.EncodeNullableForData(clientMessage, eventData.NewValue)
+
+	clientMessage.AddFrame(proto.EndFrame.Copy())
+}
+
+func DecodeQueryCacheEventData(frameIterator *proto.ForwardFrameIterator) QueryCacheEventData {
+	// begin frame
+	frameIterator.Next()
+	initialFrame := frameIterator.Next()
+	sequence := FixSizedTypesCodec.DecodeLong(initialFrame.Content, QueryCacheEventDataCodecSequenceFieldOffset)
+	eventType := FixSizedTypesCodec.DecodeInt(initialFrame.Content, QueryCacheEventDataCodecEventTypeFieldOffset)
+	partitionID := FixSizedTypesCodec.DecodeInt(initialFrame.Content, QueryCacheEventDataCodecPartitionIdFieldOffset)
+
+	key := CodecUtil.DecodeNullableForData(frameIterator)
+	newValue := CodecUtil.DecodeNullableForData(frameIterator)
+	CodecUtil.FastForwardToEndFrame(frameIterator)
+	return QueryCacheEventData{
+		Key:         key,
+		NewValue:    newValue,
+		Sequence:    sequence,
+		EventType:   eventType,
+		PartitionID: partitionID,
+	}
+}
+
+func EncodeListMultiFrameForQueryCacheEventData(clientMessage *proto.ClientMessage, values []QueryCacheEventData) {
+	clientMessage.AddFrame(proto.BeginFrame.Copy())
+	for _, v := range values {
+		EncodeQueryCacheEventData(clientMessage, v)
+	}
+	clientMessage.AddFrame(proto.EndFrame.Copy())
+}
+
+func DecodeListMultiFrameForQueryCacheEventData(frameIterator *proto.ForwardFrameIterator) []QueryCacheEventData {
+	var result []QueryCacheEventData
+	frameIterator.Next()
+	for !CodecUtil.NextFrameIsDataStructureEndFrame(frameIterator) {
+		result = append(result, DecodeQueryCacheEventData(frameIterator))
+	}
+	frameIterator.Next()
+	return result
+}
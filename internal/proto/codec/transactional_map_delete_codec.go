@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// hex: 0x0E0C00
+	TransactionalMapDeleteCodecRequestMessageType = int32(920576)
+	// hex: 0x0E0C01
+	TransactionalMapDeleteCodecResponseMessageType = int32(920577)
+
+	TransactionalMapDeleteCodecRequestTxnIdOffset      = proto.PartitionIDOffset + proto.IntSizeInBytes
+	TransactionalMapDeleteCodecRequestThreadIdOffset   = TransactionalMapDeleteCodecRequestTxnIdOffset + proto.UuidSizeInBytes
+	TransactionalMapDeleteCodecRequestInitialFrameSize = TransactionalMapDeleteCodecRequestThreadIdOffset + proto.LongSizeInBytes
+)
+
+// Removes the mapping for a key from this map if it is present. Unlike remove(), this operation does not return
+// the removed value, which avoids the serialization cost of the returned value.
+
+func EncodeTransactionalMapDeleteRequest(name string, txnId types.UUID, threadId int64, key iserialization.Data) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, TransactionalMapDeleteCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeUUID(initialFrame.Content, TransactionalMapDeleteCodecRequestTxnIdOffset, txnId)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, TransactionalMapDeleteCodecRequestThreadIdOffset, threadId)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(TransactionalMapDeleteCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+	EncodeData(clientMessage, key)
+
+	return clientMessage
+}
@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// hex: 0x0E0A00
+	TransactionalMapReplaceIfSameCodecRequestMessageType = int32(920064)
+	// hex: 0x0E0A01
+	TransactionalMapReplaceIfSameCodecResponseMessageType = int32(920065)
+
+	TransactionalMapReplaceIfSameCodecRequestTxnIdOffset      = proto.PartitionIDOffset + proto.IntSizeInBytes
+	TransactionalMapReplaceIfSameCodecRequestThreadIdOffset   = TransactionalMapReplaceIfSameCodecRequestTxnIdOffset + proto.UuidSizeInBytes
+	TransactionalMapReplaceIfSameCodecRequestInitialFrameSize = TransactionalMapReplaceIfSameCodecRequestThreadIdOffset + proto.LongSizeInBytes
+
+	TransactionalMapReplaceIfSameResponseResponseOffset = proto.ResponseBackupAcksOffset + proto.ByteSizeInBytes
+)
+
+// Replaces the entry for a key only if currently mapped to a given value.
+
+func EncodeTransactionalMapReplaceIfSameRequest(name string, txnId types.UUID, threadId int64, key iserialization.Data, oldValue iserialization.Data, newValue iserialization.Data) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, TransactionalMapReplaceIfSameCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeUUID(initialFrame.Content, TransactionalMapReplaceIfSameCodecRequestTxnIdOffset, txnId)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, TransactionalMapReplaceIfSameCodecRequestThreadIdOffset, threadId)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(TransactionalMapReplaceIfSameCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+	EncodeData(clientMessage, key)
+	EncodeData(clientMessage, oldValue)
+	EncodeData(clientMessage, newValue)
+
+	return clientMessage
+}
+
+func DecodeTransactionalMapReplaceIfSameResponse(clientMessage *proto.ClientMessage) bool {
+	frameIterator := clientMessage.FrameIterator()
+	initialFrame := frameIterator.Next()
+
+	return FixSizedTypesCodec.DecodeBoolean(initialFrame.Content, TransactionalMapReplaceIfSameResponseResponseOffset)
+}
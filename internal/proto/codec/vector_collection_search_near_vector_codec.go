@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/vector"
+)
+
+const (
+	// hex: 0x240800
+	VectorCollectionSearchNearVectorCodecRequestMessageType = int32(2361344)
+	// hex: 0x240801
+	VectorCollectionSearchNearVectorCodecResponseMessageType = int32(2361345)
+
+	VectorCollectionSearchNearVectorCodecRequestInitialFrameSize = proto.PartitionIDOffset + proto.IntSizeInBytes
+)
+
+// Returns the documents which are most similar to the given vectors.
+
+func EncodeVectorCollectionSearchNearVectorRequest(name string, vectors []vector.Vector, options VectorSearchOptions) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(true)
+
+	initialFrame := proto.NewFrameWith(make([]byte, VectorCollectionSearchNearVectorCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(VectorCollectionSearchNearVectorCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+	EncodeListMultiFrameForVectorPair(clientMessage, vectors)
+	EncodeVectorSearchOptions(clientMessage, options)
+
+	return clientMessage
+}
+
+func DecodeVectorCollectionSearchNearVectorResponse(clientMessage *proto.ClientMessage) []VectorSearchResult {
+	frameIterator := clientMessage.FrameIterator()
+	// empty initial frame
+	frameIterator.Next()
+
+	return DecodeListMultiFrameForVectorSearchResult(frameIterator)
+}
@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/vector"
+)
+
+// VectorDocument is a serialized value together with its vectors.
+type VectorDocument struct {
+	Value   iserialization.Data
+	Vectors []vector.Vector
+}
+
+func EncodeVectorDocument(clientMessage *proto.ClientMessage, doc VectorDocument) {
+	clientMessage.AddFrame(proto.BeginFrame.Copy())
+
+	EncodeData(clientMessage, doc.Value)
+	EncodeListMultiFrameForVectorPair(clientMessage, doc.Vectors)
+
+	clientMessage.AddFrame(proto.EndFrame.Copy())
+}
+
+func DecodeVectorDocument(frameIterator *proto.ForwardFrameIterator) VectorDocument {
+	// begin frame
+	frameIterator.Next()
+
+	value := DecodeData(frameIterator)
+	vectors := DecodeListMultiFrameForVectorPair(frameIterator)
+	CodecUtil.FastForwardToEndFrame(frameIterator)
+	return VectorDocument{
+		Value:   value,
+		Vectors: vectors,
+	}
+}
+
+func DecodeNullableForVectorDocument(frameIterator *proto.ForwardFrameIterator) *VectorDocument {
+	if CodecUtil.NextFrameIsNullFrame(frameIterator) {
+		return nil
+	}
+	doc := DecodeVectorDocument(frameIterator)
+	return &doc
+}
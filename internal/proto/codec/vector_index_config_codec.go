@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/vector"
+)
+
+const (
+	VectorIndexConfigCodecMetricFieldOffset                = 0
+	VectorIndexConfigCodecDimensionFieldOffset             = VectorIndexConfigCodecMetricFieldOffset + proto.IntSizeInBytes
+	VectorIndexConfigCodecMaxDegreeFieldOffset             = VectorIndexConfigCodecDimensionFieldOffset + proto.IntSizeInBytes
+	VectorIndexConfigCodecEfConstructionFieldOffset        = VectorIndexConfigCodecMaxDegreeFieldOffset + proto.IntSizeInBytes
+	VectorIndexConfigCodecUseDeduplicationFieldOffset      = VectorIndexConfigCodecEfConstructionFieldOffset + proto.IntSizeInBytes
+	VectorIndexConfigCodecUseDeduplicationInitialFrameSize = VectorIndexConfigCodecUseDeduplicationFieldOffset + proto.BooleanSizeInBytes
+)
+
+func EncodeVectorIndexConfig(clientMessage *proto.ClientMessage, config vector.IndexConfig) {
+	clientMessage.AddFrame(proto.BeginFrame.Copy())
+	initialFrame := proto.NewFrame(make([]byte, VectorIndexConfigCodecUseDeduplicationInitialFrameSize))
+	FixSizedTypesCodec.EncodeInt(initialFrame.Content, VectorIndexConfigCodecMetricFieldOffset, int32(config.Metric))
+	FixSizedTypesCodec.EncodeInt(initialFrame.Content, VectorIndexConfigCodecDimensionFieldOffset, config.Dimension)
+	FixSizedTypesCodec.EncodeInt(initialFrame.Content, VectorIndexConfigCodecMaxDegreeFieldOffset, config.MaxDegree)
+	FixSizedTypesCodec.EncodeInt(initialFrame.Content, VectorIndexConfigCodecEfConstructionFieldOffset, config.EfConstruction)
+	FixSizedTypesCodec.EncodeBoolean(initialFrame.Content, VectorIndexConfigCodecUseDeduplicationFieldOffset, !config.DisableDeduplication)
+	clientMessage.AddFrame(initialFrame)
+
+	CodecUtil.EncodeNullableForString(clientMessage, config.Name)
+
+	clientMessage.AddFrame(proto.EndFrame.Copy())
+}
+
+func EncodeListMultiFrameForVectorIndexConfig(clientMessage *proto.ClientMessage, values []vector.IndexConfig) {
+	clientMessage.AddFrame(proto.BeginFrame.Copy())
+	for _, v := range values {
+		EncodeVectorIndexConfig(clientMessage, v)
+	}
+	clientMessage.AddFrame(proto.EndFrame.Copy())
+}
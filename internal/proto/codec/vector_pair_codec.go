@@ -0,0 +1,75 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/vector"
+)
+
+const (
+	VectorPairCodecTypeFieldOffset      = 0
+	VectorPairCodecTypeInitialFrameSize = VectorPairCodecTypeFieldOffset + proto.ByteSizeInBytes
+)
+
+// vectorPairTypeDense is the type of dense float vectors.
+const vectorPairTypeDense byte = 0
+
+func EncodeVectorPair(clientMessage *proto.ClientMessage, pair vector.Vector) {
+	clientMessage.AddFrame(proto.BeginFrame.Copy())
+	initialFrame := proto.NewFrame(make([]byte, VectorPairCodecTypeInitialFrameSize))
+	FixSizedTypesCodec.EncodeByte(initialFrame.Content, VectorPairCodecTypeFieldOffset, vectorPairTypeDense)
+	clientMessage.AddFrame(initialFrame)
+
+	EncodeString(clientMessage, pair.Name)
+	EncodeFloatArray(clientMessage, pair.Values)
+
+	clientMessage.AddFrame(proto.EndFrame.Copy())
+}
+
+func DecodeVectorPair(frameIterator *proto.ForwardFrameIterator) vector.Vector {
+	// begin frame
+	frameIterator.Next()
+	// the type field is skipped, since only dense vectors are supported
+	frameIterator.Next()
+
+	name := DecodeString(frameIterator)
+	values := DecodeFloatArray(frameIterator)
+	CodecUtil.FastForwardToEndFrame(frameIterator)
+	return vector.Vector{
+		Name:   name,
+		Values: values,
+	}
+}
+
+func EncodeListMultiFrameForVectorPair(clientMessage *proto.ClientMessage, values []vector.Vector) {
+	clientMessage.AddFrame(proto.BeginFrame.Copy())
+	for _, v := range values {
+		EncodeVectorPair(clientMessage, v)
+	}
+	clientMessage.AddFrame(proto.EndFrame.Copy())
+}
+
+func DecodeListMultiFrameForVectorPair(frameIterator *proto.ForwardFrameIterator) []vector.Vector {
+	var result []vector.Vector
+	frameIterator.Next()
+	for !CodecUtil.NextFrameIsDataStructureEndFrame(frameIterator) {
+		result = append(result, DecodeVectorPair(frameIterator))
+	}
+	frameIterator.Next()
+	return result
+}
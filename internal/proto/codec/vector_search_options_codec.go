@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+)
+
+const (
+	VectorSearchOptionsCodecIncludeValueFieldOffset   = 0
+	VectorSearchOptionsCodecIncludeVectorsFieldOffset = VectorSearchOptionsCodecIncludeValueFieldOffset + proto.BooleanSizeInBytes
+	VectorSearchOptionsCodecLimitFieldOffset          = VectorSearchOptionsCodecIncludeVectorsFieldOffset + proto.BooleanSizeInBytes
+	VectorSearchOptionsCodecLimitInitialFrameSize     = VectorSearchOptionsCodecLimitFieldOffset + proto.IntSizeInBytes
+)
+
+// VectorSearchOptions are the options of a similarity search.
+type VectorSearchOptions struct {
+	Hints          map[string]string
+	Limit          int32
+	IncludeValue   bool
+	IncludeVectors bool
+}
+
+func EncodeVectorSearchOptions(clientMessage *proto.ClientMessage, options VectorSearchOptions) {
+	clientMessage.AddFrame(proto.BeginFrame.Copy())
+	initialFrame := proto.NewFrame(make([]byte, VectorSearchOptionsCodecLimitInitialFrameSize))
+	FixSizedTypesCodec.EncodeBoolean(initialFrame.Content, VectorSearchOptionsCodecIncludeValueFieldOffset, options.IncludeValue)
+	FixSizedTypesCodec.EncodeBoolean(initialFrame.Content, VectorSearchOptionsCodecIncludeVectorsFieldOffset, options.IncludeVectors)
+	FixSizedTypesCodec.EncodeInt(initialFrame.Content, VectorSearchOptionsCodecLimitFieldOffset, options.Limit)
+	clientMessage.AddFrame(initialFrame)
+
+	EncodeMapForStringAndString(clientMessage, options.Hints)
+
+	clientMessage.AddFrame(proto.EndFrame.Copy())
+}
+
+func DecodeVectorSearchOptions(frameIterator *proto.ForwardFrameIterator) VectorSearchOptions {
+	// begin frame
+	frameIterator.Next()
+	initialFrame := frameIterator.Next()
+	includeValue := FixSizedTypesCodec.DecodeBoolean(initialFrame.Content, VectorSearchOptionsCodecIncludeValueFieldOffset)
+	includeVectors := FixSizedTypesCodec.DecodeBoolean(initialFrame.Content, VectorSearchOptionsCodecIncludeVectorsFieldOffset)
+	limit := FixSizedTypesCodec.DecodeInt(initialFrame.Content, VectorSearchOptionsCodecLimitFieldOffset)
+
+	hints := DecodeMapForStringAndString(frameIterator)
+	CodecUtil.FastForwardToEndFrame(frameIterator)
+	return VectorSearchOptions{
+		Hints:          hints,
+		Limit:          limit,
+		IncludeValue:   includeValue,
+		IncludeVectors: includeVectors,
+	}
+}
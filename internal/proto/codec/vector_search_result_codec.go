@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/vector"
+)
+
+const (
+	VectorSearchResultCodecScoreFieldOffset      = 0
+	VectorSearchResultCodecScoreInitialFrameSize = VectorSearchResultCodecScoreFieldOffset + proto.FloatSizeInBytes
+)
+
+// VectorSearchResult is a serialized document found by a similarity search.
+type VectorSearchResult struct {
+	Key     iserialization.Data
+	Value   iserialization.Data
+	Vectors []vector.Vector
+	Score   float32
+}
+
+func EncodeVectorSearchResult(clientMessage *proto.ClientMessage, result VectorSearchResult) {
+	clientMessage.AddFrame(proto.BeginFrame.Copy())
+	initialFrame := proto.NewFrame(make([]byte, VectorSearchResultCodecScoreInitialFrameSize))
+	FixSizedTypesCodec.EncodeFloat(initialFrame.Content, VectorSearchResultCodecScoreFieldOffset, result.Score)
+	clientMessage.AddFrame(initialFrame)
+
+	EncodeData(clientMessage, result.Key)
+	CodecUtil.EncodeNullableForData(clientMessage, result.Value)
+	if result.Vectors == nil {
+		clientMessage.AddFrame(proto.NullFrame.Copy())
+	} else {
+		EncodeListMultiFrameForVectorPair(clientMessage, result.Vectors)
+	}
+
+	clientMessage.AddFrame(proto.EndFrame.Copy())
+}
+
+func DecodeVectorSearchResult(frameIterator *proto.ForwardFrameIterator) VectorSearchResult {
+	// begin frame
+	frameIterator.Next()
+	initialFrame := frameIterator.Next()
+	score := FixSizedTypesCodec.DecodeFloat(initialFrame.Content, VectorSearchResultCodecScoreFieldOffset)
+
+	key := DecodeData(frameIterator)
+	value := CodecUtil.DecodeNullableForData(frameIterator)
+	var vectors []vector.Vector
+	if !CodecUtil.NextFrameIsNullFrame(frameIterator) {
+		vectors = DecodeListMultiFrameForVectorPair(frameIterator)
+	}
+	CodecUtil.FastForwardToEndFrame(frameIterator)
+	return VectorSearchResult{
+		Key:     key,
+		Value:   value,
+		Vectors: vectors,
+		Score:   score,
+	}
+}
+
+func DecodeListMultiFrameForVectorSearchResult(frameIterator *proto.ForwardFrameIterator) []VectorSearchResult {
+	var result []VectorSearchResult
+	frameIterator.Next()
+	for !CodecUtil.NextFrameIsDataStructureEndFrame(frameIterator) {
+		result = append(result, DecodeVectorSearchResult(frameIterator))
+	}
+	frameIterator.Next()
+	return result
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/vector"
+)
+
+func TestVectorSearchResultCodec(t *testing.T) {
+	results := []VectorSearchResult{
+		{
+			Key:     iserialization.Data{1, 2, 3},
+			Value:   iserialization.Data{4, 5, 6},
+			Vectors: []vector.Vector{{Name: "a", Values: []float32{0.5, -1.25, 3}}, {Name: "b", Values: []float32{}}},
+			Score:   0.75,
+		},
+		{
+			Key:   iserialization.Data{7, 8, 9},
+			Score: 0.25,
+		},
+	}
+	message := proto.NewClientMessageForEncode()
+	message.AddFrame(proto.BeginFrame.Copy())
+	for _, r := range results {
+		EncodeVectorSearchResult(message, r)
+	}
+	message.AddFrame(proto.EndFrame.Copy())
+	decoded := DecodeListMultiFrameForVectorSearchResult(message.FrameIterator())
+	assert.Equal(t, results, decoded)
+}
+
+func TestVectorDocumentCodec(t *testing.T) {
+	doc := VectorDocument{
+		Value:   iserialization.Data{1, 2, 3},
+		Vectors: []vector.Vector{vector.NewVector(1, 2, 3)},
+	}
+	message := proto.NewClientMessageForEncode()
+	EncodeVectorDocument(message, doc)
+	decoded := DecodeNullableForVectorDocument(message.FrameIterator())
+	assert.Equal(t, &doc, decoded)
+}
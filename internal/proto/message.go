@@ -159,6 +159,19 @@ func (m *ClientMessage) HasBackupEventFlag() bool {
 	return m.Frames[0].HasBackupEventFlag()
 }
 
+func (m *ClientMessage) HasBackupAwareFlag() bool {
+	return m.Frames[0].IsFlagSet(BackupAwareFlag)
+}
+
+// SetBackupAware sets or clears the flag which requests the backup replicas to acknowledge the backups to the client.
+func (m *ClientMessage) SetBackupAware(backupAware bool) {
+	if backupAware {
+		m.Frames[0].flags |= BackupAwareFlag
+	} else {
+		m.Frames[0].flags &^= BackupAwareFlag
+	}
+}
+
 func (m *ClientMessage) HasFinalFrame() bool {
 	return m.Frames[len(m.Frames)-1].IsFinalFrame()
 }
@@ -31,6 +31,7 @@ const (
 	UuidSizeInBytes                      = 17 // Deprecated
 	EntryListUUIDLongEntrySizeInBytes    = UUIDSizeInBytes + LongSizeInBytes
 	EntryListIntegerLongSizeInBytes      = IntSizeInBytes + LongSizeInBytes
+	EntryListIntegerIntegerSizeInBytes   = IntSizeInBytes + IntSizeInBytes
 	EntryListIntegerUUIDEntrySizeInBytes = IntSizeInBytes + UUIDSizeInBytes
 	LocalDateSizeInBytes                 = IntSizeInBytes + 2*ByteSizeInBytes
 	LocalTimeSizeInBytes                 = 3*ByteSizeInBytes + IntSizeInBytes
@@ -58,6 +59,7 @@ const (
 	EndDataStructureFlag      = 1 << 11
 	IsNullFlag                = 1 << 10
 	IsEventFlag               = 1 << 9
+	BackupAwareFlag           = 1 << 8
 	BackupEventFlag           = 1 << 7
 	SizeOfFrameLengthAndFlags = IntSizeInBytes + ShortSizeInBytes
 )
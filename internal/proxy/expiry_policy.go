@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package proxy
+
+import (
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/internal"
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+)
+
+const (
+	expiryPolicyClassID = 21
+)
+
+// Ordinals of java.util.concurrent.TimeUnit values.
+const (
+	timeUnitNanoseconds int32 = iota
+	timeUnitMicroseconds
+	timeUnitMilliseconds
+	timeUnitSeconds
+	timeUnitMinutes
+	timeUnitHours
+	timeUnitDays
+)
+
+// ExpiryPolicy is the serialized form of com.hazelcast.cache.HazelcastExpiryPolicy.
+// Durations are in milliseconds, a zero duration is sent as an eternal duration.
+type ExpiryPolicy struct {
+	Create int64
+	Access int64
+	Update int64
+}
+
+func (p ExpiryPolicy) FactoryID() int32 {
+	return internal.CacheFactoryID
+}
+
+func (p ExpiryPolicy) ClassID() int32 {
+	return expiryPolicyClassID
+}
+
+func (p ExpiryPolicy) WriteData(output serialization.DataOutput) {
+	writeDuration(output, p.Create)
+	writeDuration(output, p.Access)
+	writeDuration(output, p.Update)
+}
+
+func (p *ExpiryPolicy) ReadData(input serialization.DataInput) {
+	p.Create = readDuration(input)
+	p.Access = readDuration(input)
+	p.Update = readDuration(input)
+}
+
+func writeDuration(output serialization.DataOutput, ms int64) {
+	output.WriteInt64(ms)
+	if ms != 0 {
+		output.WriteInt32(timeUnitMilliseconds)
+	}
+}
+
+func readDuration(input serialization.DataInput) int64 {
+	amount := input.ReadInt64()
+	if amount == 0 {
+		return 0
+	}
+	switch input.ReadInt32() {
+	case timeUnitNanoseconds:
+		return amount / int64(time.Millisecond)
+	case timeUnitMicroseconds:
+		return amount / int64(time.Millisecond/time.Microsecond)
+	case timeUnitSeconds:
+		return amount * int64(time.Second/time.Millisecond)
+	case timeUnitMinutes:
+		return amount * int64(time.Minute/time.Millisecond)
+	case timeUnitHours:
+		return amount * int64(time.Hour/time.Millisecond)
+	case timeUnitDays:
+		return amount * int64(24*time.Hour/time.Millisecond)
+	default:
+		return amount
+	}
+}
@@ -33,6 +33,11 @@ func NewLockContext(ctx context.Context) context.Context {
 	return context.WithValue(ctx, lockIDKey{}, lockID(lockIDGen.NextID()))
 }
 
+// NextLockID returns a new lock ID which is not used by any lock context.
+func NextLockID() int64 {
+	return lockIDGen.NextID()
+}
+
 // ExtractLockID extracts lock ID from the context.
 // If the lock ID is not found, it returns the default lock ID.
 func ExtractLockID(ctx context.Context) int64 {
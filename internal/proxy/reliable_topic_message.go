@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package proxy
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal"
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+)
+
+const (
+	reliableTopicMessageClassID = 2
+	addressClassID              = 1
+)
+
+type TopicFactory struct {
+}
+
+func (f TopicFactory) Create(id int32) serialization.IdentifiedDataSerializable {
+	if id == reliableTopicMessageClassID {
+		return &ReliableTopicMessage{}
+	}
+	return nil
+}
+
+func (f TopicFactory) FactoryID() int32 {
+	return internal.TopicFactoryID
+}
+
+// ReliableTopicMessage is the item stored in the Ringbuffer backing a reliable topic.
+// Its serialized form is compatible with the members.
+type ReliableTopicMessage struct {
+	PublisherAddress *Address
+	// Payload is the serialized message.
+	Payload []byte
+	// PublishTime is the publish time in milliseconds since the epoch.
+	PublishTime int64
+}
+
+func (m ReliableTopicMessage) FactoryID() int32 {
+	return internal.TopicFactoryID
+}
+
+func (m ReliableTopicMessage) ClassID() int32 {
+	return reliableTopicMessageClassID
+}
+
+func (m ReliableTopicMessage) WriteData(output serialization.DataOutput) {
+	output.WriteInt64(m.PublishTime)
+	if m.PublisherAddress == nil {
+		output.WriteObject(nil)
+	} else {
+		output.WriteObject(m.PublisherAddress)
+	}
+	output.WriteByteArray(m.Payload)
+}
+
+func (m *ReliableTopicMessage) ReadData(input serialization.DataInput) {
+	m.PublishTime = input.ReadInt64()
+	if addr, ok := input.ReadObject().(*Address); ok {
+		m.PublisherAddress = addr
+	}
+	m.Payload = input.ReadByteArray()
+}
+
+type ClusterFactory struct {
+}
+
+func (f ClusterFactory) Create(id int32) serialization.IdentifiedDataSerializable {
+	if id == addressClassID {
+		return &Address{}
+	}
+	return nil
+}
+
+func (f ClusterFactory) FactoryID() int32 {
+	return internal.ClusterFactoryID
+}
+
+// Address is the member side representation of a network address.
+type Address struct {
+	Host string
+	Port int32
+	Type byte
+}
+
+func (a Address) FactoryID() int32 {
+	return internal.ClusterFactoryID
+}
+
+func (a Address) ClassID() int32 {
+	return addressClassID
+}
+
+func (a Address) WriteData(output serialization.DataOutput) {
+	output.WriteInt32(a.Port)
+	output.WriteByte(a.Type)
+	output.WriteString(a.Host)
+}
+
+func (a *Address) ReadData(input serialization.DataInput) {
+	a.Port = input.ReadInt32()
+	a.Type = input.ReadByte()
+	a.Host = input.ReadString()
+}
@@ -43,6 +43,11 @@ type HTTPClient struct {
 }
 
 func NewHTTPClient() *HTTPClient {
+	return NewHTTPClientWith(&http.Client{})
+}
+
+// NewHTTPClientWith creates an HTTPClient which sends the requests using the given http.Client.
+func NewHTTPClientWith(httpClient *http.Client) *HTTPClient {
 	// TODO: make circuit breaker configurable
 	cbr := cb.NewCircuitBreaker(
 		cb.MaxRetries(3),
@@ -51,7 +56,7 @@ func NewHTTPClient() *HTTPClient {
 			return time.Duration(attempt) * time.Second
 		}))
 	return &HTTPClient{
-		httpClient: &http.Client{},
+		httpClient: httpClient,
 		cb:         cbr,
 	}
 }
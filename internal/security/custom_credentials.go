@@ -0,0 +1,37 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package security
+
+// CustomCredentials is an implementation of Credentials using an arbitrary value as the security attribute.
+// The value is serialized when the client authenticates.
+type CustomCredentials struct {
+	*BaseCredentials
+	value interface{}
+}
+
+// NewCustomCredentials returns CustomCredentials with the given value.
+func NewCustomCredentials(value interface{}) *CustomCredentials {
+	return &CustomCredentials{
+		BaseCredentials: &BaseCredentials{},
+		value:           value,
+	}
+}
+
+// Value returns the value of the credentials.
+func (c *CustomCredentials) Value() interface{} {
+	return c.value
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package security
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// tokenExpiryMargin is subtracted from the expiry time of a token, so a token which is about to expire is not used.
+const tokenExpiryMargin = 5 * time.Second
+
+// TokenProvider returns a token and the time it expires.
+type TokenProvider func(ctx context.Context) (token []byte, expiry time.Time, err error)
+
+// TokenCredentials is an implementation of Credentials using a token as the security attribute.
+type TokenCredentials struct {
+	*BaseCredentials
+	provider TokenProvider
+	mu       *sync.Mutex
+	token    []byte
+	expiry   time.Time
+}
+
+// NewStaticTokenCredentials returns TokenCredentials with the given token, which never expires.
+func NewStaticTokenCredentials(token []byte) *TokenCredentials {
+	return &TokenCredentials{
+		BaseCredentials: &BaseCredentials{},
+		mu:              &sync.Mutex{},
+		token:           token,
+	}
+}
+
+// NewTokenCredentials returns TokenCredentials which request the token from the given provider.
+func NewTokenCredentials(provider TokenProvider) *TokenCredentials {
+	return &TokenCredentials{
+		BaseCredentials: &BaseCredentials{},
+		provider:        provider,
+		mu:              &sync.Mutex{},
+	}
+}
+
+// Token returns the current token.
+// A new token is requested from the provider if there is no token, or the current one is expired.
+func (c *TokenCredentials) Token(ctx context.Context) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.valid() {
+		return c.token, nil
+	}
+	token, expiry, err := c.provider(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.token = token
+	c.expiry = expiry
+	return token, nil
+}
+
+// Invalidate discards the current token, so the next call to Token requests a new one.
+// It has no effect on a static token.
+func (c *TokenCredentials) Invalidate() {
+	if c.provider == nil {
+		return
+	}
+	c.mu.Lock()
+	c.token = nil
+	c.mu.Unlock()
+}
+
+func (c *TokenCredentials) valid() bool {
+	if c.provider == nil {
+		return true
+	}
+	if c.token == nil || c.expiry.IsZero() {
+		return false
+	}
+	return time.Now().Before(c.expiry.Add(-tokenExpiryMargin))
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package security
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenCredentials_Static(t *testing.T) {
+	creds := NewStaticTokenCredentials([]byte("token"))
+	creds.Invalidate()
+	token, err := creds.Token(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []byte("token"), token)
+}
+
+func TestTokenCredentials_Provider(t *testing.T) {
+	var calls int
+	expiry := time.Now().Add(time.Hour)
+	creds := NewTokenCredentials(func(ctx context.Context) ([]byte, time.Time, error) {
+		calls++
+		return []byte{byte(calls)}, expiry, nil
+	})
+	ctx := context.Background()
+	token, err := creds.Token(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []byte{1}, token)
+	// the token is reused until it expires
+	token, err = creds.Token(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []byte{1}, token)
+	creds.Invalidate()
+	token, err = creds.Token(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []byte{2}, token)
+	// a token which is about to expire is not used
+	expiry = time.Now().Add(time.Second)
+	creds.Invalidate()
+	tokenFn := func() []byte {
+		token, err := creds.Token(ctx)
+		require.NoError(t, err)
+		return token
+	}
+	require.Equal(t, []byte{3}, tokenFn())
+	require.Equal(t, []byte{4}, tokenFn())
+	// zero expiry requests a new token every time
+	expiry = time.Time{}
+	require.Equal(t, []byte{5}, tokenFn())
+	require.Equal(t, []byte{6}, tokenFn())
+}
+
+func TestTokenCredentials_ProviderError(t *testing.T) {
+	errProvider := errors.New("provider error")
+	creds := NewTokenCredentials(func(ctx context.Context) ([]byte, time.Time, error) {
+		return nil, time.Time{}, errProvider
+	})
+	_, err := creds.Token(context.Background())
+	require.True(t, errors.Is(err, errProvider))
+}
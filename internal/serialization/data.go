@@ -49,10 +49,22 @@ func (d Data) DataSize() int {
 	return v
 }
 
+// PartitionHash returns the partition hash stored in the header if it exists, otherwise the hash of the payload.
+// The header contains the hash of the partition key for partition aware objects.
 func (d Data) PartitionHash() int32 {
+	if len(d) >= heapDataOverhead {
+		if h := int32(binary.BigEndian.Uint32(d)); h != 0 {
+			return h
+		}
+	}
 	return murmur.Default3A(d, DataOffset, d.DataSize())
 }
 
+// Hash64 returns the 64-bit MurmurHash3 of the payload.
+func (d Data) Hash64() int64 {
+	return murmur.Default64(d, DataOffset, d.DataSize())
+}
+
 func (d Data) IsNil() bool {
 	return d == nil
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	iproxy "github.com/hazelcast/hazelcast-go-client/internal/proxy"
 	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
 	"github.com/hazelcast/hazelcast-go-client/serialization"
 )
@@ -112,3 +113,109 @@ func TestIdentifiedDataSerializableSerializer_NoInstanceCreated(t *testing.T) {
 		t.Fatalf("should fail as HazelcastSerializationError")
 	}
 }
+
+func TestIdentifiedDataSerializableSerializer_ReliableTopicMessage(t *testing.T) {
+	service, err := iserialization.NewService(&serialization.Config{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload, err := service.ToData("payload")
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := &iproxy.ReliableTopicMessage{
+		PublisherAddress: &iproxy.Address{Host: "127.0.0.1", Port: 5701},
+		Payload:          payload,
+		PublishTime:      42,
+	}
+	data, err := service.ToData(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ret, err := service.ToObject(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, msg, ret)
+}
+
+func TestIdentifiedDataSerializableSerializer_UserFactoryWithClusterFactoryID(t *testing.T) {
+	c := &serialization.Config{}
+	c.SetIdentifiedDataSerializableFactories(&zeroFactory{})
+	if _, err := iserialization.NewService(c, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+type zeroFactory struct{}
+
+func (zeroFactory) Create(classID int32) serialization.IdentifiedDataSerializable {
+	return nil
+}
+
+func (zeroFactory) FactoryID() int32 {
+	return 0
+}
+
+func TestService_ReadIdentifiedFields(t *testing.T) {
+	c := &serialization.Config{}
+	c.SetIdentifiedDataSerializableFactories(&factory{})
+	service, err := iserialization.NewService(c, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := service.ToData(&employee{age: 38, name: "Jack"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e employee
+	// the service without the factory can read the fields as well
+	service, err = iserialization.NewService(&serialization.Config{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := service.ReadIdentifiedFields(data, e.ReadData); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, employee{age: 38, name: "Jack"}, e)
+	stringData, err := service.ToData("foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = service.ReadIdentifiedFields(stringData, e.ReadData)
+	assert.True(t, errors.Is(err, hzerrors.ErrHazelcastSerialization))
+}
+
+func TestIdentifiedDataSerializableSerializer_ExpiryPolicy(t *testing.T) {
+	service, err := iserialization.NewService(&serialization.Config{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ep := &iproxy.ExpiryPolicy{Create: 1000, Update: 60000}
+	data, err := service.ToData(ep)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var durations []int64
+	var units []int32
+	err = service.ReadIdentifiedFields(data, func(input serialization.DataInput) {
+		for i := 0; i < 3; i++ {
+			amount := input.ReadInt64()
+			durations = append(durations, amount)
+			if amount != 0 {
+				units = append(units, input.ReadInt32())
+			}
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// eternal durations are written without a time unit, MILLISECONDS has the ordinal 2.
+	assert.Equal(t, []int64{1000, 0, 60000}, durations)
+	assert.Equal(t, []int32{2, 2}, units)
+	var ret iproxy.ExpiryPolicy
+	if err := service.ReadIdentifiedFields(data, ret.ReadData); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, *ep, ret)
+}
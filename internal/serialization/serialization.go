@@ -99,7 +99,12 @@ func (s *Service) SetSchemaService(ss *SchemaService) {
 // ToData serializes an object to a Data.
 // It can safely be called with a Data. In that case, that instance is returned.
 // If it is called with nil, nil is returned.
+// If the object is partition aware, the hash of its partition key is stored in the header of Data.
 func (s *Service) ToData(object interface{}) (r Data, err error) {
+	return s.toData(object, true)
+}
+
+func (s *Service) toData(object interface{}, checkPartitionAware bool) (r Data, err error) {
 	defer func() {
 		if rec := recover(); rec != nil {
 			err = makeError(rec)
@@ -117,12 +122,42 @@ func (s *Service) ToData(object interface{}) (r Data, err error) {
 	if err != nil {
 		return Data{}, err
 	}
-	dataOutput.WriteInt32BigEndian(0) // partition
+	var partitionHash int32
+	if checkPartitionAware {
+		if partitionHash, err = s.partitionHash(object); err != nil {
+			return Data{}, err
+		}
+	}
+	dataOutput.WriteInt32BigEndian(partitionHash)
 	dataOutput.WriteInt32BigEndian(serializer.ID())
 	serializer.Write(dataOutput, object)
 	return dataOutput.buffer[:dataOutput.position], err
 }
 
+// partitionAware has the same method set with the public hazelcast.PartitionAware interface.
+type partitionAware interface {
+	PartitionKey() interface{}
+}
+
+// partitionHash returns the hash of the partition key if the object is partition aware, otherwise 0.
+// The hash is stored in the header of Data, see: com.hazelcast.internal.serialization.impl.AbstractSerializationService#calculatePartitionHash
+func (s *Service) partitionHash(object interface{}) (int32, error) {
+	pa, ok := object.(partitionAware)
+	if !ok {
+		return 0, nil
+	}
+	key := pa.PartitionKey()
+	if key == nil {
+		return 0, nil
+	}
+	// the partition key itself is not checked for partition awareness, same as the members.
+	keyData, err := s.toData(key, false)
+	if err != nil {
+		return 0, err
+	}
+	return keyData.PartitionHash(), nil
+}
+
 // ToObject deserializes the given Data to an object.
 // nil is returned if called with nil.
 func (s *Service) ToObject(data Data) (r interface{}, err error) {
@@ -147,6 +182,28 @@ func (s *Service) ToObject(data Data) (r interface{}, err error) {
 	return serializer.Read(dataInput), nil
 }
 
+// ReadIdentifiedFields reads the fields of the given IdentifiedDataSerializable data with the given function.
+// It does not require a factory to be registered for the data, so it is used for the member side types which are not exposed as is.
+func (s *Service) ReadIdentifiedFields(data Data, read func(input pubserialization.DataInput)) (err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = makeError(rec)
+		}
+	}()
+	if typeID := data.Type(); typeID != TypeDataSerializable {
+		return ihzerrors.NewSerializationError(fmt.Sprintf("serialization.Service.ReadIdentifiedFields: unexpected type %d", typeID), nil)
+	}
+	input := NewObjectDataInput(data, DataOffset, s, !s.SerializationConfig.LittleEndian)
+	if !input.ReadBool() {
+		return ihzerrors.NewSerializationError("serialization.Service.ReadIdentifiedFields: data is not IdentifiedDataSerializable", nil)
+	}
+	// skip the factory and class IDs
+	input.ReadInt32()
+	input.ReadInt32()
+	read(input)
+	return nil
+}
+
 func (s *Service) WriteObject(output pubserialization.DataOutput, object interface{}) {
 	serializer, err := s.FindSerializerFor(object)
 	if err != nil {
@@ -259,6 +316,7 @@ func (s *Service) lookUpGlobalSerializer() pubserialization.Serializer {
 func (s *Service) registerIdentifiedFactories() error {
 	fs := map[int32]pubserialization.IdentifiedDataSerializableFactory{
 		internal.AggregateFactoryID: &proxy.AggregateFactory{},
+		internal.TopicFactoryID:     &proxy.TopicFactory{},
 	}
 	for _, f := range s.SerializationConfig.IdentifiedDataSerializableFactories() {
 		fid := f.FactoryID()
@@ -267,6 +325,11 @@ func (s *Service) registerIdentifiedFactories() error {
 		}
 		fs[fid] = f
 	}
+	// the cluster factory is required only to read the publisher addresses of reliable topic messages.
+	// its ID is likely to be used by the user factories, so it is registered only if it does not clash.
+	if _, ok := fs[internal.ClusterFactoryID]; !ok {
+		fs[internal.ClusterFactoryID] = &proxy.ClusterFactory{}
+	}
 	s.identifiedSerializer = NewIdentifiedDataSerializableSerializer(fs)
 	if err := s.registerSerializer(s.identifiedSerializer); err != nil {
 		return err
@@ -56,6 +56,29 @@ func TestSerializationService_ToData(t *testing.T) {
 	require.Equal(t, obj, v)
 }
 
+type orderLine struct {
+	OrderID string
+	Line    int
+}
+
+func (o orderLine) PartitionKey() interface{} {
+	return o.OrderID
+}
+
+func TestSerializationService_ToData_PartitionAware(t *testing.T) {
+	service := mustSerializationService(iserialization.NewService(&serialization.Config{}, nil))
+	line := orderLine{OrderID: "order-1", Line: 2}
+	data := mustData(service.ToData(line))
+	orderData := mustData(service.ToData("order-1"))
+	// the hash of the partition key is stored in the header
+	require.NotEqual(t, []byte{0, 0, 0, 0}, []byte(data[:4]))
+	require.Equal(t, orderData.PartitionHash(), data.PartitionHash())
+	obj := it.MustValue(service.ToObject(data))
+	require.Equal(t, line, obj)
+	// objects which are not partition aware do not have the hash in the header
+	require.Equal(t, []byte{0, 0, 0, 0}, []byte(orderData[:4]))
+}
+
 func TestSerializationService_ToData_LittleEndianTrue(t *testing.T) {
 	var v int32 = 100
 	c := &serialization.Config{}
@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+)
+
+func TestJet_JobLifecycle(t *testing.T) {
+	it.SkipIf(t, "hz < 5.3")
+	it.SQLTester(t, func(t *testing.T, client *hz.Client, config *hz.Config, m *hz.Map, mapName string) {
+		ctx := context.Background()
+		q := fmt.Sprintf(`
+			CREATE MAPPING "%s"
+			TYPE IMAP
+			OPTIONS (
+				'keyFormat' = 'bigint',
+				'valueFormat' = 'bigint'
+			)
+		`, mapName)
+		it.MustValue(client.SQL().Execute(ctx, q))
+		jobName := it.NewUniqueObjectName("job")
+		q = fmt.Sprintf(`CREATE JOB "%s" AS SINK INTO "%s" SELECT v, v FROM TABLE(generate_stream(10))`, jobName, mapName)
+		it.MustValue(client.SQL().Execute(ctx, q))
+		job, err := client.Jet().GetJobByName(ctx, jobName)
+		require.NoError(t, err)
+		require.NotNil(t, job)
+		require.Equal(t, jobName, job.Name())
+		defer job.Cancel(ctx)
+		waitForJobStatus(t, job, hz.JobStatusRunning)
+		jobs, err := client.Jet().GetJobs(ctx)
+		require.NoError(t, err)
+		var found bool
+		for _, j := range jobs {
+			if j.ID() == job.ID() {
+				found = true
+			}
+		}
+		require.True(t, found)
+		byID, err := client.Jet().GetJob(ctx, job.ID())
+		require.NoError(t, err)
+		require.Equal(t, jobName, byID.Name())
+		require.NoError(t, job.Suspend(ctx))
+		waitForJobStatus(t, job, hz.JobStatusSuspended)
+		require.NoError(t, job.Resume(ctx))
+		waitForJobStatus(t, job, hz.JobStatusRunning)
+		require.NoError(t, job.Cancel(ctx))
+		waitForJobStatus(t, job, hz.JobStatusFailed)
+	})
+}
+
+func TestJet_GetJobNotExists(t *testing.T) {
+	it.SQLTester(t, func(t *testing.T, client *hz.Client, config *hz.Config, m *hz.Map, mapName string) {
+		job, err := client.Jet().GetJobByName(context.Background(), it.NewUniqueObjectName("job"))
+		require.NoError(t, err)
+		require.Nil(t, job)
+	})
+}
+
+func waitForJobStatus(t *testing.T, job *hz.Job, status hz.JobStatus) {
+	it.Eventually(t, func() bool {
+		s, err := job.Status(context.Background())
+		if err != nil {
+			t.Logf("job status: %s", err.Error())
+			return false
+		}
+		return s == status
+	})
+}
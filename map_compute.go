@@ -0,0 +1,259 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/cb"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+	iproxy "github.com/hazelcast/hazelcast-go-client/internal/proxy"
+)
+
+const (
+	// computeMaxRetries is the number of times an optimistic update is retried after a concurrent modification.
+	computeMaxRetries     = 20
+	computeInitialBackoff = 10 * time.Millisecond
+	computeMaxBackoff     = time.Second
+	computeJitter         = 0.5
+)
+
+// ComputeMode is the way Map.ComputeWithOptions updates an entry.
+type ComputeMode int
+
+const (
+	// ComputeOptimistic writes the new value only if the entry was not modified since it was read.
+	// Otherwise, the update is retried with the latest value.
+	ComputeOptimistic ComputeMode = iota
+	// ComputeLocked locks the key during the update.
+	ComputeLocked
+)
+
+// ComputeOptions contains the options for Map.ComputeWithOptions.
+type ComputeOptions struct {
+	// Mode is the way the entry is updated.
+	// Defaults to ComputeOptimistic.
+	Mode ComputeMode
+}
+
+func (o *ComputeOptions) Validate() error {
+	if o.Mode != ComputeOptimistic && o.Mode != ComputeLocked {
+		return ihzerrors.NewIllegalArgumentError(fmt.Sprintf("invalid compute mode: %d", o.Mode), nil)
+	}
+	return nil
+}
+
+/*
+ComputeFunc computes the new value of an entry from its current value.
+present is false if the key does not exist in the map, old is nil in that case.
+If remove is true or new is nil, the entry is removed.
+
+The function may be called more than once for the same Compute call, so it should not have side effects.
+*/
+type ComputeFunc func(old interface{}, present bool) (new interface{}, remove bool)
+
+/*
+Compute updates the value for the given key using the given function and returns the new value.
+Returns nil if the entry does not exist after the update.
+
+The function runs on the client, so unlike ExecuteOnKey, no entry processor needs to be deployed to the members.
+The current value is read from the member which owns the key, the Near Cache is not used.
+The new value is written only if the entry was not modified in the meantime, using PutIfAbsent, ReplaceIfSame or RemoveIfSame.
+Otherwise, the update is retried with the latest value after a short randomized delay.
+If the entry keeps being modified concurrently, the update is abandoned and an error which wraps hzerrors.ErrConcurrentModification is returned.
+
+Use ComputeWithOptions with ComputeLocked mode to lock the key during the update instead.
+*/
+func (m *Map) Compute(ctx context.Context, key interface{}, fn ComputeFunc) (interface{}, error) {
+	return m.ComputeWithOptions(ctx, key, fn, ComputeOptions{})
+}
+
+/*
+ComputeWithOptions updates the value for the given key using the given function and the given options, and returns the new value.
+Returns nil if the entry does not exist after the update.
+See Compute for the ComputeOptimistic mode.
+
+In ComputeLocked mode, the key is locked during the update, so the function is called only once and the entry is written with Set or Delete.
+The lock is owned by the lock context of ctx if it is created with NewLockContext, otherwise a new lock context is used.
+
+	v, err := m.ComputeWithOptions(ctx, "counter", func(old interface{}, present bool) (interface{}, bool) {
+		if !present {
+			return int64(1), false
+		}
+		return old.(int64) + 1, false
+	}, hazelcast.ComputeOptions{Mode: hazelcast.ComputeLocked})
+*/
+func (m *Map) ComputeWithOptions(ctx context.Context, key interface{}, fn ComputeFunc, opts ComputeOptions) (interface{}, error) {
+	if fn == nil {
+		return nil, ihzerrors.NewIllegalArgumentError("compute function must not be nil", nil)
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if opts.Mode == ComputeLocked {
+		if iproxy.ExtractLockID(ctx) == 0 {
+			ctx = NewLockContext(ctx)
+		}
+		return m.computeLocked(ctx, key, fn)
+	}
+	retryDelay := cb.ExponentialBackoff(computeInitialBackoff, computeMaxBackoff, computeJitter, rand.Float64)
+	for attempt := 0; attempt <= computeMaxRetries; attempt++ {
+		if attempt > 0 {
+			timer := time.NewTimer(retryDelay(attempt - 1))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return nil, ctx.Err()
+			case <-timer.C:
+			}
+		}
+		value, ok, err := m.tryCompute(ctx, key, fn)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return value, nil
+		}
+	}
+	msg := fmt.Sprintf("entry was modified concurrently, giving up after %d retries", computeMaxRetries)
+	return nil, ihzerrors.NewClientError(msg, nil, hzerrors.ErrConcurrentModification)
+}
+
+// ComputeIfAbsent sets the value for the given key using the given function, if the key does not exist in the map.
+// Returns the existing value if the key exists, otherwise the computed value.
+// Nothing is set if the function returns nil.
+func (m *Map) ComputeIfAbsent(ctx context.Context, key interface{}, fn func() interface{}) (interface{}, error) {
+	if fn == nil {
+		return nil, ihzerrors.NewIllegalArgumentError("compute function must not be nil", nil)
+	}
+	return m.Compute(ctx, key, func(old interface{}, present bool) (interface{}, bool) {
+		if present {
+			return old, false
+		}
+		value := fn()
+		return value, value == nil
+	})
+}
+
+// ComputeIfPresent updates the value for the given key using the given function, if the key exists in the map.
+// Returns the new value, or nil if the key does not exist or the entry was removed.
+// See ComputeFunc for the meaning of the values returned from the function.
+func (m *Map) ComputeIfPresent(ctx context.Context, key interface{}, fn func(old interface{}) (new interface{}, remove bool)) (interface{}, error) {
+	if fn == nil {
+		return nil, ihzerrors.NewIllegalArgumentError("compute function must not be nil", nil)
+	}
+	return m.Compute(ctx, key, func(old interface{}, present bool) (interface{}, bool) {
+		if !present {
+			return nil, true
+		}
+		return fn(old)
+	})
+}
+
+// Merge sets the given value for the given key if the key does not exist in the map.
+// Otherwise, the value is updated using the given function, which receives the existing value and the given value.
+// Returns the new value, or nil if the entry was removed.
+// See ComputeFunc for the meaning of the values returned from the function.
+func (m *Map) Merge(ctx context.Context, key interface{}, value interface{}, fn func(old, value interface{}) (new interface{}, remove bool)) (interface{}, error) {
+	if value == nil {
+		return nil, ihzerrors.NewIllegalArgumentError("value must not be nil", nil)
+	}
+	if fn == nil {
+		return nil, ihzerrors.NewIllegalArgumentError("merge function must not be nil", nil)
+	}
+	return m.Compute(ctx, key, func(old interface{}, present bool) (interface{}, bool) {
+		if !present {
+			return value, false
+		}
+		return fn(old, value)
+	})
+}
+
+// tryCompute runs a single optimistic update.
+// Returns false if the entry was modified concurrently.
+func (m *Map) tryCompute(ctx context.Context, key interface{}, fn ComputeFunc) (interface{}, bool, error) {
+	// the Near Cache may be stale, that would fail the update until the Near Cache is invalidated
+	old, err := m.computeGet(ctx, key)
+	if err != nil {
+		return nil, false, err
+	}
+	present := old != nil
+	value, remove := fn(old, present)
+	remove = remove || value == nil
+	switch {
+	case !present && remove:
+		return nil, true, nil
+	case !present:
+		prev, err := m.PutIfAbsent(ctx, key, value)
+		if err != nil {
+			return nil, false, err
+		}
+		return value, prev == nil, nil
+	case remove:
+		ok, err := m.RemoveIfSame(ctx, key, old)
+		return nil, ok, err
+	default:
+		ok, err := m.ReplaceIfSame(ctx, key, old, value)
+		if err != nil || !ok {
+			return nil, false, err
+		}
+		return value, true, nil
+	}
+}
+
+// computeLocked runs the update while holding the lock for the key.
+func (m *Map) computeLocked(ctx context.Context, key interface{}, fn ComputeFunc) (result interface{}, err error) {
+	if err := m.Lock(ctx, key); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if unlockErr := m.Unlock(ctx, key); unlockErr != nil && err == nil {
+			result, err = nil, unlockErr
+		}
+	}()
+	old, err := m.computeGet(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	present := old != nil
+	value, remove := fn(old, present)
+	if remove || value == nil {
+		if present {
+			if err := m.Delete(ctx, key); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	}
+	if err := m.Set(ctx, key, value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// computeGet reads the current value of the key from the member, bypassing the Near Cache.
+func (m *Map) computeGet(ctx context.Context, key interface{}) (interface{}, error) {
+	keyData, err := m.validateAndSerialize(key)
+	if err != nil {
+		return nil, err
+	}
+	return m.getFromRemote(ctx, keyData)
+}
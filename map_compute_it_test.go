@@ -0,0 +1,144 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+)
+
+func TestMap_Compute(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		increment := func(old interface{}, present bool) (interface{}, bool) {
+			if !present {
+				return int64(1), false
+			}
+			return old.(int64) + 1, false
+		}
+		v, err := m.Compute(ctx, "k1", increment)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), v)
+		v, err = m.Compute(ctx, "k1", increment)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), v)
+		it.AssertEquals(t, int64(2), it.MustValue(m.Get(ctx, "k1")))
+		v, err = m.Compute(ctx, "k1", func(old interface{}, present bool) (interface{}, bool) {
+			return nil, true
+		})
+		require.NoError(t, err)
+		require.Nil(t, v)
+		require.False(t, it.MustBool(m.ContainsKey(ctx, "k1")))
+		_, err = m.Compute(ctx, "k1", nil)
+		require.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+	})
+}
+
+func TestMap_ComputeConcurrent(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		const goroutineCount = 10
+		const incrementCount = 10
+		ctx := context.Background()
+		var successCount int64
+		wg := &sync.WaitGroup{}
+		wg.Add(goroutineCount)
+		for i := 0; i < goroutineCount; i++ {
+			go func(i int) {
+				defer wg.Done()
+				for j := 0; j < incrementCount; j++ {
+					// half of the goroutines lock the key
+					opts := hz.ComputeOptions{Mode: hz.ComputeOptimistic}
+					if i%2 == 0 {
+						opts.Mode = hz.ComputeLocked
+					}
+					_, err := m.ComputeWithOptions(ctx, "counter", func(old interface{}, present bool) (interface{}, bool) {
+						if !present {
+							return int64(1), false
+						}
+						return old.(int64) + 1, false
+					}, opts)
+					if err == nil {
+						atomic.AddInt64(&successCount, 1)
+					} else if !errors.Is(err, hzerrors.ErrConcurrentModification) {
+						panic(err)
+					}
+				}
+			}(i)
+		}
+		wg.Wait()
+		// no update is lost
+		it.AssertEquals(t, atomic.LoadInt64(&successCount), it.MustValue(m.Get(ctx, "counter")))
+	})
+}
+
+func TestMap_ComputeIfAbsentIfPresent(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		v, err := m.ComputeIfPresent(ctx, "k1", func(old interface{}) (interface{}, bool) {
+			return "updated", false
+		})
+		require.NoError(t, err)
+		require.Nil(t, v)
+		require.False(t, it.MustBool(m.ContainsKey(ctx, "k1")))
+		v, err = m.ComputeIfAbsent(ctx, "k1", func() interface{} {
+			return "v1"
+		})
+		require.NoError(t, err)
+		require.Equal(t, "v1", v)
+		v, err = m.ComputeIfAbsent(ctx, "k1", func() interface{} {
+			return "v2"
+		})
+		require.NoError(t, err)
+		require.Equal(t, "v1", v)
+		v, err = m.ComputeIfPresent(ctx, "k1", func(old interface{}) (interface{}, bool) {
+			return old.(string) + "-updated", false
+		})
+		require.NoError(t, err)
+		require.Equal(t, "v1-updated", v)
+		it.AssertEquals(t, "v1-updated", it.MustValue(m.Get(ctx, "k1")))
+	})
+}
+
+func TestMap_Merge(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		concat := func(old, value interface{}) (interface{}, bool) {
+			return old.(string) + value.(string), false
+		}
+		v, err := m.Merge(ctx, "k1", "a", concat)
+		require.NoError(t, err)
+		require.Equal(t, "a", v)
+		v, err = m.Merge(ctx, "k1", "b", concat)
+		require.NoError(t, err)
+		require.Equal(t, "ab", v)
+		v, err = m.Merge(ctx, "k1", "c", func(old, value interface{}) (interface{}, bool) {
+			return nil, true
+		})
+		require.NoError(t, err)
+		require.Nil(t, v)
+		require.False(t, it.MustBool(m.ContainsKey(ctx, "k1")))
+	})
+}
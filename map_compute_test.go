@@ -0,0 +1,37 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+)
+
+func TestComputeOptions_Validate(t *testing.T) {
+	opts := hz.ComputeOptions{}
+	assert.NoError(t, opts.Validate())
+	assert.Equal(t, hz.ComputeOptimistic, opts.Mode)
+	opts = hz.ComputeOptions{Mode: hz.ComputeLocked}
+	assert.NoError(t, opts.Validate())
+	opts = hz.ComputeOptions{Mode: hz.ComputeMode(2)}
+	assert.True(t, errors.Is(opts.Validate(), hzerrors.ErrIllegalArgument))
+}
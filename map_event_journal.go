@@ -0,0 +1,174 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/check"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+)
+
+// EventJournalState contains the sequences of the oldest and newest events in the event journal of a partition.
+type EventJournalState struct {
+	// OldestSequence is the sequence of the oldest event in the event journal.
+	// If the event journal is empty, it is greater than NewestSequence.
+	OldestSequence int64
+	// NewestSequence is the sequence of the newest event in the event journal.
+	// If the event journal is empty, it is OldestSequence - 1.
+	NewestSequence int64
+}
+
+// EventJournalMapEvent is a map entry event read from the event journal.
+// OldValue is nil if the event type is EntryAdded, NewValue is nil if the event type is EntryRemoved.
+type EventJournalMapEvent struct {
+	Key       interface{}
+	NewValue  interface{}
+	OldValue  interface{}
+	EventType EntryEventType
+}
+
+/*
+SubscribeToEventJournal subscribes to the event journal of the given partition of this map.
+It returns the sequences of the oldest and newest events in the event journal, which can be used as the start sequence of ReadFromEventJournal.
+The event journal must be enabled for the map on the members.
+
+Since the event journal is kept on the members per partition, a change data capture consumer usually subscribes to each partition in [0, partition count),
+and reads from them starting with the returned OldestSequence or NewestSequence+1.
+*/
+func (m *Map) SubscribeToEventJournal(ctx context.Context, partitionID int32) (EventJournalState, error) {
+	if err := m.checkEventJournalPartitionID(partitionID); err != nil {
+		return EventJournalState{}, err
+	}
+	request := codec.EncodeMapEventJournalSubscribeRequest(m.name)
+	response, err := m.invokeOnPartition(ctx, request, partitionID)
+	if err != nil {
+		return EventJournalState{}, err
+	}
+	oldest, newest := codec.DecodeMapEventJournalSubscribeResponse(response)
+	return EventJournalState{OldestSequence: oldest, NewestSequence: newest}, nil
+}
+
+/*
+ReadFromEventJournal reads a batch of events from the event journal of the given partition of this map.
+
+The events are read starting with startSequence.
+If there are fewer than minSize events available, the call blocks until there are enough events.
+At most maxSize events are returned.
+Use ReadResultSet.GetNextSequenceToReadFrom as the start sequence of the next read to receive a continuous stream of events.
+If startSequence is older than the oldest event in the journal, hzerrors.ErrStaleSequence is returned,
+in which case the reader may continue from the OldestSequence returned by SubscribeToEventJournal.
+
+The predicate and projection are optional, they are executed on the members and must have a member side implementation.
+If projection is nil, the items of the result set are *EventJournalMapEvent values.
+Otherwise, the items are the projected values.
+*/
+func (m *Map) ReadFromEventJournal(ctx context.Context, partitionID int32, startSequence int64, minSize int32, maxSize int32, predicate interface{}, projection interface{}) (ReadResultSet, error) {
+	if err := m.checkEventJournalPartitionID(partitionID); err != nil {
+		return ReadResultSet{}, err
+	}
+	if startSequence < 0 {
+		return ReadResultSet{}, ihzerrors.NewIllegalArgumentError("startSequence can't be smaller then 0", nil)
+	}
+	if minSize < 0 {
+		return ReadResultSet{}, ihzerrors.NewIllegalArgumentError("minSize can't be smaller then 0", nil)
+	}
+	if maxSize < minSize {
+		return ReadResultSet{}, ihzerrors.NewIllegalArgumentError("maxSize should be equal or larger than minSize", nil)
+	}
+	var predData, projData iserialization.Data
+	var err error
+	if !check.Nil(predicate) {
+		if predData, err = m.validateAndSerialize(predicate); err != nil {
+			return ReadResultSet{}, err
+		}
+	}
+	hasProjection := !check.Nil(projection)
+	if hasProjection {
+		if projData, err = m.validateAndSerialize(projection); err != nil {
+			return ReadResultSet{}, err
+		}
+	}
+	request := codec.EncodeMapEventJournalReadRequest(m.name, startSequence, minSize, maxSize, predData, projData)
+	response, err := m.invokeOnPartition(ctx, request, partitionID)
+	if err != nil {
+		return ReadResultSet{}, err
+	}
+	readCount, items, itemSeqs, nextSeq := codec.DecodeMapEventJournalReadResponse(response)
+	errs := make([]error, len(items))
+	convertedItems := make([]interface{}, len(items))
+	for i, item := range items {
+		if hasProjection {
+			convertedItems[i], errs[i] = m.convertToObject(item)
+		} else {
+			convertedItems[i], errs[i] = m.convertToEventJournalMapEvent(item)
+		}
+	}
+	return ReadResultSet{
+		readCount:        readCount,
+		conversionErrors: errs,
+		convertedItems:   convertedItems,
+		itemSeqs:         itemSeqs,
+		nextSeq:          nextSeq,
+	}, nil
+}
+
+func (m *Map) checkEventJournalPartitionID(partitionID int32) error {
+	count := m.partitionService.PartitionCount()
+	if partitionID < 0 || partitionID >= count {
+		return ihzerrors.NewIllegalArgumentError(fmt.Sprintf("partition ID %d is out of range [0,%d)", partitionID, count), nil)
+	}
+	return nil
+}
+
+func (m *Map) convertToEventJournalMapEvent(data iserialization.Data) (*EventJournalMapEvent, error) {
+	// the member side type is not exposed, so its fields are read directly:
+	// event type, key, new value and old value
+	var eventType int32
+	var keyData, newValueData, oldValueData []byte
+	err := m.serializationService.ReadIdentifiedFields(data, func(input serialization.DataInput) {
+		eventType = input.ReadInt32()
+		keyData = input.ReadByteArray()
+		newValueData = input.ReadByteArray()
+		oldValueData = input.ReadByteArray()
+	})
+	if err != nil {
+		return nil, err
+	}
+	key, err := m.convertToObject(keyData)
+	if err != nil {
+		return nil, err
+	}
+	newValue, err := m.convertToObject(newValueData)
+	if err != nil {
+		return nil, err
+	}
+	oldValue, err := m.convertToObject(oldValueData)
+	if err != nil {
+		return nil, err
+	}
+	return &EventJournalMapEvent{
+		Key:       key,
+		NewValue:  newValue,
+		OldValue:  oldValue,
+		EventType: EntryEventType(eventType),
+	}, nil
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"reflect"
 	"sort"
 	"sync"
@@ -28,6 +29,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	hz "github.com/hazelcast/hazelcast-go-client"
 	"github.com/hazelcast/hazelcast-go-client/aggregate"
@@ -36,6 +38,7 @@ import (
 	"github.com/hazelcast/hazelcast-go-client/internal/it/skip"
 	"github.com/hazelcast/hazelcast-go-client/nearcache"
 	"github.com/hazelcast/hazelcast-go-client/predicate"
+	"github.com/hazelcast/hazelcast-go-client/projection"
 	"github.com/hazelcast/hazelcast-go-client/serialization"
 	"github.com/hazelcast/hazelcast-go-client/types"
 )
@@ -84,6 +87,19 @@ func TestMap(t *testing.T) {
 		{name: "GetKeySetWithPredicate", f: mapGetKeySetWithPredicate},
 		{name: "GetValues", f: mapGetValues},
 		{name: "GetValuesWithPredicate", f: mapGetValuesWithPredicate},
+		{name: "GetValuesWithPagingPredicate", f: mapGetValuesWithPagingPredicate},
+		{name: "Project", f: mapProject},
+		{name: "ProjectWithPredicate", f: mapProjectWithPredicate},
+		{name: "Project_Identity", f: mapProjectIdentity},
+		{name: "Iterator", f: mapIterator},
+		{name: "Iterator_KeysOnly", f: mapIteratorKeysOnly},
+		{name: "Iterator_Predicate", f: mapIteratorPredicate},
+		{name: "Iterator_Projection", f: mapIteratorProjection},
+		{name: "Iterator_InvalidOptions", f: mapIteratorInvalidOptions},
+		{name: "EventJournal", f: mapEventJournal},
+		{name: "EventJournal_InvalidArguments", f: mapEventJournalInvalidArguments},
+		{name: "GetKeySetWithPagingPredicate", f: mapGetKeySetWithPagingPredicate},
+		{name: "GetEntrySetWithPagingPredicate", f: mapGetEntrySetWithPagingPredicate},
 		{name: "IsEmptySize", f: mapIsEmptySize},
 		{name: "Lock", f: mapLock},
 		{name: "LockWithLease", f: mapLockWithLease},
@@ -452,6 +468,248 @@ func mapGetKeySetWithPredicate(t *testing.T) {
 	})
 }
 
+func mapGetValuesWithPagingPredicate(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		for i := 0; i < 10; i++ {
+			it.Must(m.Set(ctx, fmt.Sprintf("k%d", i), int32(i)))
+		}
+		pred := predicate.Paging(predicate.GreaterOrEqual("this", int32(2)), 3)
+		values, err := m.GetValuesWithPredicate(ctx, pred)
+		require.NoError(t, err)
+		require.Equal(t, []interface{}{int32(2), int32(3), int32(4)}, values)
+		pred.NextPage()
+		values, err = m.GetValuesWithPredicate(ctx, pred)
+		require.NoError(t, err)
+		require.Equal(t, []interface{}{int32(5), int32(6), int32(7)}, values)
+		require.Len(t, pred.Anchors(), 2)
+		pred.NextPage()
+		values, err = m.GetValuesWithPredicate(ctx, pred)
+		require.NoError(t, err)
+		require.Equal(t, []interface{}{int32(8), int32(9)}, values)
+		pred.NextPage()
+		values, err = m.GetValuesWithPredicate(ctx, pred)
+		require.NoError(t, err)
+		require.Len(t, values, 0)
+		pred.SetPage(1)
+		values, err = m.GetValuesWithPredicate(ctx, pred)
+		require.NoError(t, err)
+		require.Equal(t, []interface{}{int32(5), int32(6), int32(7)}, values)
+		pred.PreviousPage()
+		values, err = m.GetValuesWithPredicate(ctx, pred)
+		require.NoError(t, err)
+		require.Equal(t, []interface{}{int32(2), int32(3), int32(4)}, values)
+	})
+}
+
+func mapGetKeySetWithPagingPredicate(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		for i := 0; i < 5; i++ {
+			it.Must(m.Set(ctx, fmt.Sprintf("k%d", i), int32(i)))
+		}
+		pred := predicate.Paging(nil, 2)
+		pred.SetPage(1)
+		keys, err := m.GetKeySetWithPredicate(ctx, pred)
+		require.NoError(t, err)
+		require.Equal(t, []interface{}{"k2", "k3"}, keys)
+	})
+}
+
+func mapGetEntrySetWithPagingPredicate(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		for i := 0; i < 5; i++ {
+			it.Must(m.Set(ctx, fmt.Sprintf("k%d", i), int32(i)))
+		}
+		pred := predicate.Paging(predicate.True(), 4)
+		pred.NextPage()
+		entries, err := m.GetEntrySetWithPredicate(ctx, pred)
+		require.NoError(t, err)
+		require.Equal(t, []types.Entry{types.NewEntry("k4", int32(4))}, entries)
+	})
+}
+
+func mapProject(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		it.Must(m.Set(ctx, "k1", int32(1)))
+		it.Must(m.Set(ctx, "k2", int32(2)))
+		results, err := m.Project(ctx, projection.Single("__key"))
+		require.NoError(t, err)
+		require.ElementsMatch(t, []interface{}{"k1", "k2"}, results)
+		results, err = m.Project(ctx, projection.Multi("__key", "this"))
+		require.NoError(t, err)
+		require.ElementsMatch(t, []interface{}{
+			[]interface{}{"k1", int32(1)},
+			[]interface{}{"k2", int32(2)},
+		}, results)
+	})
+}
+
+func mapProjectWithPredicate(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		for i := 0; i < 5; i++ {
+			it.Must(m.Set(ctx, fmt.Sprintf("k%d", i), int32(i)))
+		}
+		results, err := m.ProjectWithPredicate(ctx, projection.Single("__key"), predicate.Greater("this", int32(2)))
+		require.NoError(t, err)
+		require.ElementsMatch(t, []interface{}{"k3", "k4"}, results)
+	})
+}
+
+func mapProjectIdentity(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		it.Must(m.Set(ctx, "k1", int32(1)))
+		results, err := m.Project(ctx, projection.Identity())
+		require.NoError(t, err)
+		require.Equal(t, []interface{}{types.NewEntry("k1", int32(1))}, results)
+	})
+}
+
+func mapIterator(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		var target []types.Entry
+		for i := 0; i < 250; i++ {
+			key := fmt.Sprintf("k%d", i)
+			it.Must(m.Set(ctx, key, int32(i)))
+			target = append(target, types.NewEntry(key, int32(i)))
+		}
+		iter, err := m.Iterator(ctx, hz.MapIteratorOptions{BatchSize: 7})
+		require.NoError(t, err)
+		require.ElementsMatch(t, target, collectMapIterator(t, iter))
+	})
+}
+
+func mapIteratorKeysOnly(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		var target []types.Entry
+		for i := 0; i < 50; i++ {
+			key := fmt.Sprintf("k%d", i)
+			it.Must(m.Set(ctx, key, int32(i)))
+			target = append(target, types.NewEntry(key, nil))
+		}
+		iter, err := m.Iterator(ctx, hz.MapIteratorOptions{KeysOnly: true})
+		require.NoError(t, err)
+		require.ElementsMatch(t, target, collectMapIterator(t, iter))
+	})
+}
+
+func mapIteratorPredicate(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		for i := 0; i < 50; i++ {
+			it.Must(m.Set(ctx, fmt.Sprintf("k%d", i), int32(i)))
+		}
+		pred := predicate.GreaterOrEqual("this", int32(47))
+		iter, err := m.Iterator(ctx, hz.MapIteratorOptions{Predicate: pred, BatchSize: 2})
+		require.NoError(t, err)
+		require.ElementsMatch(t, []types.Entry{
+			types.NewEntry("k47", int32(47)),
+			types.NewEntry("k48", int32(48)),
+			types.NewEntry("k49", int32(49)),
+		}, collectMapIterator(t, iter))
+		iter, err = m.Iterator(ctx, hz.MapIteratorOptions{Predicate: pred, KeysOnly: true})
+		require.NoError(t, err)
+		require.ElementsMatch(t, []types.Entry{
+			types.NewEntry("k47", nil),
+			types.NewEntry("k48", nil),
+			types.NewEntry("k49", nil),
+		}, collectMapIterator(t, iter))
+	})
+}
+
+func mapIteratorProjection(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		for i := 0; i < 5; i++ {
+			it.Must(m.Set(ctx, fmt.Sprintf("k%d", i), int32(i)))
+		}
+		iter, err := m.Iterator(ctx, hz.MapIteratorOptions{
+			Projection: projection.Single("__key"),
+			Predicate:  predicate.Less("this", int32(2)),
+		})
+		require.NoError(t, err)
+		require.ElementsMatch(t, []types.Entry{
+			types.NewEntry(nil, "k0"),
+			types.NewEntry(nil, "k1"),
+		}, collectMapIterator(t, iter))
+	})
+}
+
+func mapIteratorInvalidOptions(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		_, err := m.Iterator(ctx, hz.MapIteratorOptions{BatchSize: -1})
+		require.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		_, err = m.Iterator(ctx, hz.MapIteratorOptions{KeysOnly: true, Projection: projection.Single("__key")})
+		require.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		_, err = m.Iterator(ctx, hz.MapIteratorOptions{Predicate: predicate.Paging(predicate.True(), 10)})
+		require.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+	})
+}
+
+func mapEventJournal(t *testing.T) {
+	makeName := func(labels ...string) string {
+		return it.NewUniqueObjectName("event-journal-map", labels...)
+	}
+	it.MapTesterWithConfigAndName(t, makeName, nil, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		it.Must(m.Set(ctx, "k1", "v1"))
+		it.Must(m.Set(ctx, "k1", "v2"))
+		_, err := m.Remove(ctx, "k1")
+		require.NoError(t, err)
+		partitionID, err := m.PartitionIDForKey("k1")
+		require.NoError(t, err)
+		state, err := m.SubscribeToEventJournal(ctx, partitionID)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), state.NewestSequence-state.OldestSequence)
+		rs, err := m.ReadFromEventJournal(ctx, partitionID, state.OldestSequence, 3, 10, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, 3, rs.Size())
+		var events []hz.EventJournalMapEvent
+		for i := 0; i < rs.Size(); i++ {
+			item, err := rs.Get(i)
+			require.NoError(t, err)
+			events = append(events, *item.(*hz.EventJournalMapEvent))
+		}
+		require.Equal(t, []hz.EventJournalMapEvent{
+			{Key: "k1", NewValue: "v1", EventType: hz.EntryAdded},
+			{Key: "k1", NewValue: "v2", OldValue: "v1", EventType: hz.EntryUpdated},
+			{Key: "k1", OldValue: "v2", EventType: hz.EntryRemoved},
+		}, events)
+		require.Equal(t, state.NewestSequence+1, rs.GetNextSequenceToReadFrom())
+	})
+}
+
+func mapEventJournalInvalidArguments(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		_, err := m.SubscribeToEventJournal(ctx, -1)
+		require.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		_, err = m.ReadFromEventJournal(ctx, 0, -1, 0, 1, nil, nil)
+		require.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		_, err = m.ReadFromEventJournal(ctx, 0, 0, 2, 1, nil, nil)
+		require.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+	})
+}
+
+func collectMapIterator(t *testing.T, iter *hz.MapIterator) []types.Entry {
+	var entries []types.Entry
+	for {
+		entry, err := iter.Next(context.Background())
+		if errors.Is(err, io.EOF) {
+			return entries
+		}
+		require.NoError(t, err)
+		entries = append(entries, entry)
+	}
+}
+
 func mapGetValues(t *testing.T) {
 	it.MapTester(t, func(t *testing.T, m *hz.Map) {
 		targetValues := []interface{}{"v1", "v2", "v3"}
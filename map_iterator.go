@@ -0,0 +1,256 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/check"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/predicate"
+	"github.com/hazelcast/hazelcast-go-client/projection"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const defaultMapIteratorBatchSize = 100
+
+// MapIteratorOptions contains the options for Map.Iterator.
+type MapIteratorOptions struct {
+	// Predicate filters the iterated entries on the member side.
+	// Predicate cannot be a paging predicate.
+	Predicate predicate.Predicate
+	// Projection is applied on the member side to the iterated entries.
+	// If set, the Key field of the returned entries is nil and the Value field contains the projected value.
+	Projection projection.Projection
+	// BatchSize is the number of items fetched from a partition at once.
+	// Defaults to 100.
+	BatchSize int
+	// KeysOnly iterates only the keys of the map.
+	// If set, the Value field of the returned entries is nil.
+	// KeysOnly cannot be used together with Projection.
+	KeysOnly bool
+}
+
+func (o *MapIteratorOptions) Validate() error {
+	if o.BatchSize < 0 {
+		return ihzerrors.NewIllegalArgumentError("batch size must be non-negative", nil)
+	}
+	if o.BatchSize == 0 {
+		o.BatchSize = defaultMapIteratorBatchSize
+	}
+	if o.KeysOnly && !check.Nil(o.Projection) {
+		return ihzerrors.NewIllegalArgumentError("projection cannot be used with keys only iteration", nil)
+	}
+	return nil
+}
+
+type mapIterationMode int
+
+const (
+	mapIterationKeys mapIterationMode = iota
+	mapIterationEntries
+	mapIterationQueryKeys
+	mapIterationQueryEntries
+	mapIterationQueryProjection
+)
+
+/*
+MapIterator iterates the keys or entries of a Map partition by partition.
+
+The items are fetched from the members in batches, so the whole map is never kept in the memory of the client.
+Iteration tolerates partition migrations, but the entries which are added, updated or removed during the iteration may or may not be returned.
+MapIterator is not safe for concurrent use.
+*/
+type MapIterator struct {
+	m              *Map
+	predicate      iserialization.Data
+	projection     iserialization.Data
+	pointers       []proto.Pair
+	buffer         []types.Entry
+	mode           mapIterationMode
+	batchSize      int32
+	partitionID    int32
+	partitionCount int32
+}
+
+/*
+Iterator returns an iterator over the keys or entries of the map.
+
+The map is iterated partition by partition, fetching opts.BatchSize items at a time.
+If opts.Predicate or opts.Projection is set, they are applied on the member side.
+When a predicate is given without a projection, the keys and values are extracted on the member side, so they must be deserializable by the members.
+*/
+func (m *Map) Iterator(ctx context.Context, opts MapIteratorOptions) (*MapIterator, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	it := &MapIterator{
+		m:              m,
+		batchSize:      int32(opts.BatchSize),
+		partitionCount: m.partitionService.PartitionCount(),
+	}
+	var err error
+	hasPredicate := !check.Nil(opts.Predicate)
+	hasProjection := !check.Nil(opts.Projection) && !isIdentityProjection(opts.Projection)
+	switch {
+	case hasProjection:
+		it.mode = mapIterationQueryProjection
+		if it.projection, err = m.validateAndSerializeProjection(opts.Projection); err != nil {
+			return nil, err
+		}
+	case hasPredicate && opts.KeysOnly:
+		it.mode = mapIterationQueryKeys
+		if it.projection, err = m.validateAndSerialize(projection.Single("__key")); err != nil {
+			return nil, err
+		}
+	case hasPredicate:
+		it.mode = mapIterationQueryEntries
+		if it.projection, err = m.validateAndSerialize(projection.Multi("__key", "this")); err != nil {
+			return nil, err
+		}
+	case opts.KeysOnly:
+		it.mode = mapIterationKeys
+	default:
+		it.mode = mapIterationEntries
+	}
+	if it.mode >= mapIterationQueryKeys {
+		pred := opts.Predicate
+		if !hasPredicate {
+			pred = predicate.True()
+		}
+		if it.predicate, err = m.validateAndSerializePredicate(pred); err != nil {
+			return nil, err
+		}
+	}
+	it.resetPointers()
+	return it, nil
+}
+
+// Next returns the next item of the iteration.
+// It returns io.EOF when there are no more items.
+func (it *MapIterator) Next(ctx context.Context) (types.Entry, error) {
+	for len(it.buffer) == 0 {
+		if it.partitionID >= it.partitionCount {
+			return types.Entry{}, io.EOF
+		}
+		if err := it.fetch(ctx); err != nil {
+			return types.Entry{}, err
+		}
+	}
+	entry := it.buffer[0]
+	it.buffer = it.buffer[1:]
+	return entry, nil
+}
+
+func (it *MapIterator) fetch(ctx context.Context) error {
+	var request *proto.ClientMessage
+	switch it.mode {
+	case mapIterationKeys:
+		request = codec.EncodeMapFetchKeysRequest(it.m.name, it.pointers, it.batchSize)
+	case mapIterationEntries:
+		request = codec.EncodeMapFetchEntriesRequest(it.m.name, it.pointers, it.batchSize)
+	default:
+		request = codec.EncodeMapFetchWithQueryRequest(it.m.name, it.pointers, it.batchSize, it.projection, it.predicate)
+	}
+	response, err := it.m.invokeOnPartition(ctx, request, it.partitionID)
+	if err != nil {
+		return err
+	}
+	return it.handleFetchResponse(response)
+}
+
+func (it *MapIterator) handleFetchResponse(response *proto.ClientMessage) error {
+	var pointers []proto.Pair
+	var err error
+	switch it.mode {
+	case mapIterationKeys:
+		var keys []iserialization.Data
+		pointers, keys = codec.DecodeMapFetchKeysResponse(response)
+		err = it.bufferKeys(keys)
+	case mapIterationEntries:
+		var pairs []proto.Pair
+		pointers, pairs = codec.DecodeMapFetchEntriesResponse(response)
+		err = it.bufferEntries(pairs)
+	default:
+		var results []iserialization.Data
+		results, pointers = codec.DecodeMapFetchWithQueryResponse(response)
+		err = it.bufferResults(results)
+	}
+	if err != nil {
+		return err
+	}
+	it.pointers = pointers
+	// an iteration pointer is a pair of the index and the size of a table of the record store.
+	if len(pointers) == 0 || pointers[len(pointers)-1].Key.(int32) < 0 {
+		// the current partition is exhausted
+		it.partitionID++
+		it.resetPointers()
+	}
+	return nil
+}
+
+func (it *MapIterator) resetPointers() {
+	it.pointers = []proto.Pair{proto.NewPair(int32(math.MaxInt32), int32(-1))}
+}
+
+func (it *MapIterator) bufferKeys(keys []iserialization.Data) error {
+	for _, keyData := range keys {
+		key, err := it.m.convertToObject(keyData)
+		if err != nil {
+			return err
+		}
+		it.buffer = append(it.buffer, types.NewEntry(key, nil))
+	}
+	return nil
+}
+
+func (it *MapIterator) bufferEntries(pairs []proto.Pair) error {
+	entries, err := it.m.convertPairsToEntries(pairs)
+	if err != nil {
+		return err
+	}
+	it.buffer = append(it.buffer, entries...)
+	return nil
+}
+
+func (it *MapIterator) bufferResults(results []iserialization.Data) error {
+	for _, data := range results {
+		value, err := it.m.convertToObject(data)
+		if err != nil {
+			return err
+		}
+		switch it.mode {
+		case mapIterationQueryKeys:
+			it.buffer = append(it.buffer, types.NewEntry(value, nil))
+		case mapIterationQueryEntries:
+			kv, ok := value.([]interface{})
+			if !ok || len(kv) != 2 {
+				return ihzerrors.NewClientError(fmt.Sprintf("unexpected map iteration result: %v", value), nil, nil)
+			}
+			it.buffer = append(it.buffer, types.NewEntry(kv[0], kv[1]))
+		default:
+			it.buffer = append(it.buffer, types.NewEntry(nil, value))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+)
+
+func TestMapIterator_HandleFetchResponse(t *testing.T) {
+	it := hz.NewMapKeysIterator(2)
+	// the pointer of a partition which has more items
+	require.NoError(t, it.HandleFetchResponse(mapFetchKeysResponse(proto.NewPair(int32(5), int32(10)))))
+	assert.Equal(t, int32(0), it.PartitionID())
+	assert.Equal(t, []proto.Pair{proto.NewPair(int32(5), int32(10))}, it.Pointers())
+	// the pointer of an exhausted partition
+	require.NoError(t, it.HandleFetchResponse(mapFetchKeysResponse(proto.NewPair(int32(-1), int32(10)))))
+	assert.Equal(t, int32(1), it.PartitionID())
+	assert.Equal(t, []proto.Pair{proto.NewPair(int32(math.MaxInt32), int32(-1))}, it.Pointers())
+}
+
+func mapFetchKeysResponse(pointers ...proto.Pair) *proto.ClientMessage {
+	msg := proto.NewClientMessageForEncode()
+	msg.AddFrame(proto.NewFrame(make([]byte, proto.ResponseBackupAcksOffset+proto.ByteSizeInBytes)))
+	codec.EncodeEntryListIntegerInteger(msg, pointers)
+	codec.EncodeListMultiFrameForData(msg, nil)
+	return msg
+}
@@ -0,0 +1,471 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/cb"
+	"github.com/hazelcast/hazelcast-go-client/internal/check"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	defaultMapStoreWriteDelay        = types.Duration(time.Second)
+	defaultMapStoreWriteRetryBackoff = types.Duration(100 * time.Millisecond)
+	defaultMapStoreWriteBatchSize    = 100
+	defaultMapStoreWriteRetryCount   = 3
+	maxMapStoreWriteRetryBackoff     = 30 * time.Second
+	// a batch which could not be written after retries counts as a failure.
+	// the writer is not called for mapStoreResetTimeout after mapStoreMaxFailureCount failures.
+	mapStoreMaxFailureCount = 3
+	mapStoreResetTimeout    = 10 * time.Second
+)
+
+// MapLoader loads the values of the keys which are missing from a Map.
+type MapLoader interface {
+	// Load returns the value for the given key.
+	// It should return nil if the key does not exist.
+	Load(ctx context.Context, key interface{}) (interface{}, error)
+}
+
+// MapWriter writes the entries of a Map to an external store.
+// StoreAll and DeleteAll are called in the order of the writes to the MapStore.
+type MapWriter interface {
+	// StoreAll stores the given entries.
+	// StoreAll must be idempotent, since failed writes are retried.
+	StoreAll(ctx context.Context, entries []types.Entry) error
+	// DeleteAll deletes the entries with the given keys.
+	// DeleteAll must be idempotent, since failed writes are retried.
+	DeleteAll(ctx context.Context, keys []interface{}) error
+}
+
+// MapStoreConfig contains configuration for a MapStore.
+type MapStoreConfig struct {
+	// WriteDelay is the duration a write is held in the queue before it is passed to the writer.
+	// It is ignored if WriteThrough is true.
+	// Defaults to 1 second.
+	WriteDelay types.Duration `json:",omitempty"`
+	// WriteRetryBackoff is the delay before the first retry of a failed write.
+	// The delay is doubled for each retry, up to 30 seconds.
+	// Defaults to 100 milliseconds.
+	WriteRetryBackoff types.Duration `json:",omitempty"`
+	// WriteBatchSize is the maximum number of entries passed to the writer at once.
+	// Defaults to 100.
+	WriteBatchSize int `json:",omitempty"`
+	// WriteRetryCount is the number of times a failed write is retried.
+	// Defaults to 3.
+	WriteRetryCount int `json:",omitempty"`
+	// DisableWriteCoalescing disables merging the queued writes to the same key.
+	// If coalescing is enabled, only the latest value of a key is passed to the writer.
+	// Coalescing is enabled by default.
+	DisableWriteCoalescing bool `json:",omitempty"`
+	// WriteThrough enables passing the writes to the writer immediately, before Set, Put, Delete and Remove return.
+	// A write which fails after retries is returned as an error, and it stays in the queue to be retried in the background.
+	WriteThrough bool `json:",omitempty"`
+}
+
+// Validate validates the configuration and adds the defaults.
+func (c *MapStoreConfig) Validate() error {
+	if err := check.EnsureNonNegativeDuration((*time.Duration)(&c.WriteDelay), time.Duration(defaultMapStoreWriteDelay), "invalid write delay"); err != nil {
+		return err
+	}
+	if err := check.EnsureNonNegativeDuration((*time.Duration)(&c.WriteRetryBackoff), time.Duration(defaultMapStoreWriteRetryBackoff), "invalid write retry backoff"); err != nil {
+		return err
+	}
+	if c.WriteBatchSize == 0 {
+		c.WriteBatchSize = defaultMapStoreWriteBatchSize
+	} else if c.WriteBatchSize < 0 {
+		return ihzerrors.NewInvalidConfigurationError("invalid write batch size", nil)
+	}
+	if c.WriteRetryCount == 0 {
+		c.WriteRetryCount = defaultMapStoreWriteRetryCount
+	} else if c.WriteRetryCount < 0 {
+		return ihzerrors.NewInvalidConfigurationError("invalid write retry count", nil)
+	}
+	return nil
+}
+
+/*
+MapStore is a read-through, write-behind adapter around a Map, which keeps the map in sync with an external store.
+
+Get loads the values missing from the map using the MapLoader and puts them to the map.
+Loaded values are not passed to the MapWriter.
+
+Set, Put, Delete and Remove update the map immediately and queue the write for the MapWriter.
+Queued writes are passed to the writer in batches, after they are held in the queue for MapStoreConfig.WriteDelay.
+Stores and deletes are passed to the writer in the order they are queued.
+If MapStoreConfig.WriteThrough is true, the writes are passed to the writer immediately instead.
+Failed writes are retried with exponential backoff.
+If the writes keep failing, the writer is not called for a while and the writes stay in the queue.
+
+Flush writes all queued entries immediately.
+Close stops the adapter and writes the remaining entries.
+The adapter does not close the underlying map.
+*/
+type MapStore struct {
+	m       *Map
+	loader  MapLoader
+	writer  MapWriter
+	cb      *cb.CircuitBreaker
+	queueMu *sync.Mutex
+	// writeMu serializes writes, so the writer receives the entries in order.
+	writeMu *sync.Mutex
+	// pending contains the queued entries by serialized key, only when coalescing is enabled.
+	pending map[string]*mapStoreEntry
+	// latest contains the latest entry which is not written yet by serialized key, it may be queued or being written.
+	latest map[string]*mapStoreEntry
+	cancel context.CancelFunc
+	wg     *sync.WaitGroup
+	queue  []*mapStoreEntry
+	config MapStoreConfig
+	closed int32
+}
+
+type mapStoreEntry struct {
+	storeTime time.Time
+	key       interface{}
+	value     interface{}
+	keyData   string
+	// deleted is true if the entry is deleted from the store, instead of stored.
+	deleted bool
+}
+
+// NewMapStore creates a MapStore for the given map and starts writing the queued entries in the background.
+func NewMapStore(m *Map, loader MapLoader, writer MapWriter, config MapStoreConfig) (*MapStore, error) {
+	if m == nil || loader == nil || writer == nil {
+		return nil, ihzerrors.NewIllegalArgumentError("map, loader and writer must not be nil", nil)
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	backoff := time.Duration(config.WriteRetryBackoff)
+	ctx, cancel := context.WithCancel(context.Background())
+	ms := &MapStore{
+		m:      m,
+		loader: loader,
+		writer: writer,
+		cb: cb.NewCircuitBreaker(
+			cb.MaxRetries(config.WriteRetryCount),
+			cb.MaxFailureCount(mapStoreMaxFailureCount),
+			cb.ResetTimeout(mapStoreResetTimeout),
+			cb.RetryPolicy(cb.ExponentialBackoff(backoff, maxMapStoreWriteRetryBackoff, 0, nil))),
+		queueMu: &sync.Mutex{},
+		writeMu: &sync.Mutex{},
+		pending: map[string]*mapStoreEntry{},
+		latest:  map[string]*mapStoreEntry{},
+		cancel:  cancel,
+		wg:      &sync.WaitGroup{},
+		config:  config,
+	}
+	ms.wg.Add(1)
+	go ms.writeBehind(ctx)
+	return ms, nil
+}
+
+// Close stops writing in the background and writes the remaining queued entries.
+// The entries which could not be written are dropped.
+func (ms *MapStore) Close(ctx context.Context) error {
+	if !atomic.CompareAndSwapInt32(&ms.closed, 0, 1) {
+		return nil
+	}
+	// cancel the background write in progress, the remaining entries are written below
+	ms.cancel()
+	ms.wg.Wait()
+	err := ms.Flush(ctx)
+	ms.queueMu.Lock()
+	ms.queue = nil
+	ms.pending = map[string]*mapStoreEntry{}
+	ms.latest = map[string]*mapStoreEntry{}
+	ms.queueMu.Unlock()
+	return err
+}
+
+// Flush writes all queued entries immediately, regardless of the write delay.
+// The entries which could not be written stay in the queue.
+func (ms *MapStore) Flush(ctx context.Context) error {
+	return ms.writeQueued(ctx, time.Time{})
+}
+
+// Delete deletes the given key from the map and queues the delete.
+func (ms *MapStore) Delete(ctx context.Context, key interface{}) error {
+	if err := ms.checkOpen(); err != nil {
+		return err
+	}
+	if err := ms.m.Delete(ctx, key); err != nil {
+		return err
+	}
+	return ms.enqueue(ctx, key, nil, true)
+}
+
+// Get returns the value for the given key.
+// If the key does not exist in the map, the value is loaded using the MapLoader and put to the map.
+// The value is not loaded if the key was deleted, but the delete is not written yet.
+// Returns nil if the key does not exist in the map and the loader.
+func (ms *MapStore) Get(ctx context.Context, key interface{}) (interface{}, error) {
+	value, err := ms.m.Get(ctx, key)
+	if err != nil || value != nil {
+		return value, err
+	}
+	keyData, err := ms.m.convertToData(key)
+	if err != nil {
+		return nil, err
+	}
+	if ms.deletePending(string(keyData)) {
+		// the store still has the deleted value
+		return nil, nil
+	}
+	value, err = ms.loader.Load(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("loading key: %w", err)
+	}
+	if value == nil {
+		return nil, nil
+	}
+	// do not overwrite the value if it was set while loading.
+	existing, err := ms.m.PutIfAbsent(ctx, key, value)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return existing, nil
+	}
+	return value, nil
+}
+
+// Map returns the underlying map.
+func (ms *MapStore) Map() *Map {
+	return ms.m
+}
+
+// Put sets the value for the given key, queues the write and returns the old value.
+func (ms *MapStore) Put(ctx context.Context, key interface{}, value interface{}) (interface{}, error) {
+	if err := ms.checkOpen(); err != nil {
+		return nil, err
+	}
+	old, err := ms.m.Put(ctx, key, value)
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.enqueue(ctx, key, value, false); err != nil {
+		return nil, err
+	}
+	return old, nil
+}
+
+// QueueSize returns the number of entries waiting to be written.
+func (ms *MapStore) QueueSize() int {
+	ms.queueMu.Lock()
+	defer ms.queueMu.Unlock()
+	return len(ms.queue)
+}
+
+// Remove deletes the given key from the map, queues the delete and returns the old value.
+func (ms *MapStore) Remove(ctx context.Context, key interface{}) (interface{}, error) {
+	if err := ms.checkOpen(); err != nil {
+		return nil, err
+	}
+	old, err := ms.m.Remove(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.enqueue(ctx, key, nil, true); err != nil {
+		return nil, err
+	}
+	return old, nil
+}
+
+// Set sets the value for the given key and queues the write.
+func (ms *MapStore) Set(ctx context.Context, key interface{}, value interface{}) error {
+	if err := ms.checkOpen(); err != nil {
+		return err
+	}
+	if err := ms.m.Set(ctx, key, value); err != nil {
+		return err
+	}
+	return ms.enqueue(ctx, key, value, false)
+}
+
+func (ms *MapStore) checkOpen() error {
+	if atomic.LoadInt32(&ms.closed) == 1 {
+		return ihzerrors.NewIllegalStateError("map store is closed", nil)
+	}
+	return nil
+}
+
+// enqueue queues the store or the delete of the given key.
+// If write-through is enabled, the queued writes are passed to the writer before returning.
+func (ms *MapStore) enqueue(ctx context.Context, key interface{}, value interface{}, deleted bool) error {
+	keyData, err := ms.m.convertToData(key)
+	if err != nil {
+		return err
+	}
+	ms.queueEntry(&mapStoreEntry{key: key, value: value, keyData: string(keyData), deleted: deleted, storeTime: time.Now()})
+	if ms.config.WriteThrough {
+		return ms.Flush(ctx)
+	}
+	return nil
+}
+
+func (ms *MapStore) queueEntry(e *mapStoreEntry) {
+	ms.queueMu.Lock()
+	defer ms.queueMu.Unlock()
+	if !ms.config.DisableWriteCoalescing {
+		if existing, ok := ms.pending[e.keyData]; ok {
+			// keep the store time of the first write, so frequently updated keys are written eventually.
+			existing.value = e.value
+			existing.deleted = e.deleted
+			return
+		}
+		ms.pending[e.keyData] = e
+	}
+	ms.latest[e.keyData] = e
+	ms.queue = append(ms.queue, e)
+}
+
+// deletePending returns true if the latest write of the given key which is not written yet is a delete.
+func (ms *MapStore) deletePending(keyData string) bool {
+	ms.queueMu.Lock()
+	defer ms.queueMu.Unlock()
+	e, ok := ms.latest[keyData]
+	return ok && e.deleted
+}
+
+func (ms *MapStore) writeBehind(ctx context.Context) {
+	defer ms.wg.Done()
+	interval := time.Duration(ms.config.WriteDelay)
+	if interval > time.Second || interval == 0 {
+		interval = time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// only the failed writes are queued with write-through, they are retried without a delay
+			var before time.Time
+			if !ms.config.WriteThrough {
+				before = time.Now().Add(-time.Duration(ms.config.WriteDelay))
+			}
+			if err := ms.writeQueued(ctx, before); err != nil && ctx.Err() == nil {
+				ms.m.logger.Warnf("map store %s: writing entries: %s", ms.m.name, err.Error())
+			}
+		}
+	}
+}
+
+// writeQueued writes the entries queued before the given time in batches.
+// The zero time writes all entries.
+func (ms *MapStore) writeQueued(ctx context.Context, before time.Time) error {
+	ms.writeMu.Lock()
+	defer ms.writeMu.Unlock()
+	for {
+		batch := ms.takeBatch(before)
+		if len(batch) == 0 {
+			return nil
+		}
+		_, err := ms.cb.TryContext(ctx, func(ctx context.Context, attempt int) (interface{}, error) {
+			return nil, ms.writeBatch(ctx, batch)
+		})
+		if err != nil {
+			ms.requeue(batch)
+			return err
+		}
+		ms.written(batch)
+	}
+}
+
+// writeBatch passes the batch to the writer, the entries of the batch are either all stores or all deletes.
+func (ms *MapStore) writeBatch(ctx context.Context, batch []*mapStoreEntry) error {
+	if batch[0].deleted {
+		keys := make([]interface{}, len(batch))
+		for i, e := range batch {
+			keys[i] = e.key
+		}
+		return ms.writer.DeleteAll(ctx, keys)
+	}
+	entries := make([]types.Entry, len(batch))
+	for i, e := range batch {
+		entries[i] = types.Entry{Key: e.key, Value: e.value}
+	}
+	return ms.writer.StoreAll(ctx, entries)
+}
+
+// written removes the entries of the written batch from the latest entries, unless the keys were written again meanwhile.
+func (ms *MapStore) written(batch []*mapStoreEntry) {
+	ms.queueMu.Lock()
+	defer ms.queueMu.Unlock()
+	for _, e := range batch {
+		if ms.latest[e.keyData] == e {
+			delete(ms.latest, e.keyData)
+		}
+	}
+}
+
+// takeBatch removes at most WriteBatchSize entries queued before the given time from the queue.
+// The batch contains either consecutive stores or consecutive deletes, so the writes are passed to the writer in order.
+func (ms *MapStore) takeBatch(before time.Time) []*mapStoreEntry {
+	ms.queueMu.Lock()
+	defer ms.queueMu.Unlock()
+	n := 0
+	for n < len(ms.queue) && n < ms.config.WriteBatchSize {
+		if !before.IsZero() && ms.queue[n].storeTime.After(before) {
+			break
+		}
+		if ms.queue[n].deleted != ms.queue[0].deleted {
+			break
+		}
+		n++
+	}
+	if n == 0 {
+		return nil
+	}
+	batch := make([]*mapStoreEntry, n)
+	copy(batch, ms.queue[:n])
+	ms.queue = ms.queue[n:]
+	if !ms.config.DisableWriteCoalescing {
+		for _, e := range batch {
+			delete(ms.pending, e.keyData)
+		}
+	}
+	return batch
+}
+
+// requeue puts the entries of a failed batch back to the front of the queue.
+// Entries which were written again meanwhile are dropped, since the newer value is already queued.
+func (ms *MapStore) requeue(batch []*mapStoreEntry) {
+	ms.queueMu.Lock()
+	defer ms.queueMu.Unlock()
+	requeued := make([]*mapStoreEntry, 0, len(batch)+len(ms.queue))
+	for _, e := range batch {
+		if !ms.config.DisableWriteCoalescing {
+			if _, ok := ms.pending[e.keyData]; ok {
+				continue
+			}
+			ms.pending[e.keyData] = e
+		}
+		requeued = append(requeued, e)
+	}
+	ms.queue = append(requeued, ms.queue...)
+}
@@ -0,0 +1,243 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+func TestMapStore_GetLoadsMissingKey(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		store := &testMapStoreBackend{values: map[interface{}]interface{}{"k1": "loaded"}}
+		ms, err := hz.NewMapStore(m, store, store, hz.MapStoreConfig{})
+		require.NoError(t, err)
+		defer ms.Close(ctx)
+		value, err := ms.Get(ctx, "k1")
+		require.NoError(t, err)
+		require.Equal(t, "loaded", value)
+		it.AssertEquals(t, "loaded", it.MustValue(m.Get(ctx, "k1")))
+		value, err = ms.Get(ctx, "k2")
+		require.NoError(t, err)
+		require.Nil(t, value)
+		// loaded values are not written back
+		require.NoError(t, ms.Flush(ctx))
+		require.Equal(t, 0, store.writeCount())
+	})
+}
+
+func TestMapStore_WriteBehindCoalescing(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		store := &testMapStoreBackend{values: map[interface{}]interface{}{}}
+		ms, err := hz.NewMapStore(m, store, store, hz.MapStoreConfig{WriteDelay: types.Duration(time.Hour)})
+		require.NoError(t, err)
+		defer ms.Close(ctx)
+		require.NoError(t, ms.Set(ctx, "k1", "v1"))
+		old, err := ms.Put(ctx, "k1", "v2")
+		require.NoError(t, err)
+		require.Equal(t, "v1", old)
+		require.NoError(t, ms.Set(ctx, "k2", "v3"))
+		require.Equal(t, 2, ms.QueueSize())
+		require.Equal(t, 0, store.writeCount())
+		require.NoError(t, ms.Flush(ctx))
+		require.Equal(t, 0, ms.QueueSize())
+		require.Equal(t, map[interface{}]interface{}{"k1": "v2", "k2": "v3"}, store.snapshot())
+		require.Equal(t, 2, store.writeCount())
+	})
+}
+
+func TestMapStore_WriteBehindDelay(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		store := &testMapStoreBackend{values: map[interface{}]interface{}{}}
+		config := hz.MapStoreConfig{WriteDelay: types.Duration(100 * time.Millisecond), WriteBatchSize: 1}
+		ms, err := hz.NewMapStore(m, store, store, config)
+		require.NoError(t, err)
+		defer ms.Close(ctx)
+		require.NoError(t, ms.Set(ctx, "k1", "v1"))
+		require.NoError(t, ms.Set(ctx, "k2", "v2"))
+		it.Eventually(t, func() bool {
+			return store.writeCount() == 2
+		})
+		require.Equal(t, map[interface{}]interface{}{"k1": "v1", "k2": "v2"}, store.snapshot())
+	})
+}
+
+func TestMapStore_WriteRetry(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		store := &testMapStoreBackend{values: map[interface{}]interface{}{}, failures: 2}
+		config := hz.MapStoreConfig{WriteDelay: types.Duration(time.Hour), WriteRetryBackoff: types.Duration(time.Millisecond)}
+		ms, err := hz.NewMapStore(m, store, store, config)
+		require.NoError(t, err)
+		require.NoError(t, ms.Set(ctx, "k1", "v1"))
+		require.NoError(t, ms.Close(ctx))
+		require.Equal(t, map[interface{}]interface{}{"k1": "v1"}, store.snapshot())
+		require.Error(t, ms.Set(ctx, "k1", "v2"))
+	})
+}
+
+func TestMapStore_WriteFailure(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		store := &testMapStoreBackend{values: map[interface{}]interface{}{}, failures: 100}
+		config := hz.MapStoreConfig{WriteDelay: types.Duration(time.Hour), WriteRetryBackoff: types.Duration(time.Millisecond), WriteRetryCount: 1}
+		ms, err := hz.NewMapStore(m, store, store, config)
+		require.NoError(t, err)
+		defer ms.Close(ctx)
+		require.NoError(t, ms.Set(ctx, "k1", "v1"))
+		require.Error(t, ms.Flush(ctx))
+		// failed entries stay in the queue
+		require.Equal(t, 1, ms.QueueSize())
+	})
+}
+
+func TestMapStore_DeleteInOrder(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		store := &testMapStoreBackend{values: map[interface{}]interface{}{"k1": "stored"}}
+		config := hz.MapStoreConfig{WriteDelay: types.Duration(time.Hour), DisableWriteCoalescing: true}
+		ms, err := hz.NewMapStore(m, store, store, config)
+		require.NoError(t, err)
+		defer ms.Close(ctx)
+		require.NoError(t, ms.Set(ctx, "k1", "v1"))
+		require.NoError(t, ms.Delete(ctx, "k1"))
+		require.NoError(t, ms.Set(ctx, "k2", "v2"))
+		old, err := ms.Remove(ctx, "k2")
+		require.NoError(t, err)
+		require.Equal(t, "v2", old)
+		require.Equal(t, 4, ms.QueueSize())
+		// the delete is not written yet, so the deleted value is not loaded from the store
+		value, err := ms.Get(ctx, "k1")
+		require.NoError(t, err)
+		require.Nil(t, value)
+		require.NoError(t, ms.Flush(ctx))
+		require.Equal(t, []string{"store k1", "delete k1", "store k2", "delete k2"}, store.operations())
+		require.Equal(t, map[interface{}]interface{}{}, store.snapshot())
+	})
+}
+
+func TestMapStore_DeleteCoalescing(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		store := &testMapStoreBackend{values: map[interface{}]interface{}{"k1": "stored"}}
+		ms, err := hz.NewMapStore(m, store, store, hz.MapStoreConfig{WriteDelay: types.Duration(time.Hour)})
+		require.NoError(t, err)
+		defer ms.Close(ctx)
+		require.NoError(t, ms.Set(ctx, "k1", "v1"))
+		require.NoError(t, ms.Delete(ctx, "k1"))
+		require.Equal(t, 1, ms.QueueSize())
+		require.NoError(t, ms.Flush(ctx))
+		require.Equal(t, []string{"delete k1"}, store.operations())
+		require.Equal(t, map[interface{}]interface{}{}, store.snapshot())
+	})
+}
+
+func TestMapStore_WriteThrough(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		store := &testMapStoreBackend{values: map[interface{}]interface{}{}}
+		ms, err := hz.NewMapStore(m, store, store, hz.MapStoreConfig{WriteThrough: true})
+		require.NoError(t, err)
+		defer ms.Close(ctx)
+		require.NoError(t, ms.Set(ctx, "k1", "v1"))
+		require.Equal(t, map[interface{}]interface{}{"k1": "v1"}, store.snapshot())
+		require.NoError(t, ms.Delete(ctx, "k1"))
+		require.Equal(t, map[interface{}]interface{}{}, store.snapshot())
+		require.Equal(t, 0, ms.QueueSize())
+	})
+}
+
+var errTestMapStoreWrite = errors.New("write failed")
+
+type testMapStoreBackend struct {
+	values   map[interface{}]interface{}
+	ops      []string
+	mu       sync.Mutex
+	writes   int
+	failures int
+}
+
+func (b *testMapStoreBackend) Load(ctx context.Context, key interface{}) (interface{}, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.values[key], nil
+}
+
+func (b *testMapStoreBackend) StoreAll(ctx context.Context, entries []types.Entry) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failures > 0 {
+		b.failures--
+		return errTestMapStoreWrite
+	}
+	for _, e := range entries {
+		b.values[e.Key] = e.Value
+		b.ops = append(b.ops, fmt.Sprintf("store %v", e.Key))
+		b.writes++
+	}
+	return nil
+}
+
+func (b *testMapStoreBackend) DeleteAll(ctx context.Context, keys []interface{}) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failures > 0 {
+		b.failures--
+		return errTestMapStoreWrite
+	}
+	for _, key := range keys {
+		delete(b.values, key)
+		b.ops = append(b.ops, fmt.Sprintf("delete %v", key))
+		b.writes++
+	}
+	return nil
+}
+
+func (b *testMapStoreBackend) operations() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]string(nil), b.ops...)
+}
+
+func (b *testMapStoreBackend) writeCount() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.writes
+}
+
+func (b *testMapStoreBackend) snapshot() map[interface{}]interface{} {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	cp := make(map[interface{}]interface{}, len(b.values))
+	for k, v := range b.values {
+		cp[k] = v
+	}
+	return cp
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+func TestMapStoreConfig_Validate(t *testing.T) {
+	var cfg hz.MapStoreConfig
+	require.NoError(t, cfg.Validate())
+	target := hz.MapStoreConfig{
+		WriteDelay:        types.Duration(time.Second),
+		WriteRetryBackoff: types.Duration(100 * time.Millisecond),
+		WriteBatchSize:    100,
+		WriteRetryCount:   3,
+	}
+	assert.Equal(t, target, cfg)
+	// writes are passed to the writer immediately with write-through
+	cfg = hz.MapStoreConfig{WriteThrough: true}
+	require.NoError(t, cfg.Validate())
+	assert.True(t, cfg.WriteThrough)
+}
+
+func TestMapStoreConfig_Validate_Invalid(t *testing.T) {
+	testCases := []struct {
+		name string
+		cfg  hz.MapStoreConfig
+	}{
+		{name: "negative write delay", cfg: hz.MapStoreConfig{WriteDelay: types.Duration(-time.Second)}},
+		{name: "negative retry backoff", cfg: hz.MapStoreConfig{WriteRetryBackoff: types.Duration(-time.Second)}},
+		{name: "negative batch size", cfg: hz.MapStoreConfig{WriteBatchSize: -1}},
+		{name: "negative retry count", cfg: hz.MapStoreConfig{WriteRetryCount: -1}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Error(t, tc.cfg.Validate())
+		})
+	}
+}
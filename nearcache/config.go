@@ -38,6 +38,7 @@ type Config struct {
 	// Eviction is the optional eviction configuration for the Near Cache.
 	Eviction           EvictionConfig
 	invalidateOnChange *bool
+	preloader          *PreloaderConfig
 	// Name is the name of this Near Cache configuration.
 	// If the name is not specified, it is set to "default".
 	Name string
@@ -69,6 +70,7 @@ type Config struct {
 func (c Config) Clone() Config {
 	return Config{
 		invalidateOnChange: c.invalidateOnChange,
+		preloader:          c.preloader.clone(),
 		Name:               c.Name,
 		Eviction:           c.Eviction.Clone(),
 		InMemoryFormat:     c.InMemoryFormat,
@@ -101,6 +103,11 @@ func (c *Config) Validate() error {
 	if c.InMemoryFormat != InMemoryFormatBinary && c.InMemoryFormat != InMemoryFormatObject {
 		return ihzerrors.NewInvalidConfigurationError("nearcache.Config: InMemoryFormat: invalid memory format", nil)
 	}
+	if c.preloader != nil {
+		if err := c.preloader.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -124,6 +131,25 @@ func (c Config) InvalidateOnChange() bool {
 	return *c.invalidateOnChange
 }
 
+/*
+SetPreloader sets the preloader configuration.
+The preloader periodically saves the keys in the Near Cache to a file.
+When the map is retrieved for the first time, the saved keys are loaded to the Near Cache, so the Near Cache is warm right after the client starts.
+The preloader is supported only for maps and it is disabled by default.
+*/
+func (c *Config) SetPreloader(cfg PreloaderConfig) {
+	c.preloader = &cfg
+}
+
+// Preloader returns the preloader configuration.
+// See the documentation for SetPreloader.
+func (c Config) Preloader() PreloaderConfig {
+	if c.preloader == nil {
+		return PreloaderConfig{}
+	}
+	return *c.preloader
+}
+
 func (c *Config) UnmarshalJSON(b []byte) error {
 	var cfg configForMarshal
 	if err := json.Unmarshal(b, &cfg); err != nil {
@@ -140,7 +166,8 @@ func (c Config) MarshalJSON() ([]byte, error) {
 
 type configForMarshal struct {
 	Eviction           EvictionConfig
-	InvalidateOnChange *bool `json:",omitempty"`
+	InvalidateOnChange *bool            `json:",omitempty"`
+	Preloader          *PreloaderConfig `json:",omitempty"`
 	Name               string
 	TimeToLiveSeconds  int
 	MaxIdleSeconds     int
@@ -148,6 +175,50 @@ type configForMarshal struct {
 	InMemoryFormat     InMemoryFormat
 }
 
+// PreloaderConfig is the configuration for the Near Cache preloader.
+type PreloaderConfig struct {
+	// Directory is the directory to store the key files in.
+	// It is created if it does not exist.
+	// The directory must not be shared by clients which have Near Caches with the same name.
+	// The default is the current working directory.
+	Directory string `json:",omitempty"`
+	// StoreInitialDelaySeconds is the number of seconds before the keys are stored for the first time.
+	// Must be non-negative.
+	// The default is 600.
+	StoreInitialDelaySeconds int `json:",omitempty"`
+	// StoreIntervalSeconds is the number of seconds between storing the keys.
+	// Must be non-negative.
+	// The default is 600.
+	StoreIntervalSeconds int `json:",omitempty"`
+	// Enabled enables the preloader.
+	Enabled bool `json:",omitempty"`
+}
+
+// Validate validates the configuration and replaces missing configuration with defaults.
+func (c *PreloaderConfig) Validate() error {
+	if c.StoreInitialDelaySeconds == 0 {
+		c.StoreInitialDelaySeconds = defaultStoreInitialDelaySeconds
+	}
+	if c.StoreIntervalSeconds == 0 {
+		c.StoreIntervalSeconds = defaultStoreIntervalSeconds
+	}
+	if err := check.NonNegativeInt32Config(c.StoreInitialDelaySeconds); err != nil {
+		return fmt.Errorf("nearcache.PreloaderConfig: StoreInitialDelaySeconds: %w", err)
+	}
+	if err := check.NonNegativeInt32Config(c.StoreIntervalSeconds); err != nil {
+		return fmt.Errorf("nearcache.PreloaderConfig: StoreIntervalSeconds: %w", err)
+	}
+	return nil
+}
+
+func (c *PreloaderConfig) clone() *PreloaderConfig {
+	if c == nil {
+		return nil
+	}
+	cp := *c
+	return &cp
+}
+
 /*
 EvictionConfig is the configuration for eviction.
 
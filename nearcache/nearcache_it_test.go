@@ -86,6 +86,47 @@ func TestSmokeNearCachePopulation(t *testing.T) {
 	require.Equal(t, mapSize, nca.Size())
 }
 
+func TestNearCachePreloader(t *testing.T) {
+	const memberCount = 1
+	clusterName := t.Name()
+	mapName := it.NewUniqueObjectName("map")
+	port := it.NextPort()
+	cls := it.StartNewClusterWithConfig(memberCount, smokeXMLConfig(clusterName, port), port)
+	defer cls.Shutdown()
+	const mapSize = 100
+	for i := 0; i < mapSize; i++ {
+		v := strconv.Itoa(i)
+		it.MapSetOnServer(cls.ClusterID, mapName, v, v)
+	}
+	ctx := context.Background()
+	ncc := nearcache.Config{Name: mapName}
+	ncc.SetPreloader(nearcache.PreloaderConfig{
+		Enabled:                  true,
+		Directory:                t.TempDir(),
+		StoreInitialDelaySeconds: 1,
+		StoreIntervalSeconds:     1,
+	})
+	cfg := cls.DefaultConfigWithNoSSL()
+	cfg.AddNearCache(ncc)
+	// the first client populates the Near Cache and stores the keys
+	client := it.MustClient(hz.StartNewClientWithConfig(nil, cfg))
+	m := it.MustValue(client.GetMap(ctx, mapName)).(*hz.Map)
+	for i := 0; i < mapSize; i++ {
+		it.MustValue(m.Get(ctx, strconv.Itoa(i)))
+	}
+	it.Eventually(t, func() bool {
+		stats := m.LocalMapStats().NearCacheStats
+		return stats.PersistenceCount > 0 && stats.LastPersistenceKeyCount == mapSize
+	})
+	require.NoError(t, client.Shutdown(ctx))
+	// the second client loads the stored keys before returning the map
+	client = it.MustClient(hz.StartNewClientWithConfig(nil, cfg))
+	defer client.Shutdown(ctx)
+	m = it.MustValue(client.GetMap(ctx, mapName)).(*hz.Map)
+	nca := hz.MakeNearCacheAdapterFromMap(m).(it.NearCacheAdapter)
+	require.Equal(t, mapSize, nca.Size())
+}
+
 func TestGetAllChecksNearCacheFirst(t *testing.T) {
 	// port of: com.hazelcast.client.map.impl.nearcache.ClientMapNearCacheTest#testGetAllChecksNearCacheFirst
 	tcx := newNearCacheMapTestContext(t, nearcache.InMemoryFormatObject, false)
@@ -0,0 +1,192 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/cluster"
+	"github.com/hazelcast/hazelcast-go-client/internal/logger"
+	inearcache "github.com/hazelcast/hazelcast-go-client/internal/nearcache"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+type nearCacheCache struct {
+	nc                     *inearcache.NearCache
+	toNearCacheKey         func(key interface{}) (interface{}, error)
+	ss                     *serialization.Service
+	rt                     *inearcache.ReparingTask
+	lb                     *cluster.ConnectionListenerBinder
+	lg                     logger.LogAdaptor
+	invalidationListenerID atomic.Value
+}
+
+func newNearCacheCache(ctx context.Context, nc *inearcache.NearCache, ss *serialization.Service, rt *inearcache.ReparingTask, lg logger.LogAdaptor, name string, lb *cluster.ConnectionListenerBinder, local bool) (*nearCacheCache, error) {
+	ncc := &nearCacheCache{
+		nc: nc,
+		ss: ss,
+		rt: rt,
+		lb: lb,
+		lg: lg,
+	}
+	cfg := nc.Config()
+	if cfg.InvalidateOnChange() {
+		lg.Debug(func() string {
+			return fmt.Sprintf("registering invalidation listener: name: %s, local: %t", name, local)
+		})
+		if err := ncc.registerInvalidationListener(ctx, name, local); err != nil {
+			lg.Errorf("hazelcast.newNearCacheCache: registering invalidation handler: %w", err)
+		}
+	}
+	// toNearCacheKey returns the raw key if SerializeKeys is not true.
+	if cfg.SerializeKeys {
+		ncc.toNearCacheKey = func(key interface{}) (interface{}, error) {
+			return ss.ToData(key)
+		}
+	} else {
+		ncc.toNearCacheKey = func(key interface{}) (interface{}, error) {
+			return key, nil
+		}
+	}
+	return ncc, nil
+}
+
+func (ncc *nearCacheCache) Destroy(ctx context.Context, name string) error {
+	ncc.lg.Trace(func() string {
+		return fmt.Sprintf("hazelcast.nearCacheCache.Destroy: %s", name)
+	})
+	s := ncc.invalidationListenerID.Load()
+	if s == nil {
+		return nil
+	}
+	ncc.rt.DeregisterHandler(name)
+	return ncc.lb.Remove(ctx, s.(types.UUID))
+}
+
+func (ncc *nearCacheCache) registerInvalidationListener(ctx context.Context, name string, local bool) error {
+	// port of: com.hazelcast.client.cache.impl.NearCachedClientCacheProxy#registerInvalidationListener
+	addMsg := codec.EncodeCacheAddNearCacheInvalidationListenerRequest(name, local)
+	rth, err := ncc.rt.RegisterAndGetHandler(ctx, name, ncc.nc)
+	if err != nil {
+		return fmt.Errorf("nearCacheCache.registerInvalidationListener: %w", err)
+	}
+	handler := func(msg *proto.ClientMessage) {
+		codec.HandleCacheAddNearCacheInvalidationListener(msg,
+			func(name string, key serialization.Data, source types.UUID, partition types.UUID, seq int64) {
+				if err := rth.Handle(key, source, partition, seq); err != nil {
+					ncc.lg.Errorf("handling invalidation message: %w", err)
+				}
+			},
+			func(name string, keys []serialization.Data, sources []types.UUID, partitions []types.UUID, seqs []int64) {
+				if err := rth.HandleBatch(keys, sources, partitions, seqs); err != nil {
+					ncc.lg.Errorf("handling batch invalidation message: %w", err)
+				}
+			})
+	}
+	sid := types.NewUUID()
+	removeMsg := codec.EncodeCacheRemoveEntryListenerRequest(name, sid)
+	if err := ncc.lb.Add(ctx, sid, addMsg, removeMsg, handler); err != nil {
+		return err
+	}
+	ncc.invalidationListenerID.Store(sid)
+	return nil
+}
+
+// Get returns the cached value for the key, or gets it using getFn and caches it.
+func (ncc *nearCacheCache) Get(key interface{}, keyData serialization.Data, getFn func() (interface{}, error)) (interface{}, error) {
+	ncKey, err := ncc.toNearCacheKey(key)
+	if err != nil {
+		return nil, err
+	}
+	cached, found, err := ncc.getCachedValue(ncKey)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		return cached, nil
+	}
+	rid, err := ncc.nc.TryReserveForUpdate(ncKey, keyData, inearcache.UpdateSemanticReadUpdate)
+	if err != nil {
+		return nil, err
+	}
+	value, err := getFn()
+	if err != nil {
+		ncc.nc.Invalidate(ncKey)
+		return nil, err
+	}
+	if rid != inearcache.RecordNotReserved {
+		if value, err = ncc.nc.TryPublishReserved(ncKey, value, rid); err != nil {
+			return nil, err
+		}
+	}
+	return value, nil
+}
+
+// GetCached returns the entries found in the Near Cache and the keys which were not found.
+func (ncc *nearCacheCache) GetCached(keys []interface{}) ([]types.Entry, []interface{}, error) {
+	var entries []types.Entry
+	var missKeys []interface{}
+	for _, k := range keys {
+		ncKey, err := ncc.toNearCacheKey(k)
+		if err != nil {
+			return nil, nil, err
+		}
+		cached, ok, err := ncc.getCachedValue(ncKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		if ok && cached != nil {
+			entries = append(entries, types.Entry{Key: k, Value: cached})
+			continue
+		}
+		missKeys = append(missKeys, k)
+	}
+	return entries, missKeys, nil
+}
+
+// Invalidate removes the key from the Near Cache.
+func (ncc *nearCacheCache) Invalidate(key interface{}) {
+	ncKey, err := ncc.toNearCacheKey(key)
+	if err != nil {
+		ncc.lg.Errorf("hazelcast.nearCacheCache.Invalidate: %w", err)
+		return
+	}
+	ncc.nc.Invalidate(ncKey)
+}
+
+// Clear removes all entries from the Near Cache.
+func (ncc *nearCacheCache) Clear() {
+	ncc.nc.Clear()
+}
+
+func (ncc *nearCacheCache) getCachedValue(key interface{}) (value interface{}, found bool, err error) {
+	value, found, err = ncc.nc.Get(key)
+	if err != nil || !found {
+		return nil, false, err
+	}
+	if data, ok := value.(serialization.Data); ok {
+		if value, err = ncc.ss.ToObject(data); err != nil {
+			return nil, false, err
+		}
+	}
+	return value, true, nil
+}
@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/cluster"
+	icluster "github.com/hazelcast/hazelcast-go-client/internal/cluster"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+/*
+PartitionAware is implemented by keys which determine their partition using a partition key, instead of the key itself.
+
+Keys with the same partition key are stored in the same partition.
+That allows keeping related entries together, e.g., an order and its order lines, and processing them together with entry processors.
+The partition key should be a value which is serialized the same way on all clients and members, such as a string or an integer.
+
+	type OrderLineKey struct {
+		OrderID string
+		Line    int
+	}
+
+	func (k OrderLineKey) PartitionKey() interface{} {
+		return k.OrderID
+	}
+*/
+type PartitionAware interface {
+	// PartitionKey returns the key which determines the partition.
+	// If it returns nil, the partition is determined using the object itself.
+	PartitionKey() interface{}
+}
+
+// PartitionService provides information about the partitions of the cluster.
+type PartitionService struct {
+	ps *icluster.PartitionService
+	cs *icluster.Service
+	ss *iserialization.Service
+}
+
+// GetPartition returns the ID of the partition the given key belongs to.
+// PartitionAware keys are placed using their partition key.
+func (s *PartitionService) GetPartition(key interface{}) (int32, error) {
+	keyData, err := s.ss.ToData(key)
+	if err != nil {
+		return 0, err
+	}
+	return s.ps.GetPartitionID(keyData)
+}
+
+// PartitionCount returns the number of partitions of the cluster.
+// Returns 0 if the client has not connected to the cluster yet.
+func (s *PartitionService) PartitionCount() int32 {
+	return s.ps.PartitionCount()
+}
+
+// PartitionOwner returns the member which owns the given partition.
+// Returns false if the owner is not known yet.
+func (s *PartitionService) PartitionOwner(partitionID int32) (cluster.MemberInfo, bool) {
+	uuid, ok := s.ps.GetPartitionOwner(partitionID)
+	if !ok {
+		return cluster.MemberInfo{}, false
+	}
+	mem := s.cs.GetMemberByUUID(uuid)
+	if mem == nil {
+		return cluster.MemberInfo{}, false
+	}
+	return *mem, true
+}
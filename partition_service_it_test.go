@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+)
+
+type orderLineKey struct {
+	OrderID string
+	Line    int
+}
+
+func (k orderLineKey) PartitionKey() interface{} {
+	return k.OrderID
+}
+
+func TestPartitionService_PartitionAware(t *testing.T) {
+	it.Tester(t, func(t *testing.T, client *hz.Client) {
+		ps := client.PartitionService()
+		require.Equal(t, int32(271), ps.PartitionCount())
+		orderPartition, err := ps.GetPartition("order-1")
+		require.NoError(t, err)
+		for i := 0; i < 10; i++ {
+			pid, err := ps.GetPartition(orderLineKey{OrderID: "order-1", Line: i})
+			require.NoError(t, err)
+			require.Equal(t, orderPartition, pid)
+		}
+		it.Eventually(t, func() bool {
+			_, ok := ps.PartitionOwner(orderPartition)
+			return ok
+		})
+	})
+}
+
+func TestPartitionService_PartitionAwareMapKey(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		key := orderLineKey{OrderID: "order-1", Line: 1}
+		it.MustValue(m.Put(ctx, key, "line-1"))
+		v, err := m.Get(ctx, key)
+		require.NoError(t, err)
+		require.Equal(t, "line-1", v)
+		pid, err := m.PartitionIDForKey(key)
+		require.NoError(t, err)
+		orderPid, err := m.PartitionIDForKey("order-1")
+		require.NoError(t, err)
+		require.Equal(t, orderPid, pid)
+	})
+}
@@ -0,0 +1,186 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package predicate
+
+import (
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+)
+
+// iterationTypeEntry is the name of the entry iteration type in the cluster.
+const iterationTypeEntry = "ENTRY"
+
+/*
+Paging creates a predicate which returns the results of the given predicate page by page.
+
+The page size must be positive.
+The inner predicate may be nil, in which case all entries are returned page by page.
+The first page is zero.
+The page is changed with NextPage, PreviousPage and SetPage.
+
+The results are sorted by the values of the entries, which must implement the java.lang.Comparable interface on the cluster side.
+Use PagingWithComparator to sort the results with a custom comparator.
+
+A PagingPredicate keeps the state of the query, so it should not be used by multiple goroutines concurrently.
+It can only be used with Map.GetKeySetWithPredicate, Map.GetValuesWithPredicate and Map.GetEntrySetWithPredicate.
+*/
+func Paging(inner Predicate, pageSize int) *PagingPredicate {
+	return PagingWithComparator(inner, nil, pageSize)
+}
+
+/*
+PagingWithComparator creates a predicate which returns the results of the given predicate sorted with the given comparator page by page.
+
+The comparator must have a counterpart registered in the server-side that implements the java.util.Comparator interface for map entries.
+The comparator determines the iteration order, so a comparator which reverses the order should be used for descending order.
+See Paging for the details.
+*/
+func PagingWithComparator(inner Predicate, comparator serialization.IdentifiedDataSerializable, pageSize int) *PagingPredicate {
+	return &PagingPredicate{
+		predicate:  inner,
+		comparator: comparator,
+		pageSize:   pageSize,
+	}
+}
+
+// PagingAnchor is the last entry of a page.
+// The cluster uses the anchors of the previous pages to find the entries of the current page.
+type PagingAnchor struct {
+	Key   interface{}
+	Value interface{}
+	Page  int
+}
+
+// PagingPredicate is a predicate which returns the results of its inner predicate page by page.
+// Use Paging or PagingWithComparator to create a PagingPredicate.
+type PagingPredicate struct {
+	predicate  Predicate
+	comparator serialization.IdentifiedDataSerializable
+	anchors    []PagingAnchor
+	pageSize   int
+	page       int
+}
+
+func (p PagingPredicate) FactoryID() int32 {
+	return factoryID
+}
+
+func (p PagingPredicate) ClassID() int32 {
+	return 15
+}
+
+func (p *PagingPredicate) ReadData(input serialization.DataInput) {
+	if pred := input.ReadObject(); pred != nil {
+		p.predicate = pred.(Predicate)
+	}
+	if cmp := input.ReadObject(); cmp != nil {
+		p.comparator = cmp.(serialization.IdentifiedDataSerializable)
+	}
+	p.page = int(input.ReadInt32())
+	p.pageSize = int(input.ReadInt32())
+	// the iteration type is set by the map operation.
+	input.ReadString()
+	size := int(input.ReadInt32())
+	anchors := make([]PagingAnchor, size)
+	for i := 0; i < size; i++ {
+		anchors[i].Page = int(input.ReadInt32())
+		anchors[i].Key = input.ReadObject()
+		anchors[i].Value = input.ReadObject()
+	}
+	p.anchors = anchors
+}
+
+func (p PagingPredicate) WriteData(output serialization.DataOutput) {
+	output.WriteObject(p.predicate)
+	output.WriteObject(p.comparator)
+	output.WriteInt32(int32(p.page))
+	output.WriteInt32(int32(p.pageSize))
+	// the iteration type is set by the map operation, ENTRY is written for compatibility.
+	output.WriteString(iterationTypeEntry)
+	output.WriteInt32(int32(len(p.anchors)))
+	for _, a := range p.anchors {
+		output.WriteInt32(int32(a.Page))
+		output.WriteObject(a.Key)
+		output.WriteObject(a.Value)
+	}
+}
+
+func (p PagingPredicate) String() string {
+	var inner string
+	if p.predicate != nil {
+		inner = p.predicate.String()
+	}
+	return fmt.Sprintf("Paging(%s, page=%d, pageSize=%d)", inner, p.page, p.pageSize)
+}
+
+// Predicate returns the inner predicate.
+func (p *PagingPredicate) Predicate() Predicate {
+	return p.predicate
+}
+
+// Comparator returns the comparator, or nil if the predicate does not have a comparator.
+func (p *PagingPredicate) Comparator() serialization.IdentifiedDataSerializable {
+	return p.comparator
+}
+
+// PageSize returns the number of entries in a page.
+func (p *PagingPredicate) PageSize() int {
+	return p.pageSize
+}
+
+// Page returns the current page.
+func (p *PagingPredicate) Page() int {
+	return p.page
+}
+
+// NextPage sets the page to the next page.
+func (p *PagingPredicate) NextPage() {
+	p.page++
+}
+
+// PreviousPage sets the page to the previous page.
+// It does nothing if the current page is the first page.
+func (p *PagingPredicate) PreviousPage() {
+	if p.page > 0 {
+		p.page--
+	}
+}
+
+// SetPage sets the current page.
+func (p *PagingPredicate) SetPage(page int) {
+	p.page = page
+}
+
+// Reset sets the page to the first page and clears the anchors.
+func (p *PagingPredicate) Reset() {
+	p.page = 0
+	p.anchors = nil
+}
+
+// Anchors returns a copy of the anchors received from the cluster.
+func (p *PagingPredicate) Anchors() []PagingAnchor {
+	anchors := make([]PagingAnchor, len(p.anchors))
+	copy(anchors, p.anchors)
+	return anchors
+}
+
+// SetAnchors replaces the anchors.
+// It is called by the client with the anchors received from the cluster, so it is not necessary to call it explicitly.
+func (p *PagingPredicate) SetAnchors(anchors []PagingAnchor) {
+	p.anchors = anchors
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package predicate_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hazelcast/hazelcast-go-client/predicate"
+)
+
+func TestPaging(t *testing.T) {
+	inner := predicate.Equal("a", 1)
+	p := predicate.Paging(inner, 10)
+	assert.Equal(t, inner, p.Predicate())
+	assert.Nil(t, p.Comparator())
+	assert.Equal(t, 10, p.PageSize())
+	assert.Equal(t, 0, p.Page())
+	p.PreviousPage()
+	assert.Equal(t, 0, p.Page())
+	p.NextPage()
+	p.NextPage()
+	assert.Equal(t, 2, p.Page())
+	p.PreviousPage()
+	assert.Equal(t, 1, p.Page())
+	p.SetPage(5)
+	assert.Equal(t, 5, p.Page())
+}
+
+func TestPaging_Anchors(t *testing.T) {
+	p := predicate.Paging(nil, 2)
+	anchors := []predicate.PagingAnchor{
+		{Page: 0, Key: "k1", Value: 1},
+		{Page: 1, Key: "k3", Value: 3},
+	}
+	p.SetAnchors(anchors)
+	got := p.Anchors()
+	assert.Equal(t, anchors, got)
+	// modifying the returned anchors does not change the predicate.
+	got[0].Key = "other"
+	assert.Equal(t, "k1", p.Anchors()[0].Key)
+	p.NextPage()
+	p.Reset()
+	assert.Equal(t, 0, p.Page())
+	assert.Len(t, p.Anchors(), 0)
+}
@@ -0,0 +1,23 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+/*
+Package projection provides projection functions.
+
+Projections allow transforming the stored map entries before they are returned to the client.
+The transformation is performed in a fully distributed manner, so only the projected values are transferred to the client.
+For instance, a projection can extract a single attribute of the values to avoid sending the whole values over the network.
+*/
+package projection
@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package projection
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal"
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+)
+
+// Identity returns a projection which does not transform the entries.
+// The result of the projection for each entry is a types.Entry.
+func Identity() *projIdentity {
+	return &projIdentity{}
+}
+
+type projIdentity struct{}
+
+func (p projIdentity) FactoryID() int32 {
+	return internal.ProjectionFactoryID
+}
+
+func (p projIdentity) ClassID() int32 {
+	return internal.ProjectionIdentityClassID
+}
+
+func (p projIdentity) WriteData(output serialization.DataOutput) {
+}
+
+func (p *projIdentity) ReadData(input serialization.DataInput) {
+}
+
+func (p projIdentity) String() string {
+	return "Identity()"
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package projection
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hazelcast/hazelcast-go-client/internal"
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+)
+
+// Multi returns a projection which extracts the values of the given attribute paths of the entries.
+// The result of the projection for each entry is a []interface{}, which contains the attribute values in the given order.
+func Multi(attrs ...string) *projMulti {
+	return &projMulti{attrPaths: attrs}
+}
+
+type projMulti struct {
+	attrPaths []string
+}
+
+func (p projMulti) FactoryID() int32 {
+	return internal.ProjectionFactoryID
+}
+
+func (p projMulti) ClassID() int32 {
+	return 1
+}
+
+func (p projMulti) WriteData(output serialization.DataOutput) {
+	output.WriteStringArray(p.attrPaths)
+}
+
+func (p *projMulti) ReadData(input serialization.DataInput) {
+	p.attrPaths = input.ReadStringArray()
+}
+
+func (p projMulti) String() string {
+	return fmt.Sprintf("Multi(%s)", strings.Join(p.attrPaths, ", "))
+}
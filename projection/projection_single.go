@@ -0,0 +1,53 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package projection
+
+import (
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-go-client/internal"
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+)
+
+// Single returns a projection which extracts the value of the given attribute path of the entries.
+// The attribute path may refer to the key of the entry using the __key prefix, such as "__key.name".
+func Single(attr string) *projSingle {
+	return &projSingle{attrPath: attr}
+}
+
+type projSingle struct {
+	attrPath string
+}
+
+func (p projSingle) FactoryID() int32 {
+	return internal.ProjectionFactoryID
+}
+
+func (p projSingle) ClassID() int32 {
+	return 0
+}
+
+func (p projSingle) WriteData(output serialization.DataOutput) {
+	output.WriteString(p.attrPath)
+}
+
+func (p *projSingle) ReadData(input serialization.DataInput) {
+	p.attrPath = input.ReadString()
+}
+
+func (p projSingle) String() string {
+	return fmt.Sprintf("Single(%s)", p.attrPath)
+}
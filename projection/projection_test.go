@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package projection
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hazelcast/hazelcast-go-client/internal"
+)
+
+func TestProjStringer(t *testing.T) {
+	tcs := []struct {
+		projInstance fmt.Stringer
+		want         string
+	}{
+		{projInstance: Single("attribute"), want: "Single(attribute)"},
+		{projInstance: Multi("attr1", "attr2"), want: "Multi(attr1, attr2)"},
+		{projInstance: Identity(), want: "Identity()"},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.want, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.projInstance.String())
+		})
+	}
+}
+
+func TestProjIDs(t *testing.T) {
+	tcs := []struct {
+		proj    Projection
+		classID int32
+	}{
+		{proj: Single("attribute"), classID: 0},
+		{proj: Multi("attribute"), classID: 1},
+		{proj: Identity(), classID: 2},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.proj.String(), func(t *testing.T) {
+			assert.Equal(t, int32(internal.ProjectionFactoryID), tc.proj.FactoryID())
+			assert.Equal(t, tc.classID, tc.proj.ClassID())
+		})
+	}
+}
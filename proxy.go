@@ -24,6 +24,7 @@ import (
 
 	"github.com/hazelcast/hazelcast-go-client/aggregate"
 	pubcluster "github.com/hazelcast/hazelcast-go-client/cluster"
+	"github.com/hazelcast/hazelcast-go-client/internal"
 	"github.com/hazelcast/hazelcast-go-client/internal/cb"
 	"github.com/hazelcast/hazelcast-go-client/internal/check"
 	"github.com/hazelcast/hazelcast-go-client/internal/client"
@@ -36,20 +37,27 @@ import (
 	iproxy "github.com/hazelcast/hazelcast-go-client/internal/proxy"
 	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
 	"github.com/hazelcast/hazelcast-go-client/predicate"
+	"github.com/hazelcast/hazelcast-go-client/projection"
 	"github.com/hazelcast/hazelcast-go-client/types"
 )
 
 const (
-	ServiceNameMap              = "hz:impl:mapService"
-	ServiceNameReplicatedMap    = "hz:impl:replicatedMapService"
-	ServiceNameMultiMap         = "hz:impl:multiMapService"
-	ServiceNameQueue            = "hz:impl:queueService"
-	ServiceNameTopic            = "hz:impl:topicService"
-	ServiceNameList             = "hz:impl:listService"
-	ServiceNameRingBuffer       = "hz:impl:ringbufferService"
-	ServiceNameSet              = "hz:impl:setService"
-	ServiceNamePNCounter        = "hz:impl:PNCounterService"
-	ServiceNameFlakeIDGenerator = "hz:impl:flakeIdGeneratorService"
+	ServiceNameMap                  = "hz:impl:mapService"
+	ServiceNameReplicatedMap        = "hz:impl:replicatedMapService"
+	ServiceNameMultiMap             = "hz:impl:multiMapService"
+	ServiceNameQueue                = "hz:impl:queueService"
+	ServiceNameTopic                = "hz:impl:topicService"
+	ServiceNameReliableTopic        = "hz:impl:reliableTopicService"
+	ServiceNameList                 = "hz:impl:listService"
+	ServiceNameRingBuffer           = "hz:impl:ringbufferService"
+	ServiceNameSet                  = "hz:impl:setService"
+	ServiceNamePNCounter            = "hz:impl:PNCounterService"
+	ServiceNameFlakeIDGenerator     = "hz:impl:flakeIdGeneratorService"
+	ServiceNameCache                = "hz:impl:cacheService"
+	ServiceNameCardinalityEstimator = "hz:impl:cardinalityEstimatorService"
+	ServiceNameExecutor             = "hz:impl:executorService"
+	ServiceNameDurableExecutor      = "hz:impl:durableExecutorService"
+	ServiceNameVectorCollection     = "hz:service:vector"
 )
 
 const (
@@ -57,6 +65,15 @@ const (
 	ttlUnlimited = 0
 )
 
+// iterationType is the type of the results of a paging query.
+type iterationType byte
+
+const (
+	iterationTypeKey   iterationType = 0
+	iterationTypeValue iterationType = 1
+	iterationTypeEntry iterationType = 2
+)
+
 const (
 	maxIndexAttributes = 255
 	defaultLockID      = 0
@@ -222,10 +239,21 @@ func (p *proxy) validateAndSerializeAggregate(agg aggregate.Aggregator) (arg1Dat
 	return
 }
 
+func (p *proxy) validateAndSerializeProjection(proj projection.Projection) (arg1Data iserialization.Data, err error) {
+	if check.Nil(proj) {
+		return nil, ihzerrors.NewIllegalArgumentError("projection should not be nil", nil)
+	}
+	arg1Data, err = p.serializationService.ToData(proj)
+	return
+}
+
 func (p *proxy) validateAndSerializePredicate(pred predicate.Predicate) (arg1Data iserialization.Data, err error) {
 	if check.Nil(pred) {
 		return nil, ihzerrors.NewIllegalArgumentError("predicate should not be nil", nil)
 	}
+	if _, ok := asPagingPredicate(pred); ok {
+		return nil, ihzerrors.NewIllegalArgumentError("paging predicate is not supported for this operation", nil)
+	}
 	arg1Data, err = p.serializationService.ToData(pred)
 	return
 }
@@ -258,6 +286,10 @@ func (p *proxy) invokeOnPartition(ctx context.Context, request *proto.ClientMess
 	return p.invoker.InvokeOnPartition(ctx, request, partitionID)
 }
 
+func (p *proxy) invokeOnMember(ctx context.Context, request *proto.ClientMessage, member *pubcluster.MemberInfo) (*proto.ClientMessage, error) {
+	return p.invoker.InvokeOnMember(ctx, request, member)
+}
+
 func (p *proxy) invokeOnPartitionAsync(ctx context.Context, request *proto.ClientMessage, partitionID int32, now time.Time) (invocation.Invocation, error) {
 	return p.invoker.InvokeOnPartitionAsync(ctx, request, partitionID, now)
 }
@@ -413,3 +445,12 @@ func flagsSetOrClear(flags *int32, flag int32, enable bool) {
 		*flags &^= flag
 	}
 }
+
+func asPagingPredicate(pred predicate.Predicate) (*predicate.PagingPredicate, bool) {
+	pp, ok := pred.(*predicate.PagingPredicate)
+	return pp, ok && pp != nil
+}
+
+func isIdentityProjection(proj projection.Projection) bool {
+	return proj.FactoryID() == internal.ProjectionFactoryID && proj.ClassID() == internal.ProjectionIdentityClassID
+}
@@ -0,0 +1,581 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/cb"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	iproxy "github.com/hazelcast/hazelcast-go-client/internal/proxy"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// cacheNamePrefix is prepended to cache names by the default cache manager of the members.
+	cacheNamePrefix = "/hz/"
+	// noCompletionID is sent when the operation does not wait for a completion event.
+	noCompletionID = -1
+)
+
+// ExpiryPolicy sets the expiry durations of a cache entry for a single operation.
+// Create is used when the entry is created, Access when the entry is read and Update when the entry is updated.
+// A zero duration means the entry does not expire.
+// Durations are sent with millisecond precision.
+type ExpiryPolicy struct {
+	Create time.Duration
+	Access time.Duration
+	Update time.Duration
+}
+
+// Validate returns an error if the expiry policy has a negative duration.
+func (p ExpiryPolicy) Validate() error {
+	if p.Create < 0 || p.Access < 0 || p.Update < 0 {
+		return ihzerrors.NewIllegalArgumentError("expiry policy durations must be non-negative", nil)
+	}
+	return nil
+}
+
+/*
+Cache is a distributed JCache compatible cache.
+
+The cache must be configured on the members, otherwise getting the cache fails with hzerrors.ErrCacheNotExists.
+Operations without an ExpiryPolicy use the expiry policy configured for the cache on the members.
+
+If a Near Cache is configured for the cache name in the client configuration, Get and GetAll use the Near Cache,
+and the Near Cache is invalidated on changes.
+
+For details see https://docs.hazelcast.com/hazelcast/latest/jcache/jcache
+*/
+type Cache struct {
+	*proxy
+	ncc       *nearCacheCache
+	cacheName string
+}
+
+func newCache(p *proxy, name string) *Cache {
+	return &Cache{proxy: p, cacheName: name}
+}
+
+// Name returns the name of the cache without the cache manager prefix.
+func (c *Cache) Name() string {
+	return c.cacheName
+}
+
+// AddEntryListener adds an entry listener to this cache.
+// The handler is called for created, updated, removed and expired entries.
+func (c *Cache) AddEntryListener(ctx context.Context, handler CacheEntryNotifiedHandler) (types.UUID, error) {
+	if handler == nil {
+		return types.UUID{}, ihzerrors.NewIllegalArgumentError("handler cannot be nil", nil)
+	}
+	subscriptionID := types.NewUUID()
+	addRequest := codec.EncodeCacheAddEntryListenerRequest(c.name, c.smart)
+	removeRequest := codec.EncodeCacheRemoveEntryListenerRequest(c.name, subscriptionID)
+	listenerHandler := func(msg *proto.ClientMessage) {
+		codec.HandleCacheAddEntryListener(msg, func(eventType int32, events []codec.CacheEventData, completionID int32) {
+			for _, e := range events {
+				et := CacheEntryEventType(e.CacheEventType)
+				if et < CacheEntryCreated || et > CacheEntryExpired {
+					continue
+				}
+				event, err := c.makeCacheEntryNotified(e, et)
+				if err != nil {
+					c.logger.Errorf("error while preparing cache entry notified event: %w", err)
+					continue
+				}
+				handler(event)
+			}
+		})
+	}
+	err := c.listenerBinder.Add(ctx, subscriptionID, addRequest, removeRequest, listenerHandler)
+	return subscriptionID, err
+}
+
+// Clear removes all entries from this cache without notifying listeners.
+func (c *Cache) Clear(ctx context.Context) error {
+	if c.ncc != nil {
+		defer c.ncc.Clear()
+	}
+	request := codec.EncodeCacheClearRequest(c.name)
+	_, err := c.invokeOnRandomTarget(ctx, request, nil)
+	return err
+}
+
+// ContainsKey returns true if this cache contains an entry for the given key.
+func (c *Cache) ContainsKey(ctx context.Context, key interface{}) (bool, error) {
+	keyData, err := c.validateAndSerialize(key)
+	if err != nil {
+		return false, err
+	}
+	request := codec.EncodeCacheContainsKeyRequest(c.name, keyData)
+	response, err := c.invokeOnKey(ctx, request, keyData)
+	if err != nil {
+		return false, err
+	}
+	return codec.DecodeCacheContainsKeyResponse(response), nil
+}
+
+// Get returns the value for the given key, or nil if the cache does not contain the key.
+func (c *Cache) Get(ctx context.Context, key interface{}) (interface{}, error) {
+	keyData, err := c.validateAndSerialize(key)
+	if err != nil {
+		return nil, err
+	}
+	if c.ncc != nil {
+		return c.ncc.Get(key, keyData, func() (interface{}, error) {
+			return c.getFromRemote(ctx, keyData, nil)
+		})
+	}
+	return c.getFromRemote(ctx, keyData, nil)
+}
+
+// GetWithExpiryPolicy returns the value for the given key using the given expiry policy.
+// It returns nil if the cache does not contain the key.
+// The Near Cache is not used by this method.
+func (c *Cache) GetWithExpiryPolicy(ctx context.Context, key interface{}, expiryPolicy ExpiryPolicy) (interface{}, error) {
+	keyData, epData, err := c.validateAndSerializeKeyAndExpiryPolicy(key, &expiryPolicy)
+	if err != nil {
+		return nil, err
+	}
+	return c.getFromRemote(ctx, keyData, epData)
+}
+
+// GetAll returns the entries for the given keys.
+// Keys which do not exist in the cache are not included in the result.
+func (c *Cache) GetAll(ctx context.Context, keys ...interface{}) ([]types.Entry, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	if c.ncc == nil {
+		return c.getAllFromRemote(ctx, keys, nil)
+	}
+	entries, missKeys, err := c.ncc.GetCached(keys)
+	if err != nil {
+		return nil, err
+	}
+	if len(missKeys) == 0 {
+		return entries, nil
+	}
+	remoteEntries, err := c.getAllFromRemote(ctx, missKeys, nil)
+	if err != nil {
+		return nil, err
+	}
+	return append(entries, remoteEntries...), nil
+}
+
+// GetAllWithExpiryPolicy returns the entries for the given keys using the given expiry policy.
+// Keys which do not exist in the cache are not included in the result.
+// The Near Cache is not used by this method.
+func (c *Cache) GetAllWithExpiryPolicy(ctx context.Context, expiryPolicy ExpiryPolicy, keys ...interface{}) ([]types.Entry, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	return c.getAllFromRemote(ctx, keys, &expiryPolicy)
+}
+
+// GetAndPut associates the given value with the given key and returns the previous value.
+func (c *Cache) GetAndPut(ctx context.Context, key interface{}, value interface{}) (interface{}, error) {
+	return c.put(ctx, key, value, nil, true)
+}
+
+// GetAndPutWithExpiryPolicy associates the given value with the given key using the given expiry policy and returns the previous value.
+func (c *Cache) GetAndPutWithExpiryPolicy(ctx context.Context, key interface{}, value interface{}, expiryPolicy ExpiryPolicy) (interface{}, error) {
+	return c.put(ctx, key, value, &expiryPolicy, true)
+}
+
+// GetAndRemove removes the entry for the given key and returns its value.
+func (c *Cache) GetAndRemove(ctx context.Context, key interface{}) (interface{}, error) {
+	keyData, err := c.validateAndSerialize(key)
+	if err != nil {
+		return nil, err
+	}
+	defer c.invalidate(key)
+	request := codec.EncodeCacheGetAndRemoveRequest(c.name, keyData, noCompletionID)
+	response, err := c.invokeOnKey(ctx, request, keyData)
+	if err != nil {
+		return nil, err
+	}
+	return c.convertToObject(codec.DecodeCacheGetAndRemoveResponse(response))
+}
+
+// GetAndReplace replaces the value of the given key only if the key exists and returns the previous value.
+func (c *Cache) GetAndReplace(ctx context.Context, key interface{}, value interface{}) (interface{}, error) {
+	return c.getAndReplace(ctx, key, value, nil)
+}
+
+// GetAndReplaceWithExpiryPolicy replaces the value of the given key using the given expiry policy only if the key exists and returns the previous value.
+func (c *Cache) GetAndReplaceWithExpiryPolicy(ctx context.Context, key interface{}, value interface{}, expiryPolicy ExpiryPolicy) (interface{}, error) {
+	return c.getAndReplace(ctx, key, value, &expiryPolicy)
+}
+
+// Invoke runs the entry processor on the entry with the given key and returns its result.
+// The entry processor and the arguments must be serializable, and the entry processor must be registered on the members.
+func (c *Cache) Invoke(ctx context.Context, key interface{}, entryProcessor interface{}, arguments ...interface{}) (interface{}, error) {
+	keyData, processorData, err := c.validateAndSerialize2(key, entryProcessor)
+	if err != nil {
+		return nil, err
+	}
+	argsData, err := c.validateAndSerializeValues(arguments)
+	if err != nil {
+		return nil, err
+	}
+	defer c.invalidate(key)
+	request := codec.EncodeCacheEntryProcessorRequest(c.name, keyData, processorData, argsData, noCompletionID)
+	response, err := c.invokeOnKey(ctx, request, keyData)
+	if err != nil {
+		return nil, err
+	}
+	return c.convertToObject(codec.DecodeCacheEntryProcessorResponse(response))
+}
+
+// Put associates the given value with the given key.
+func (c *Cache) Put(ctx context.Context, key interface{}, value interface{}) error {
+	_, err := c.put(ctx, key, value, nil, false)
+	return err
+}
+
+// PutWithExpiryPolicy associates the given value with the given key using the given expiry policy.
+func (c *Cache) PutWithExpiryPolicy(ctx context.Context, key interface{}, value interface{}, expiryPolicy ExpiryPolicy) error {
+	_, err := c.put(ctx, key, value, &expiryPolicy, false)
+	return err
+}
+
+// PutAll copies the given entries to this cache.
+func (c *Cache) PutAll(ctx context.Context, entries ...types.Entry) error {
+	return c.putAll(ctx, entries, nil)
+}
+
+// PutAllWithExpiryPolicy copies the given entries to this cache using the given expiry policy.
+func (c *Cache) PutAllWithExpiryPolicy(ctx context.Context, expiryPolicy ExpiryPolicy, entries ...types.Entry) error {
+	return c.putAll(ctx, entries, &expiryPolicy)
+}
+
+// PutIfAbsent associates the given value with the given key only if the key does not exist.
+// Returns true if the value was set.
+func (c *Cache) PutIfAbsent(ctx context.Context, key interface{}, value interface{}) (bool, error) {
+	return c.putIfAbsent(ctx, key, value, nil)
+}
+
+// PutIfAbsentWithExpiryPolicy associates the given value with the given key using the given expiry policy only if the key does not exist.
+// Returns true if the value was set.
+func (c *Cache) PutIfAbsentWithExpiryPolicy(ctx context.Context, key interface{}, value interface{}, expiryPolicy ExpiryPolicy) (bool, error) {
+	return c.putIfAbsent(ctx, key, value, &expiryPolicy)
+}
+
+// Remove removes the entry for the given key.
+// Returns true if the entry was removed.
+func (c *Cache) Remove(ctx context.Context, key interface{}) (bool, error) {
+	return c.remove(ctx, key, nil)
+}
+
+// RemoveIfSame removes the entry for the given key only if it is currently mapped to the given value.
+// Returns true if the entry was removed.
+func (c *Cache) RemoveIfSame(ctx context.Context, key interface{}, value interface{}) (bool, error) {
+	if value == nil {
+		return false, ihzerrors.NewIllegalArgumentError("value cannot be nil", nil)
+	}
+	return c.remove(ctx, key, value)
+}
+
+// RemoveAll removes all entries from this cache, notifying the listeners.
+func (c *Cache) RemoveAll(ctx context.Context) error {
+	if c.ncc != nil {
+		defer c.ncc.Clear()
+	}
+	request := codec.EncodeCacheRemoveAllRequest(c.name, noCompletionID)
+	_, err := c.invokeOnRandomTarget(ctx, request, nil)
+	return err
+}
+
+// RemoveEntryListener removes the entry listener with the given subscription ID.
+func (c *Cache) RemoveEntryListener(ctx context.Context, subscriptionID types.UUID) error {
+	return c.listenerBinder.Remove(ctx, subscriptionID)
+}
+
+// Replace replaces the value of the given key only if the key exists.
+// Returns true if the value was replaced.
+func (c *Cache) Replace(ctx context.Context, key interface{}, value interface{}) (bool, error) {
+	return c.replace(ctx, key, nil, value, nil)
+}
+
+// ReplaceWithExpiryPolicy replaces the value of the given key using the given expiry policy only if the key exists.
+// Returns true if the value was replaced.
+func (c *Cache) ReplaceWithExpiryPolicy(ctx context.Context, key interface{}, value interface{}, expiryPolicy ExpiryPolicy) (bool, error) {
+	return c.replace(ctx, key, nil, value, &expiryPolicy)
+}
+
+// ReplaceIfSame replaces the value of the given key only if it is currently mapped to oldValue.
+// Returns true if the value was replaced.
+func (c *Cache) ReplaceIfSame(ctx context.Context, key interface{}, oldValue interface{}, newValue interface{}) (bool, error) {
+	if oldValue == nil {
+		return false, ihzerrors.NewIllegalArgumentError("old value cannot be nil", nil)
+	}
+	return c.replace(ctx, key, oldValue, newValue, nil)
+}
+
+// ReplaceIfSameWithExpiryPolicy replaces the value of the given key using the given expiry policy only if it is currently mapped to oldValue.
+// Returns true if the value was replaced.
+func (c *Cache) ReplaceIfSameWithExpiryPolicy(ctx context.Context, key interface{}, oldValue interface{}, newValue interface{}, expiryPolicy ExpiryPolicy) (bool, error) {
+	if oldValue == nil {
+		return false, ihzerrors.NewIllegalArgumentError("old value cannot be nil", nil)
+	}
+	return c.replace(ctx, key, oldValue, newValue, &expiryPolicy)
+}
+
+// Size returns the number of entries in this cache.
+func (c *Cache) Size(ctx context.Context) (int, error) {
+	request := codec.EncodeCacheSizeRequest(c.name)
+	response, err := c.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		return 0, err
+	}
+	return int(codec.DecodeCacheSizeResponse(response)), nil
+}
+
+func (c *Cache) destroyLocally(ctx context.Context) bool {
+	c.logger.Trace(func() string {
+		return fmt.Sprintf("hazelcast.Cache.destroyLocally: %s", c.name)
+	})
+	if c.ncc != nil {
+		if err := c.ncc.Destroy(ctx, c.name); err != nil {
+			c.logger.Errorf("hazelcast.Cache.destroyLocally: %w", err)
+		}
+	}
+	return true
+}
+
+func (c *Cache) getFromRemote(ctx context.Context, keyData, epData iserialization.Data) (interface{}, error) {
+	request := codec.EncodeCacheGetRequest(c.name, keyData, epData)
+	response, err := c.invokeOnKey(ctx, request, keyData)
+	if err != nil {
+		return nil, err
+	}
+	return c.convertToObject(codec.DecodeCacheGetResponse(response))
+}
+
+func (c *Cache) getAllFromRemote(ctx context.Context, keys []interface{}, ep *ExpiryPolicy) ([]types.Entry, error) {
+	keysData, err := c.validateAndSerializeValues(keys)
+	if err != nil {
+		return nil, err
+	}
+	epData, err := c.serializeExpiryPolicy(ep)
+	if err != nil {
+		return nil, err
+	}
+	request := codec.EncodeCacheGetAllRequest(c.name, keysData, epData)
+	response, err := c.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.convertPairsToEntries(codec.DecodeCacheGetAllResponse(response))
+}
+
+func (c *Cache) getAndReplace(ctx context.Context, key interface{}, value interface{}, ep *ExpiryPolicy) (interface{}, error) {
+	keyData, epData, err := c.validateAndSerializeKeyAndExpiryPolicy(key, ep)
+	if err != nil {
+		return nil, err
+	}
+	valueData, err := c.validateAndSerialize(value)
+	if err != nil {
+		return nil, err
+	}
+	defer c.invalidate(key)
+	request := codec.EncodeCacheGetAndReplaceRequest(c.name, keyData, valueData, epData, noCompletionID)
+	response, err := c.invokeOnKey(ctx, request, keyData)
+	if err != nil {
+		return nil, err
+	}
+	return c.convertToObject(codec.DecodeCacheGetAndReplaceResponse(response))
+}
+
+func (c *Cache) put(ctx context.Context, key interface{}, value interface{}, ep *ExpiryPolicy, get bool) (interface{}, error) {
+	keyData, epData, err := c.validateAndSerializeKeyAndExpiryPolicy(key, ep)
+	if err != nil {
+		return nil, err
+	}
+	valueData, err := c.validateAndSerialize(value)
+	if err != nil {
+		return nil, err
+	}
+	defer c.invalidate(key)
+	request := codec.EncodeCachePutRequest(c.name, keyData, valueData, epData, get, noCompletionID)
+	response, err := c.invokeOnKey(ctx, request, keyData)
+	if err != nil {
+		return nil, err
+	}
+	return c.convertToObject(codec.DecodeCachePutResponse(response))
+}
+
+func (c *Cache) putAll(ctx context.Context, entries []types.Entry, ep *ExpiryPolicy) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	epData, err := c.serializeExpiryPolicy(ep)
+	if err != nil {
+		return err
+	}
+	if c.ncc != nil {
+		defer func() {
+			for _, e := range entries {
+				c.ncc.Invalidate(e.Key)
+			}
+		}()
+	}
+	f := func(partitionID int32, entries []proto.Pair) cb.Future {
+		request := codec.EncodeCachePutAllRequest(c.name, entries, epData, noCompletionID)
+		now := time.Now()
+		return c.invoker.CB().TryContextFuture(ctx, func(ctx context.Context, attempt int) (interface{}, error) {
+			if attempt > 0 {
+				request = request.Copy()
+			}
+			if inv, err := c.invokeOnPartitionAsync(ctx, request, partitionID, now); err != nil {
+				return nil, err
+			} else {
+				return inv.GetWithContext(ctx)
+			}
+		})
+	}
+	return c.proxy.putAll(entries, f)
+}
+
+func (c *Cache) putIfAbsent(ctx context.Context, key interface{}, value interface{}, ep *ExpiryPolicy) (bool, error) {
+	keyData, epData, err := c.validateAndSerializeKeyAndExpiryPolicy(key, ep)
+	if err != nil {
+		return false, err
+	}
+	valueData, err := c.validateAndSerialize(value)
+	if err != nil {
+		return false, err
+	}
+	defer c.invalidate(key)
+	request := codec.EncodeCachePutIfAbsentRequest(c.name, keyData, valueData, epData, noCompletionID)
+	response, err := c.invokeOnKey(ctx, request, keyData)
+	if err != nil {
+		return false, err
+	}
+	return codec.DecodeCachePutIfAbsentResponse(response), nil
+}
+
+func (c *Cache) remove(ctx context.Context, key interface{}, value interface{}) (bool, error) {
+	keyData, err := c.validateAndSerialize(key)
+	if err != nil {
+		return false, err
+	}
+	var valueData iserialization.Data
+	if value != nil {
+		if valueData, err = c.convertToData(value); err != nil {
+			return false, err
+		}
+	}
+	defer c.invalidate(key)
+	request := codec.EncodeCacheRemoveRequest(c.name, keyData, valueData, noCompletionID)
+	response, err := c.invokeOnKey(ctx, request, keyData)
+	if err != nil {
+		return false, err
+	}
+	return codec.DecodeCacheRemoveResponse(response), nil
+}
+
+func (c *Cache) replace(ctx context.Context, key interface{}, oldValue interface{}, newValue interface{}, ep *ExpiryPolicy) (bool, error) {
+	keyData, epData, err := c.validateAndSerializeKeyAndExpiryPolicy(key, ep)
+	if err != nil {
+		return false, err
+	}
+	newValueData, err := c.validateAndSerialize(newValue)
+	if err != nil {
+		return false, err
+	}
+	var oldValueData iserialization.Data
+	if oldValue != nil {
+		if oldValueData, err = c.convertToData(oldValue); err != nil {
+			return false, err
+		}
+	}
+	defer c.invalidate(key)
+	request := codec.EncodeCacheReplaceRequest(c.name, keyData, oldValueData, newValueData, epData, noCompletionID)
+	response, err := c.invokeOnKey(ctx, request, keyData)
+	if err != nil {
+		return false, err
+	}
+	// the response is a serialized boolean
+	res, err := c.convertToObject(codec.DecodeCacheReplaceResponse(response))
+	if err != nil {
+		return false, err
+	}
+	replaced, _ := res.(bool)
+	return replaced, nil
+}
+
+func (c *Cache) invalidate(key interface{}) {
+	if c.ncc != nil {
+		c.ncc.Invalidate(key)
+	}
+}
+
+func (c *Cache) makeCacheEntryNotified(e codec.CacheEventData, eventType CacheEntryEventType) (*CacheEntryNotified, error) {
+	key, err := c.convertToObject(e.Key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key: %w", err)
+	}
+	value, err := c.convertToObject(e.Value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value: %w", err)
+	}
+	oldValue, err := c.convertToObject(e.OldValue)
+	if err != nil {
+		return nil, fmt.Errorf("invalid oldValue: %w", err)
+	}
+	return newCacheEntryNotified(c.cacheName, key, value, oldValue, e.OldValueAvailable, eventType), nil
+}
+
+func (c *Cache) validateAndSerializeKeyAndExpiryPolicy(key interface{}, ep *ExpiryPolicy) (keyData, epData iserialization.Data, err error) {
+	if keyData, err = c.validateAndSerialize(key); err != nil {
+		return nil, nil, err
+	}
+	if epData, err = c.serializeExpiryPolicy(ep); err != nil {
+		return nil, nil, err
+	}
+	return keyData, epData, nil
+}
+
+// serializeExpiryPolicy returns nil if the expiry policy is nil.
+func (c *Cache) serializeExpiryPolicy(ep *ExpiryPolicy) (iserialization.Data, error) {
+	if ep == nil {
+		return nil, nil
+	}
+	if err := ep.Validate(); err != nil {
+		return nil, err
+	}
+	return c.convertToData(&iproxy.ExpiryPolicy{
+		Create: expiryMillis(ep.Create),
+		Access: expiryMillis(ep.Access),
+		Update: expiryMillis(ep.Update),
+	})
+}
+
+// expiryMillis rounds positive durations shorter than a millisecond up, so they are not sent as eternal.
+func expiryMillis(d time.Duration) int64 {
+	if d > 0 && d < time.Millisecond {
+		return 1
+	}
+	return d.Milliseconds()
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+/*
+CardinalityEstimator is a redundant and highly available distributed data-structure used for probabilistic cardinality estimation purposes, on unique items, in significantly sized data cultures.
+
+CardinalityEstimator is internally based on a HyperLogLog++ data-structure, and uses P^2 byte registers for storage and computation.
+The default P is 14, so the estimator uses about 16KB of memory regardless of the number of added items.
+
+All of the estimator state is stored in a single partition, in the partition owner of the estimator name.
+
+For details see https://docs.hazelcast.com/hazelcast/latest/data-structures/cardinality-estimator-service
+*/
+type CardinalityEstimator struct {
+	*proxy
+	// partitionKey is the serialized partition key of the estimator name.
+	partitionKey iserialization.Data
+}
+
+func newCardinalityEstimator(p *proxy) (*CardinalityEstimator, error) {
+	key := p.name[strings.Index(p.name, "@")+1:]
+	partitionKey, err := p.convertToData(key)
+	if err != nil {
+		return nil, err
+	}
+	return &CardinalityEstimator{proxy: p, partitionKey: partitionKey}, nil
+}
+
+// Add adds the given item to the estimator.
+// Items are compared using their serialized form, so the same item must be serialized in the same way by all clients.
+func (ce *CardinalityEstimator) Add(ctx context.Context, item interface{}) error {
+	itemData, err := ce.validateAndSerialize(item)
+	if err != nil {
+		return err
+	}
+	request := codec.EncodeCardinalityEstimatorAddRequest(ce.name, itemData.Hash64())
+	_, err = ce.invokeOnKey(ctx, request, ce.partitionKey)
+	return err
+}
+
+// Estimate returns the estimated number of distinct items added to the estimator so far.
+func (ce *CardinalityEstimator) Estimate(ctx context.Context) (int64, error) {
+	request := codec.EncodeCardinalityEstimatorEstimateRequest(ce.name)
+	response, err := ce.invokeOnKey(ctx, request, ce.partitionKey)
+	if err != nil {
+		return 0, err
+	}
+	return codec.DecodeCardinalityEstimatorEstimateResponse(response), nil
+}
@@ -0,0 +1,159 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+/*
+DurableExecutorService runs tasks on the members of the cluster, and stores the results of the tasks on the members.
+
+Unlike ExecutorService, the tasks and their results are backed up, so they survive the failure of a member.
+Each submitted task is assigned a task ID, which can be used to retrieve the result of the task later, even by another client.
+Results are kept until they are disposed, or the capacity of the executor is exceeded.
+
+Tasks must be implemented on the member side as a java.util.concurrent.Callable or java.lang.Runnable,
+and must be passed as a value which the members can deserialize, such as an IdentifiedDataSerializable, Portable or Compact value.
+
+For details, see https://docs.hazelcast.com/hazelcast/latest/computing/durable-executor-service
+*/
+type DurableExecutorService struct {
+	*proxy
+}
+
+func newDurableExecutorService(p *proxy) *DurableExecutorService {
+	return &DurableExecutorService{proxy: p}
+}
+
+// DisposeResult removes the result of the task with the given ID from the members.
+func (es *DurableExecutorService) DisposeResult(ctx context.Context, taskID int64) error {
+	partitionID, sequence := splitDurableTaskID(taskID)
+	request := codec.EncodeDurableExecutorDisposeResultRequest(es.name, sequence)
+	_, err := es.invokeOnPartition(ctx, request, partitionID)
+	return err
+}
+
+// IsShutdown returns true if this executor has been shut down.
+func (es *DurableExecutorService) IsShutdown(ctx context.Context) (bool, error) {
+	request := codec.EncodeDurableExecutorIsShutdownRequest(es.name)
+	resp, err := es.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		return false, err
+	}
+	return codec.DecodeDurableExecutorIsShutdownResponse(resp), nil
+}
+
+// RetrieveAndDisposeResult waits for the task with the given ID to complete, returns its result and removes it from the members.
+func (es *DurableExecutorService) RetrieveAndDisposeResult(ctx context.Context, taskID int64) (interface{}, error) {
+	partitionID, sequence := splitDurableTaskID(taskID)
+	request := codec.EncodeDurableExecutorRetrieveAndDisposeResultRequest(es.name, sequence)
+	resp, err := es.invokeOnPartition(ctx, request, partitionID)
+	if err != nil {
+		return nil, err
+	}
+	return es.convertToObject(codec.DecodeDurableExecutorRetrieveAndDisposeResultResponse(resp))
+}
+
+// RetrieveResult waits for the task with the given ID to complete and returns its result.
+func (es *DurableExecutorService) RetrieveResult(ctx context.Context, taskID int64) (interface{}, error) {
+	partitionID, sequence := splitDurableTaskID(taskID)
+	request := codec.EncodeDurableExecutorRetrieveResultRequest(es.name, sequence)
+	resp, err := es.invokeOnPartition(ctx, request, partitionID)
+	if err != nil {
+		return nil, err
+	}
+	return es.convertToObject(codec.DecodeDurableExecutorRetrieveResultResponse(resp))
+}
+
+// Shutdown initiates an orderly shutdown of the executor.
+// Previously submitted tasks are executed, but no new tasks are accepted.
+func (es *DurableExecutorService) Shutdown(ctx context.Context) error {
+	request := codec.EncodeDurableExecutorShutdownRequest(es.name)
+	_, err := es.invokeOnRandomTarget(ctx, request, nil)
+	return err
+}
+
+// Submit submits the task to a member.
+// If the task is PartitionAware, it runs on the owner of its partition key, otherwise on the owner of a random partition.
+func (es *DurableExecutorService) Submit(ctx context.Context, task interface{}) (*DurableExecutorFuture, error) {
+	taskData, err := es.validateAndSerialize(task)
+	if err != nil {
+		return nil, err
+	}
+	partitionID, err := taskPartitionID(es.proxy, task)
+	if err != nil {
+		return nil, err
+	}
+	return es.submitToPartition(ctx, taskData, partitionID)
+}
+
+// SubmitToKeyOwner submits the task to the owner of the given key.
+func (es *DurableExecutorService) SubmitToKeyOwner(ctx context.Context, task interface{}, key interface{}) (*DurableExecutorFuture, error) {
+	taskData, keyData, err := es.validateAndSerialize2(task, key)
+	if err != nil {
+		return nil, err
+	}
+	partitionID, err := es.partitionService.GetPartitionID(keyData)
+	if err != nil {
+		return nil, err
+	}
+	return es.submitToPartition(ctx, taskData, partitionID)
+}
+
+func (es *DurableExecutorService) submitToPartition(ctx context.Context, taskData iserialization.Data, partitionID int32) (*DurableExecutorFuture, error) {
+	request := codec.EncodeDurableExecutorSubmitToPartitionRequest(es.name, taskData)
+	resp, err := es.invokeOnPartition(ctx, request, partitionID)
+	if err != nil {
+		return nil, err
+	}
+	sequence := codec.DecodeDurableExecutorSubmitToPartitionResponse(resp)
+	// the task is stored on the members at this point, the result is retrieved separately.
+	retrieveRequest := codec.EncodeDurableExecutorRetrieveResultRequest(es.name, sequence)
+	f := newExecutorFuture(ctx, es.proxy, func(ctx context.Context) (*proto.ClientMessage, error) {
+		return es.invokeOnPartition(ctx, retrieveRequest, partitionID)
+	}, codec.DecodeDurableExecutorRetrieveResultResponse, nil)
+	return &DurableExecutorFuture{
+		ExecutorFuture: f,
+		taskID:         makeDurableTaskID(partitionID, sequence),
+	}, nil
+}
+
+// DurableExecutorFuture is the result of a task submitted to a DurableExecutorService.
+// Tasks submitted to a DurableExecutorService cannot be canceled, so Cancel always returns false.
+type DurableExecutorFuture struct {
+	*ExecutorFuture
+	taskID int64
+}
+
+// TaskID returns the ID of the task, which can be used to retrieve its result later.
+func (f *DurableExecutorFuture) TaskID() int64 {
+	return f.taskID
+}
+
+// makeDurableTaskID combines the partition ID and the sequence, same as the Java client.
+func makeDurableTaskID(partitionID int32, sequence int32) int64 {
+	return int64(partitionID)<<32 | int64(uint32(sequence))
+}
+
+func splitDurableTaskID(taskID int64) (partitionID int32, sequence int32) {
+	return int32(taskID >> 32), int32(taskID)
+}
@@ -0,0 +1,233 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+
+	"github.com/hazelcast/hazelcast-go-client/cluster"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+/*
+ExecutorService runs tasks on the members of the cluster.
+
+Tasks must be implemented on the member side as a java.util.concurrent.Callable or java.lang.Runnable,
+and must be passed as a value which the members can deserialize, such as an IdentifiedDataSerializable, Portable or Compact value.
+The result of a Runnable task is nil.
+
+Submit methods return a future which completes when the task completes.
+The context passed to a submit method bounds waiting for the result; if it is canceled before the task completes, the future fails with the context error.
+Canceling a context does not stop the task on the member, use ExecutorFuture.Cancel for that.
+
+For details, see https://docs.hazelcast.com/hazelcast/latest/computing/executor-service
+*/
+type ExecutorService struct {
+	*proxy
+}
+
+func newExecutorService(p *proxy) *ExecutorService {
+	return &ExecutorService{proxy: p}
+}
+
+// IsShutdown returns true if this executor has been shut down.
+func (es *ExecutorService) IsShutdown(ctx context.Context) (bool, error) {
+	request := codec.EncodeExecutorServiceIsShutdownRequest(es.name)
+	resp, err := es.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		return false, err
+	}
+	return codec.DecodeExecutorServiceIsShutdownResponse(resp), nil
+}
+
+// Shutdown initiates an orderly shutdown of the executor.
+// Previously submitted tasks are executed, but no new tasks are accepted.
+func (es *ExecutorService) Shutdown(ctx context.Context) error {
+	request := codec.EncodeExecutorServiceShutdownRequest(es.name)
+	_, err := es.invokeOnRandomTarget(ctx, request, nil)
+	return err
+}
+
+// SubmitToAllMembers submits the task to all members of the cluster.
+// Returns the futures for each member, keyed by member UUID.
+func (es *ExecutorService) SubmitToAllMembers(ctx context.Context, task interface{}) (map[types.UUID]*ExecutorFuture, error) {
+	taskData, err := es.validateAndSerialize(task)
+	if err != nil {
+		return nil, err
+	}
+	members := es.clusterService.OrderedMembers()
+	futures := make(map[types.UUID]*ExecutorFuture, len(members))
+	for _, mem := range members {
+		futures[mem.UUID] = es.submitToMember(ctx, taskData, mem)
+	}
+	return futures, nil
+}
+
+// SubmitToKeyOwner submits the task to the owner of the given key.
+func (es *ExecutorService) SubmitToKeyOwner(ctx context.Context, task interface{}, key interface{}) (*ExecutorFuture, error) {
+	taskData, keyData, err := es.validateAndSerialize2(task, key)
+	if err != nil {
+		return nil, err
+	}
+	partitionID, err := es.partitionService.GetPartitionID(keyData)
+	if err != nil {
+		return nil, err
+	}
+	taskID := types.NewUUID()
+	request := codec.EncodeExecutorServiceSubmitToPartitionRequest(es.name, taskID, taskData)
+	return newExecutorFuture(ctx, es.proxy, func(ctx context.Context) (*proto.ClientMessage, error) {
+		return es.invokeOnPartition(ctx, request, partitionID)
+	}, codec.DecodeExecutorServiceSubmitToPartitionResponse, func(ctx context.Context, interrupt bool) (bool, error) {
+		request := codec.EncodeExecutorServiceCancelOnPartitionRequest(taskID, interrupt)
+		resp, err := es.invokeOnPartition(ctx, request, partitionID)
+		if err != nil {
+			return false, err
+		}
+		return codec.DecodeExecutorServiceCancelOnPartitionResponse(resp), nil
+	}), nil
+}
+
+// SubmitToMember submits the task to the given member.
+func (es *ExecutorService) SubmitToMember(ctx context.Context, task interface{}, member cluster.MemberInfo) (*ExecutorFuture, error) {
+	taskData, err := es.validateAndSerialize(task)
+	if err != nil {
+		return nil, err
+	}
+	return es.submitToMember(ctx, taskData, member), nil
+}
+
+func (es *ExecutorService) submitToMember(ctx context.Context, taskData iserialization.Data, member cluster.MemberInfo) *ExecutorFuture {
+	taskID := types.NewUUID()
+	request := codec.EncodeExecutorServiceSubmitToMemberRequest(es.name, taskID, taskData, member.UUID)
+	return newExecutorFuture(ctx, es.proxy, func(ctx context.Context) (*proto.ClientMessage, error) {
+		return es.invokeOnMember(ctx, request, &member)
+	}, codec.DecodeExecutorServiceSubmitToMemberResponse, func(ctx context.Context, interrupt bool) (bool, error) {
+		request := codec.EncodeExecutorServiceCancelOnMemberRequest(taskID, member.UUID, interrupt)
+		resp, err := es.invokeOnMember(ctx, request, &member)
+		if err != nil {
+			return false, err
+		}
+		return codec.DecodeExecutorServiceCancelOnMemberResponse(resp), nil
+	})
+}
+
+// ExecutorFuture is the result of a task submitted to an executor.
+// It is safe to call its methods concurrently.
+type ExecutorFuture struct {
+	doneCh   chan struct{}
+	cancelFn func(ctx context.Context, interrupt bool) (bool, error)
+	value    interface{}
+	err      error
+	once     *sync.Once
+}
+
+// newExecutorFuture runs invokeFn in a goroutine and completes the future with its decoded result.
+// The invocation is bound to ctx, so canceling ctx fails the future.
+func newExecutorFuture(ctx context.Context, p *proxy, invokeFn func(ctx context.Context) (*proto.ClientMessage, error), decodeFn func(msg *proto.ClientMessage) iserialization.Data, cancelFn func(ctx context.Context, interrupt bool) (bool, error)) *ExecutorFuture {
+	f := &ExecutorFuture{
+		doneCh:   make(chan struct{}),
+		cancelFn: cancelFn,
+		once:     &sync.Once{},
+	}
+	go func() {
+		resp, err := invokeFn(ctx)
+		if err != nil {
+			f.complete(nil, err)
+			return
+		}
+		f.complete(p.convertToObject(decodeFn(resp)))
+	}()
+	return f
+}
+
+// Cancel attempts to cancel the task.
+// Returns false if the task could not be canceled, e.g., because it has already completed.
+// If the task is canceled, Get returns an error which wraps hzerrors.ErrCancellation.
+// If interrupt is true, the thread running the task on the member is interrupted.
+func (f *ExecutorFuture) Cancel(ctx context.Context, interrupt bool) (bool, error) {
+	if f.cancelFn == nil || f.IsDone() {
+		return false, nil
+	}
+	ok, err := f.cancelFn(ctx, interrupt)
+	if err != nil {
+		return false, err
+	}
+	if ok {
+		f.complete(nil, ihzerrors.NewClientError("task was canceled", nil, hzerrors.ErrCancellation))
+	}
+	return ok, nil
+}
+
+// Done returns a channel which is closed when the future completes.
+func (f *ExecutorFuture) Done() <-chan struct{} {
+	return f.doneCh
+}
+
+// Get waits for the task to complete and returns its result.
+// Returns the error of the context if it is done before the task completes.
+func (f *ExecutorFuture) Get(ctx context.Context) (interface{}, error) {
+	select {
+	case <-f.doneCh:
+		return f.value, f.err
+	case <-ctx.Done():
+		return nil, fmt.Errorf("waiting for the task result: %w", ctx.Err())
+	}
+}
+
+// IsDone returns true if the future is completed.
+func (f *ExecutorFuture) IsDone() bool {
+	select {
+	case <-f.doneCh:
+		return true
+	default:
+		return false
+	}
+}
+
+func (f *ExecutorFuture) complete(value interface{}, err error) {
+	f.once.Do(func() {
+		f.value = value
+		f.err = err
+		close(f.doneCh)
+	})
+}
+
+// taskPartitionID returns the partition of the partition key if the task is PartitionAware, otherwise a random partition.
+func taskPartitionID(p *proxy, task interface{}) (int32, error) {
+	if pa, ok := task.(PartitionAware); ok {
+		if key := pa.PartitionKey(); key != nil {
+			keyData, err := p.convertToData(key)
+			if err != nil {
+				return 0, err
+			}
+			return p.partitionService.GetPartitionID(keyData)
+		}
+	}
+	count := p.partitionService.PartitionCount()
+	if count == 0 {
+		return 0, hzerrors.ErrClientOffline
+	}
+	return rand.Int31n(count), nil
+}
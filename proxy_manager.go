@@ -29,6 +29,8 @@ import (
 
 type proxyManager struct {
 	proxies        *sync.Map
+	creationMu     *sync.Mutex
+	creationLocks  map[string]*creationLock
 	invoker        *client.Invoker
 	serviceBundle  creationBundle
 	refIDGenerator *iproxy.ReferenceIDGenerator
@@ -39,6 +41,8 @@ func newProxyManager(bundle creationBundle) *proxyManager {
 	bundle.Check()
 	return &proxyManager{
 		proxies:        &sync.Map{},
+		creationMu:     &sync.Mutex{},
+		creationLocks:  map[string]*creationLock{},
 		serviceBundle:  bundle,
 		refIDGenerator: iproxy.NewReferenceIDGenerator(1),
 		ncmDestroyFn:   bundle.NCMDestroyFn,
@@ -63,6 +67,14 @@ func (m *proxyManager) getMap(ctx context.Context, name string, fn func(p *proxy
 	return p.(*Map), nil
 }
 
+func (m *proxyManager) getCache(ctx context.Context, name string, fn func(p *proxy) (interface{}, error)) (*Cache, error) {
+	p, err := m.proxyFor(ctx, ServiceNameCache, cacheNamePrefix+name, fn)
+	if err != nil {
+		return nil, err
+	}
+	return p.(*Cache), nil
+}
+
 func (m *proxyManager) getReplicatedMap(ctx context.Context, name string) (*ReplicatedMap, error) {
 	p, err := m.proxyFor(ctx, ServiceNameReplicatedMap, name, func(p *proxy) (interface{}, error) {
 		return newReplicatedMap(p, m.refIDGenerator)
@@ -103,6 +115,20 @@ func (m *proxyManager) getTopic(ctx context.Context, name string) (*Topic, error
 	return p.(*Topic), nil
 }
 
+func (m *proxyManager) getReliableTopic(ctx context.Context, name string) (*ReliableTopic, error) {
+	p, err := m.proxyFor(ctx, ServiceNameReliableTopic, name, func(p *proxy) (interface{}, error) {
+		rb, err := m.getRingbuffer(ctx, reliableTopicRingbufferPrefix+name)
+		if err != nil {
+			return nil, err
+		}
+		return newReliableTopic(p, rb, m.getReliableTopicConfig(name)), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p.(*ReliableTopic), nil
+}
+
 func (m *proxyManager) getList(ctx context.Context, name string) (*List, error) {
 	p, err := m.proxyFor(ctx, ServiceNameList, name, func(p *proxy) (interface{}, error) {
 		return newList(p)
@@ -143,6 +169,55 @@ func (m *proxyManager) getPNCounter(ctx context.Context, name string) (*PNCounte
 	return p.(*PNCounter), nil
 }
 
+func (m *proxyManager) getCardinalityEstimator(ctx context.Context, name string) (*CardinalityEstimator, error) {
+	p, err := m.proxyFor(ctx, ServiceNameCardinalityEstimator, name, func(p *proxy) (interface{}, error) {
+		return newCardinalityEstimator(p)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p.(*CardinalityEstimator), nil
+}
+
+func (m *proxyManager) getExecutorService(ctx context.Context, name string) (*ExecutorService, error) {
+	p, err := m.proxyFor(ctx, ServiceNameExecutor, name, func(p *proxy) (interface{}, error) {
+		return newExecutorService(p), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p.(*ExecutorService), nil
+}
+
+func (m *proxyManager) getDurableExecutorService(ctx context.Context, name string) (*DurableExecutorService, error) {
+	p, err := m.proxyFor(ctx, ServiceNameDurableExecutor, name, func(p *proxy) (interface{}, error) {
+		return newDurableExecutorService(p), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p.(*DurableExecutorService), nil
+}
+
+func (m *proxyManager) getVectorCollection(ctx context.Context, name string) (*VectorCollection, error) {
+	if _, ok := m.proxies.Load(makeProxyName(ServiceNameVectorCollection, name)); !ok {
+		// the collection must be configured on the cluster before it is created
+		if conf, ok := m.serviceBundle.Config.VectorCollections[name]; ok {
+			request := codec.EncodeDynamicConfigAddVectorCollectionConfigRequest(name, conf.Indexes)
+			if _, err := m.invokeOnRandomTarget(ctx, request, nil); err != nil {
+				return nil, err
+			}
+		}
+	}
+	p, err := m.proxyFor(ctx, ServiceNameVectorCollection, name, func(p *proxy) (interface{}, error) {
+		return newVectorCollection(p), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p.(*VectorCollection), nil
+}
+
 func (m *proxyManager) getFlakeIDGenerator(ctx context.Context, name string) (*FlakeIDGenerator, error) {
 	p, err := m.proxyFor(ctx, ServiceNameFlakeIDGenerator, name, func(p *proxy) (interface{}, error) {
 		return newFlakeIdGenerator(p, m.getFlakeIDGeneratorConfig(name), flakeIDBatchFromMemberFn), nil
@@ -188,6 +263,13 @@ func (m *proxyManager) remove(ctx context.Context, serviceName string, objectNam
 		mp.destroyLocally(ctx)
 		m.ncmDestroyFn(serviceName, objectName)
 	}
+	if serviceName == ServiceNameCache {
+		p.(*Cache).destroyLocally(ctx)
+		m.ncmDestroyFn(serviceName, objectName)
+	}
+	if serviceName == ServiceNameReliableTopic {
+		p.(*ReliableTopic).stopRunners()
+	}
 	m.proxies.Delete(name)
 	return true
 }
@@ -198,6 +280,12 @@ func (m *proxyManager) proxyFor(ctx context.Context, serviceName string, objectN
 	if ok {
 		return wrapper, nil
 	}
+	// concurrent callers wait until the proxy is created and stored, so they do not get a partially initialized proxy.
+	unlock := m.lockCreation(name)
+	defer unlock()
+	if wrapper, ok = m.proxies.Load(name); ok {
+		return wrapper, nil
+	}
 	p, err := newProxy(ctx, m.serviceBundle, serviceName, objectName, m.refIDGenerator, func(ctx context.Context) bool {
 		return m.remove(ctx, serviceName, objectName)
 	}, true)
@@ -212,6 +300,35 @@ func (m *proxyManager) proxyFor(ctx context.Context, serviceName string, objectN
 	return wrapper, nil
 }
 
+// creationLock serializes the creation of the proxies with the same name.
+// refs is the number of callers which hold or wait for the lock, the lock is removed when it drops to zero.
+type creationLock struct {
+	mu   sync.Mutex
+	refs int
+}
+
+// lockCreation locks the creation of the proxy with the given name and returns the function which unlocks it.
+func (m *proxyManager) lockCreation(name string) func() {
+	m.creationMu.Lock()
+	lock, ok := m.creationLocks[name]
+	if !ok {
+		lock = &creationLock{}
+		m.creationLocks[name] = lock
+	}
+	lock.refs++
+	m.creationMu.Unlock()
+	lock.mu.Lock()
+	return func() {
+		lock.mu.Unlock()
+		m.creationMu.Lock()
+		lock.refs--
+		if lock.refs == 0 {
+			delete(m.creationLocks, name)
+		}
+		m.creationMu.Unlock()
+	}
+}
+
 func (m *proxyManager) getFlakeIDGeneratorConfig(name string) FlakeIDGeneratorConfig {
 	if conf, ok := m.serviceBundle.Config.FlakeIDGenerators[name]; ok {
 		return conf
@@ -222,6 +339,16 @@ func (m *proxyManager) getFlakeIDGeneratorConfig(name string) FlakeIDGeneratorCo
 	}
 }
 
+func (m *proxyManager) getReliableTopicConfig(name string) ReliableTopicConfig {
+	if conf, ok := m.serviceBundle.Config.ReliableTopics[name]; ok {
+		return conf
+	}
+	return ReliableTopicConfig{
+		ReadBatchSize:  defaultReliableTopicReadBatchSize,
+		OverloadPolicy: TopicOverloadPolicyBlock,
+	}
+}
+
 func makeProxyName(serviceName string, objectName string) string {
 	return serviceName + objectName
 }
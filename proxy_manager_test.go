@@ -0,0 +1,50 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+)
+
+func TestProxyManager_CreationLock(t *testing.T) {
+	lock, count := hz.NewProxyCreationLocker()
+	unlockA := lock("a")
+	// other names are not blocked
+	lock("b")()
+	assert.Equal(t, 1, count())
+	var locked int32
+	doneCh := make(chan struct{})
+	go func() {
+		defer close(doneCh)
+		unlock := lock("a")
+		atomic.StoreInt32(&locked, 1)
+		unlock()
+	}()
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&locked))
+	unlockA()
+	<-doneCh
+	assert.Equal(t, int32(1), atomic.LoadInt32(&locked))
+	// the lock is removed after all of its users unlock it
+	assert.Equal(t, 0, count())
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hazelcast/hazelcast-go-client/aggregate"
@@ -31,6 +32,7 @@ import (
 	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
 	"github.com/hazelcast/hazelcast-go-client/nearcache"
 	"github.com/hazelcast/hazelcast-go-client/predicate"
+	"github.com/hazelcast/hazelcast-go-client/projection"
 	"github.com/hazelcast/hazelcast-go-client/types"
 )
 
@@ -183,12 +185,18 @@ Following Map methods support the Near Cache:
 */
 type Map struct {
 	*proxy
-	ncm          nearCacheMap
-	hasNearCache bool
+	queryCachesMu *sync.Mutex
+	queryCaches   map[string]*QueryCache
+	ncm           nearCacheMap
+	hasNearCache  bool
 }
 
 func newMap(p *proxy) *Map {
-	return &Map{proxy: p}
+	return &Map{
+		proxy:         p,
+		queryCachesMu: &sync.Mutex{},
+		queryCaches:   map[string]*QueryCache{},
+	}
 }
 
 // NewLockContext augments the passed parent context with a unique lock ID.
@@ -918,7 +926,11 @@ func (m *Map) GetEntrySet(ctx context.Context) ([]types.Entry, error) {
 
 // GetEntrySetWithPredicate returns a clone of the mappings contained in this map.
 // See [predicate] for a list of predicates.
+// If the predicate is a paging predicate, only the entries in the current page are returned.
 func (m *Map) GetEntrySetWithPredicate(ctx context.Context, predicate predicate.Predicate) ([]types.Entry, error) {
+	if pp, ok := asPagingPredicate(predicate); ok {
+		return m.getEntrySetWithPagingPredicate(ctx, pp)
+	}
 	if predData, err := m.validateAndSerialize(predicate); err != nil {
 		return nil, err
 	} else {
@@ -994,7 +1006,11 @@ func (m *Map) GetKeySet(ctx context.Context) ([]interface{}, error) {
 
 // GetKeySetWithPredicate returns keys contained in this map.
 // See [predicate] for a list of predicates.
+// If the predicate is a paging predicate, only the keys in the current page are returned.
 func (m *Map) GetKeySetWithPredicate(ctx context.Context, predicate predicate.Predicate) ([]interface{}, error) {
+	if pp, ok := asPagingPredicate(predicate); ok {
+		return m.getKeySetWithPagingPredicate(ctx, pp)
+	}
 	if predicateData, err := m.validateAndSerializePredicate(predicate); err != nil {
 		return nil, err
 	} else {
@@ -1019,7 +1035,11 @@ func (m *Map) GetValues(ctx context.Context) ([]interface{}, error) {
 
 // GetValuesWithPredicate returns a list clone of the values contained in this map.
 // See [predicate] for a list of predicates.
+// If the predicate is a paging predicate, only the values in the current page are returned.
 func (m *Map) GetValuesWithPredicate(ctx context.Context, predicate predicate.Predicate) ([]interface{}, error) {
+	if pp, ok := asPagingPredicate(predicate); ok {
+		return m.getValuesWithPagingPredicate(ctx, pp)
+	}
 	if predicateData, err := m.validateAndSerializePredicate(predicate); err != nil {
 		return nil, err
 	} else {
@@ -1092,6 +1112,47 @@ func (m *Map) LockWithLease(ctx context.Context, key interface{}, leaseTime time
 	return m.lock(ctx, key, leaseTime.Milliseconds())
 }
 
+// Project applies the given projection to all entries and returns the results.
+// See [projection] for a list of supported projections.
+func (m *Map) Project(ctx context.Context, proj projection.Projection) ([]interface{}, error) {
+	projData, err := m.validateAndSerializeProjection(proj)
+	if err != nil {
+		return nil, err
+	}
+	if isIdentityProjection(proj) {
+		return m.projectIdentity(m.GetEntrySet(ctx))
+	}
+	request := codec.EncodeMapProjectRequest(m.name, projData)
+	response, err := m.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.convertToObjects(codec.DecodeMapProjectResponse(response))
+}
+
+// ProjectWithPredicate applies the given projection to the entries which satisfy the given predicate and returns the results.
+// See [projection] for a list of supported projections.
+// See [predicate] for a list of predicates.
+func (m *Map) ProjectWithPredicate(ctx context.Context, proj projection.Projection, pred predicate.Predicate) ([]interface{}, error) {
+	projData, err := m.validateAndSerializeProjection(proj)
+	if err != nil {
+		return nil, err
+	}
+	predData, err := m.validateAndSerializePredicate(pred)
+	if err != nil {
+		return nil, err
+	}
+	if isIdentityProjection(proj) {
+		return m.projectIdentity(m.GetEntrySetWithPredicate(ctx, pred))
+	}
+	request := codec.EncodeMapProjectWithPredicateRequest(m.name, projData, predData)
+	response, err := m.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.convertToObjects(codec.DecodeMapProjectWithPredicateResponse(response))
+}
+
 // Put sets the value for the given key and returns the old value.
 func (m *Map) Put(ctx context.Context, key interface{}, value interface{}) (interface{}, error) {
 	return m.putWithTTL(ctx, key, value, int64(ttlUnset))
@@ -1387,6 +1448,14 @@ func (m *Map) destroyLocally(ctx context.Context) bool {
 			m.logger.Errorf("hazelcast.Map.destroyLocally: %w", err)
 		}
 	}
+	m.queryCachesMu.Lock()
+	for name, qc := range m.queryCaches {
+		if err := qc.destroy(ctx); err != nil {
+			m.logger.Errorf("hazelcast.Map.destroyLocally: query cache %s: %w", name, err)
+		}
+	}
+	m.queryCaches = map[string]*QueryCache{}
+	m.queryCachesMu.Unlock()
 	return true
 }
 
@@ -1423,6 +1492,124 @@ func (m *Map) addEntryListener(ctx context.Context, flags int32, includeValue bo
 	return subscriptionID, err
 }
 
+func (m *Map) getKeySetWithPagingPredicate(ctx context.Context, pred *predicate.PagingPredicate) ([]interface{}, error) {
+	holder, err := m.makePagingPredicateHolder(pred, iterationTypeKey)
+	if err != nil {
+		return nil, err
+	}
+	request := codec.EncodeMapKeySetWithPagingPredicateRequest(m.name, holder)
+	response, err := m.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		return nil, err
+	}
+	keyDatas, anchorHolder := codec.DecodeMapKeySetWithPagingPredicateResponse(response)
+	if err := m.updatePagingAnchors(pred, anchorHolder); err != nil {
+		return nil, err
+	}
+	return m.convertToObjects(keyDatas)
+}
+
+func (m *Map) getValuesWithPagingPredicate(ctx context.Context, pred *predicate.PagingPredicate) ([]interface{}, error) {
+	holder, err := m.makePagingPredicateHolder(pred, iterationTypeValue)
+	if err != nil {
+		return nil, err
+	}
+	request := codec.EncodeMapValuesWithPagingPredicateRequest(m.name, holder)
+	response, err := m.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		return nil, err
+	}
+	valueDatas, anchorHolder := codec.DecodeMapValuesWithPagingPredicateResponse(response)
+	if err := m.updatePagingAnchors(pred, anchorHolder); err != nil {
+		return nil, err
+	}
+	return m.convertToObjects(valueDatas)
+}
+
+func (m *Map) getEntrySetWithPagingPredicate(ctx context.Context, pred *predicate.PagingPredicate) ([]types.Entry, error) {
+	holder, err := m.makePagingPredicateHolder(pred, iterationTypeEntry)
+	if err != nil {
+		return nil, err
+	}
+	request := codec.EncodeMapEntriesWithPagingPredicateRequest(m.name, holder)
+	response, err := m.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		return nil, err
+	}
+	pairs, anchorHolder := codec.DecodeMapEntriesWithPagingPredicateResponse(response)
+	if err := m.updatePagingAnchors(pred, anchorHolder); err != nil {
+		return nil, err
+	}
+	return m.convertPairsToEntries(pairs)
+}
+
+func (m *Map) makePagingPredicateHolder(pred *predicate.PagingPredicate, iterType iterationType) (codec.PagingPredicateHolder, error) {
+	if pred.PageSize() <= 0 {
+		return codec.PagingPredicateHolder{}, ihzerrors.NewIllegalArgumentError("page size must be positive", nil)
+	}
+	if pred.Page() < 0 {
+		return codec.PagingPredicateHolder{}, ihzerrors.NewIllegalArgumentError("page must be non-negative", nil)
+	}
+	if _, ok := asPagingPredicate(pred.Predicate()); ok {
+		return codec.PagingPredicateHolder{}, ihzerrors.NewIllegalArgumentError("nested paging predicates are not supported", nil)
+	}
+	predData, err := m.convertToData(pred.Predicate())
+	if err != nil {
+		return codec.PagingPredicateHolder{}, err
+	}
+	cmpData, err := m.convertToData(pred.Comparator())
+	if err != nil {
+		return codec.PagingPredicateHolder{}, err
+	}
+	anchors := pred.Anchors()
+	pages := make([]int32, len(anchors))
+	pairs := make([]proto.Pair, len(anchors))
+	for i, a := range anchors {
+		keyData, err := m.convertToData(a.Key)
+		if err != nil {
+			return codec.PagingPredicateHolder{}, err
+		}
+		valueData, err := m.convertToData(a.Value)
+		if err != nil {
+			return codec.PagingPredicateHolder{}, err
+		}
+		pages[i] = int32(a.Page)
+		pairs[i] = proto.NewPair(keyData, valueData)
+	}
+	return codec.PagingPredicateHolder{
+		AnchorDataListHolder: codec.AnchorDataListHolder{
+			AnchorPageList: pages,
+			AnchorDataList: pairs,
+		},
+		PredicateData:   predData,
+		ComparatorData:  cmpData,
+		PageSize:        int32(pred.PageSize()),
+		Page:            int32(pred.Page()),
+		IterationTypeID: byte(iterType),
+	}, nil
+}
+
+func (m *Map) updatePagingAnchors(pred *predicate.PagingPredicate, holder codec.AnchorDataListHolder) error {
+	anchors := make([]predicate.PagingAnchor, len(holder.AnchorDataList))
+	for i, pair := range holder.AnchorDataList {
+		key, err := m.convertToObject(pair.Key.(serialization.Data))
+		if err != nil {
+			return err
+		}
+		value, err := m.convertToObject(pair.Value.(serialization.Data))
+		if err != nil {
+			return err
+		}
+		anchors[i] = predicate.PagingAnchor{
+			Key:   key,
+			Value: value,
+			Page:  int(holder.AnchorPageList[i]),
+		}
+	}
+	pred.SetAnchors(anchors)
+	return nil
+}
+
 func (m *Map) loadAll(ctx context.Context, replaceExisting bool, keys ...interface{}) error {
 	if m.hasNearCache {
 		return m.ncm.LoadAll(ctx, m, replaceExisting, keys)
@@ -1552,6 +1739,19 @@ func (m *Map) tryRemove(ctx context.Context, key interface{}, timeout int64) (bo
 	return m.tryRemoveFromRemote(ctx, key, timeout)
 }
 
+// projectIdentity returns the results of the identity projection.
+// The entries are fetched instead of running the identity projection on the cluster, which returns the entries in a member specific format.
+func (m *Map) projectIdentity(entries []types.Entry, err error) ([]interface{}, error) {
+	if err != nil {
+		return nil, err
+	}
+	results := make([]interface{}, len(entries))
+	for i, e := range entries {
+		results[i] = e
+	}
+	return results, nil
+}
+
 func (m *Map) aggregate(ctx context.Context, req *proto.ClientMessage, decoder func(message *proto.ClientMessage) serialization.Data) (interface{}, error) {
 	resp, err := m.invokeOnRandomTarget(ctx, req, nil)
 	if err != nil {
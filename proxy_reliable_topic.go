@@ -0,0 +1,391 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	pubcluster "github.com/hazelcast/hazelcast-go-client/cluster"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+	iproxy "github.com/hazelcast/hazelcast-go-client/internal/proxy"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	reliableTopicRingbufferPrefix = "_hz_rb_"
+	reliableTopicMinBackoff       = 1 * time.Millisecond
+	reliableTopicMaxBackoff       = 2 * time.Second
+	reliableTopicRetryDelay       = 1 * time.Second
+)
+
+// TopicOverloadPolicy determines what happens when a message is published to a ReliableTopic,
+// but there is no space in the backing Ringbuffer.
+// Note that there is no space only if the oldest message in the Ringbuffer has not expired yet.
+type TopicOverloadPolicy int32
+
+const (
+	// TopicOverloadPolicyBlock waits with an exponential backoff until there is space in the Ringbuffer or the context is done.
+	TopicOverloadPolicyBlock TopicOverloadPolicy = iota
+	// TopicOverloadPolicyDiscardOldest overwrites the oldest message, even if it has not expired yet.
+	TopicOverloadPolicyDiscardOldest
+	// TopicOverloadPolicyDiscardNewest discards the message which is being published.
+	TopicOverloadPolicyDiscardNewest
+	// TopicOverloadPolicyError returns hzerrors.ErrTopicOverload.
+	TopicOverloadPolicyError
+)
+
+// ReliableTopicListenerConfig contains configuration for a ReliableTopic message listener.
+type ReliableTopicListenerConfig struct {
+	// StoreSequence is called with the sequence of each message after the handler processes it.
+	// The stored sequence incremented by one can be used as the InitialSequence to resume listening later.
+	StoreSequence func(sequence int64)
+	// InitialSequence is the sequence of the first message to be delivered.
+	// It is used only if UseInitialSequence is true.
+	// Otherwise, only the messages published after the listener is added are delivered.
+	InitialSequence int64
+	// UseInitialSequence enables InitialSequence.
+	UseInitialSequence bool
+	// LossTolerant enables the listener to continue when messages are lost, e.g., because the listener is too slow.
+	// Otherwise the listener is stopped at the first message loss.
+	LossTolerant bool
+}
+
+/*
+ReliableTopic is a Topic backed by a Ringbuffer.
+
+Unlike Topic, a slow or temporarily disconnected listener does not lose the messages as long as they are retained by the backing Ringbuffer.
+Each listener reads the messages from the Ringbuffer in batches and keeps track of its own sequence, so it continues where it left off when the connection to the cluster is restored.
+The backing Ringbuffer is named "_hz_rb_" followed by the name of the topic, and its capacity and time-to-live are configured on the members.
+
+The size of the read batches and the behavior when the Ringbuffer is full can be configured with ReliableTopicConfig.
+*/
+type ReliableTopic struct {
+	*proxy
+	rb        *Ringbuffer
+	runnersMu *sync.Mutex
+	runners   map[types.UUID]*reliableTopicRunner
+	config    ReliableTopicConfig
+}
+
+func newReliableTopic(p *proxy, rb *Ringbuffer, config ReliableTopicConfig) *ReliableTopic {
+	return &ReliableTopic{
+		proxy:     p,
+		rb:        rb,
+		config:    config,
+		runnersMu: &sync.Mutex{},
+		runners:   map[types.UUID]*reliableTopicRunner{},
+	}
+}
+
+// AddMessageListener adds a subscriber to this topic.
+// Only the messages published after this call are delivered to the handler.
+// The listener stops at the first message loss.
+func (t *ReliableTopic) AddMessageListener(ctx context.Context, handler TopicMessageHandler) (types.UUID, error) {
+	return t.AddMessageListenerWithConfig(ctx, ReliableTopicListenerConfig{}, handler)
+}
+
+// AddMessageListenerWithConfig adds a subscriber to this topic with the given configuration.
+func (t *ReliableTopic) AddMessageListenerWithConfig(ctx context.Context, config ReliableTopicListenerConfig, handler TopicMessageHandler) (types.UUID, error) {
+	if handler == nil {
+		return types.UUID{}, ihzerrors.NewIllegalArgumentError("handler should not be nil", nil)
+	}
+	sequence := config.InitialSequence
+	if !config.UseInitialSequence {
+		tail, err := t.rb.TailSequence(ctx)
+		if err != nil {
+			return types.UUID{}, err
+		}
+		sequence = tail + 1
+	} else if sequence < 0 {
+		return types.UUID{}, ihzerrors.NewIllegalArgumentError("initial sequence must be non-negative", nil)
+	}
+	capacity, err := t.rb.Capacity(ctx)
+	if err != nil {
+		return types.UUID{}, err
+	}
+	batchSize := t.config.ReadBatchSize
+	if int64(batchSize) > capacity {
+		batchSize = int32(capacity)
+	}
+	runCtx, cancel := context.WithCancel(context.Background())
+	r := &reliableTopicRunner{
+		id:        types.NewUUID(),
+		topic:     t,
+		handler:   handler,
+		config:    config,
+		cancel:    cancel,
+		sequence:  sequence,
+		batchSize: batchSize,
+	}
+	t.runnersMu.Lock()
+	t.runners[r.id] = r
+	t.runnersMu.Unlock()
+	go r.run(runCtx)
+	return r.id, nil
+}
+
+// Publish publishes the given message to all subscribers of this topic.
+// The behavior when the backing Ringbuffer is full depends on the configured TopicOverloadPolicy.
+func (t *ReliableTopic) Publish(ctx context.Context, message interface{}) error {
+	messageData, err := t.validateAndSerializeReliableTopicMessage(message)
+	if err != nil {
+		return err
+	}
+	return t.publish(ctx, func(policy OverflowPolicy) (int64, error) {
+		return t.rb.Add(ctx, messageData, policy)
+	})
+}
+
+// PublishAll publishes all given messages to all subscribers of this topic.
+// The behavior when the backing Ringbuffer is full depends on the configured TopicOverloadPolicy.
+func (t *ReliableTopic) PublishAll(ctx context.Context, messages ...interface{}) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	items := make([]interface{}, len(messages))
+	for i, message := range messages {
+		messageData, err := t.validateAndSerializeReliableTopicMessage(message)
+		if err != nil {
+			return err
+		}
+		items[i] = messageData
+	}
+	return t.publish(ctx, func(policy OverflowPolicy) (int64, error) {
+		return t.rb.AddAll(ctx, policy, items...)
+	})
+}
+
+// RemoveListener removes the given subscription from this topic.
+func (t *ReliableTopic) RemoveListener(ctx context.Context, subscriptionID types.UUID) error {
+	t.runnersMu.Lock()
+	r, ok := t.runners[subscriptionID]
+	delete(t.runners, subscriptionID)
+	t.runnersMu.Unlock()
+	if !ok {
+		return ihzerrors.NewIllegalArgumentError(fmt.Sprintf("no listener with ID %s", subscriptionID), nil)
+	}
+	r.cancel()
+	return nil
+}
+
+// Destroy stops all listeners, and destroys this topic and its backing Ringbuffer on the cluster.
+func (t *ReliableTopic) Destroy(ctx context.Context) error {
+	t.stopRunners()
+	if err := t.proxy.Destroy(ctx); err != nil {
+		return err
+	}
+	return t.rb.Destroy(ctx)
+}
+
+func (t *ReliableTopic) stopRunners() {
+	t.runnersMu.Lock()
+	runners := t.runners
+	t.runners = map[types.UUID]*reliableTopicRunner{}
+	t.runnersMu.Unlock()
+	for _, r := range runners {
+		r.cancel()
+	}
+}
+
+func (t *ReliableTopic) removeRunner(id types.UUID) {
+	t.runnersMu.Lock()
+	delete(t.runners, id)
+	t.runnersMu.Unlock()
+}
+
+func (t *ReliableTopic) validateAndSerializeReliableTopicMessage(message interface{}) (iserialization.Data, error) {
+	payload, err := t.validateAndSerialize(message)
+	if err != nil {
+		return nil, err
+	}
+	return t.serializationService.ToData(&iproxy.ReliableTopicMessage{
+		PublishTime: time.Now().UnixMilli(),
+		Payload:     payload,
+	})
+}
+
+func (t *ReliableTopic) publish(ctx context.Context, add func(policy OverflowPolicy) (int64, error)) error {
+	switch t.config.OverloadPolicy {
+	case TopicOverloadPolicyDiscardOldest:
+		_, err := add(OverflowPolicyOverwrite)
+		return err
+	case TopicOverloadPolicyDiscardNewest:
+		// the message is dropped silently if there is no space
+		_, err := add(OverflowPolicyFail)
+		return err
+	case TopicOverloadPolicyError:
+		seq, err := add(OverflowPolicyFail)
+		if err != nil {
+			return err
+		}
+		if seq == ReadResultSetSequenceUnavailable {
+			return ihzerrors.NewClientError(fmt.Sprintf("failed to publish message to topic %s, no space left", t.name), nil, hzerrors.ErrTopicOverload)
+		}
+		return nil
+	}
+	// TopicOverloadPolicyBlock
+	backoff := reliableTopicMinBackoff
+	for {
+		seq, err := add(OverflowPolicyFail)
+		if err != nil {
+			return err
+		}
+		if seq != ReadResultSetSequenceUnavailable {
+			return nil
+		}
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		backoff *= 2
+		if backoff > reliableTopicMaxBackoff {
+			backoff = reliableTopicMaxBackoff
+		}
+	}
+}
+
+func (t *ReliableTopic) memberByAddress(addr *iproxy.Address) pubcluster.MemberInfo {
+	if addr == nil {
+		return pubcluster.MemberInfo{}
+	}
+	target := pubcluster.NewAddress(addr.Host, addr.Port)
+	for _, mem := range t.clusterService.OrderedMembers() {
+		if mem.Address.Equal(target) {
+			return mem
+		}
+	}
+	return pubcluster.MemberInfo{}
+}
+
+// reliableTopicRunner reads the messages of a reliable topic for a single listener.
+type reliableTopicRunner struct {
+	topic     *ReliableTopic
+	handler   TopicMessageHandler
+	cancel    context.CancelFunc
+	config    ReliableTopicListenerConfig
+	sequence  int64
+	batchSize int32
+	id        types.UUID
+}
+
+func (r *reliableTopicRunner) run(ctx context.Context) {
+	defer r.topic.removeRunner(r.id)
+	for ctx.Err() == nil {
+		rs, err := r.topic.rb.ReadMany(ctx, r.sequence, 1, r.batchSize, nil)
+		if err != nil {
+			if !r.handleError(ctx, err) {
+				return
+			}
+			continue
+		}
+		if !r.process(rs) {
+			return
+		}
+	}
+}
+
+// process delivers the messages in the given result set and returns false if the listener should be stopped.
+func (r *reliableTopicRunner) process(rs ReadResultSet) bool {
+	// the sequence may jump forward if the messages were overwritten, or backward if the Ringbuffer was lost
+	if lost := rs.GetNextSequenceToReadFrom() - int64(rs.ReadCount()) - r.sequence; lost != 0 {
+		if !r.config.LossTolerant {
+			r.topic.logger.Errorf("terminating the listener %s on reliable topic %s: %d messages lost, the listener is not loss tolerant", r.id, r.topic.name, lost)
+			return false
+		}
+		r.topic.logger.Warnf("listener %s on reliable topic %s lost %d messages", r.id, r.topic.name, lost)
+	}
+	for i := 0; i < rs.Size(); i++ {
+		item, err := rs.Get(i)
+		if err != nil {
+			r.topic.logger.Warnf("cannot convert data to Go value: %v", err)
+			continue
+		}
+		msg, ok := item.(*iproxy.ReliableTopicMessage)
+		if !ok {
+			r.topic.logger.Warnf("unexpected item in the Ringbuffer of reliable topic %s: %v", r.topic.name, item)
+			continue
+		}
+		value, err := r.topic.convertToObject(msg.Payload)
+		if err != nil {
+			r.topic.logger.Warnf("cannot convert data to Go value: %v", err)
+			continue
+		}
+		member := r.topic.memberByAddress(msg.PublisherAddress)
+		r.handler(newMessagePublished(r.topic.name, value, time.Unix(0, msg.PublishTime*1_000_000), member))
+		if r.config.StoreSequence != nil {
+			if seq, err := rs.GetSequence(i); err == nil {
+				r.config.StoreSequence(seq)
+			}
+		}
+	}
+	r.sequence = rs.GetNextSequenceToReadFrom()
+	return true
+}
+
+// handleError returns true if reading should be retried after the given error.
+func (r *reliableTopicRunner) handleError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	switch {
+	case errors.Is(err, hzerrors.ErrStaleSequence):
+		if !r.config.LossTolerant {
+			r.topic.logger.Errorf("terminating the listener %s on reliable topic %s: messages lost, the listener is not loss tolerant", r.id, r.topic.name)
+			return false
+		}
+		head, err := r.topic.rb.HeadSequence(ctx)
+		if err != nil {
+			return r.handleError(ctx, err)
+		}
+		r.topic.logger.Warnf("listener %s on reliable topic %s lost %d messages", r.id, r.topic.name, head-r.sequence)
+		r.sequence = head
+		return true
+	case errors.Is(err, hzerrors.ErrOperationTimeout):
+		// the blocking read timed out since no messages were published
+		return true
+	case errors.Is(err, hzerrors.ErrClientNotActive), errors.Is(err, hzerrors.ErrDistributedObjectDestroyed):
+		return false
+	case ihzerrors.IsRetryable(err),
+		errors.Is(err, hzerrors.ErrClientOffline),
+		errors.Is(err, hzerrors.ErrHazelcastInstanceNotActive),
+		errors.Is(err, hzerrors.ErrTargetDisconnected),
+		errors.Is(err, hzerrors.ErrIO):
+		// the member may be restarting, or the client may be reconnecting
+		r.topic.logger.Debug(func() string {
+			return fmt.Sprintf("listener %s on reliable topic %s will retry reading: %s", r.id, r.topic.name, err.Error())
+		})
+		timer := time.NewTimer(reliableTopicRetryDelay)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return false
+		case <-timer.C:
+			return true
+		}
+	}
+	r.topic.logger.Errorf("terminating the listener %s on reliable topic %s: %w", r.id, r.topic.name, err)
+	return false
+}
@@ -254,6 +254,8 @@ func (rb *Ringbuffer) RemainingCapacity(ctx context.Context) (int64, error) {
 // If the filter is not null, only items where the filter function returns true are returned.
 // Using filters is a good way to prevent getting items that are of no value to the receiver.
 // This reduces the amount of IO and the number of operations being executed, and can result in a significant performance improvement.
+// A filter other than RingbufferFilterFunc is executed on the members and must have a member side implementation.
+// A RingbufferFilterFunc is executed on the client after reading the items, so minCount applies to the items before filtering.
 // If the startSequence is smaller than the smallest sequence still available in the Ringbuffer (HeadSequence}, then the smallest available sequence will be used as the start sequence and the minimum/maximum number of items will be attempted to be read from there on.
 // If the startSequence is bigger than the last available sequence in the Ringbuffer (TailSequence), then the last available sequence plus one will be used as the start sequence and the call will block until further items become available and it can read at least the minimum number of items.
 func (rb *Ringbuffer) ReadMany(ctx context.Context, startSequence int64, minCount int32, maxCount int32, filter interface{}) (ReadResultSet, error) {
@@ -277,7 +279,9 @@ func (rb *Ringbuffer) ReadMany(ctx context.Context, startSequence int64, minCoun
 		return ReadResultSet{}, ihzerrors.NewIllegalArgumentError("the maxCount should be smaller than or equal to the capacity", err)
 	}
 	var serializedFilterData iserialization.Data
-	if filter != nil {
+	// a RingbufferFilterFunc cannot be serialized, it is applied to the items read below
+	filterFunc, isFilterFunc := filter.(RingbufferFilterFunc)
+	if !isFilterFunc && !check.Nil(filter) {
 		data, err := rb.validateAndSerialize(filter)
 		serializedFilterData = data
 		if err != nil {
@@ -295,6 +299,9 @@ func (rb *Ringbuffer) ReadMany(ctx context.Context, startSequence int64, minCoun
 	for i := 0; i < len(items); i++ {
 		convertedItems[i], errors[i] = rb.convertToObject(items[i])
 	}
+	if filterFunc != nil {
+		convertedItems, errors, itemSeqs = filterReadItems(filterFunc, convertedItems, errors, itemSeqs)
+	}
 	return ReadResultSet{
 		readCount:        readCount,
 		conversionErrors: errors,
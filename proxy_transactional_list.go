@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+)
+
+/*
+TransactionalList is the transactional version of List.
+
+TransactionalList is retrieved using TransactionContext.GetList after the transaction begins.
+*/
+type TransactionalList struct {
+	*transactionalProxy
+}
+
+func newTransactionalList(p *transactionalProxy) *TransactionalList {
+	return &TransactionalList{transactionalProxy: p}
+}
+
+// Add adds the given item to the list.
+// Returns true if the list is changed.
+func (l *TransactionalList) Add(ctx context.Context, item interface{}) (bool, error) {
+	itemData, err := l.validateAndSerialize(item)
+	if err != nil {
+		return false, err
+	}
+	request := codec.EncodeTransactionalListAddRequest(l.name, l.txnID, l.threadID, itemData)
+	response, err := l.invoke(ctx, request)
+	if err != nil {
+		return false, err
+	}
+	return codec.DecodeTransactionalListAddResponse(response), nil
+}
+
+// Remove removes the given item from the list.
+// Returns true if the item was in the list.
+func (l *TransactionalList) Remove(ctx context.Context, item interface{}) (bool, error) {
+	itemData, err := l.validateAndSerialize(item)
+	if err != nil {
+		return false, err
+	}
+	request := codec.EncodeTransactionalListRemoveRequest(l.name, l.txnID, l.threadID, itemData)
+	response, err := l.invoke(ctx, request)
+	if err != nil {
+		return false, err
+	}
+	return codec.DecodeTransactionalListRemoveResponse(response), nil
+}
+
+// Size returns the number of items in the list.
+func (l *TransactionalList) Size(ctx context.Context) (int, error) {
+	request := codec.EncodeTransactionalListSizeRequest(l.name, l.txnID, l.threadID)
+	response, err := l.invoke(ctx, request)
+	if err != nil {
+		return 0, err
+	}
+	return int(codec.DecodeTransactionalListSizeResponse(response)), nil
+}
@@ -0,0 +1,285 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	"github.com/hazelcast/hazelcast-go-client/predicate"
+)
+
+/*
+TransactionalMap is the transactional version of Map.
+
+The changes made through a TransactionalMap are visible to other clients only after the transaction is committed.
+TransactionalMap is retrieved using TransactionContext.GetMap after the transaction begins.
+*/
+type TransactionalMap struct {
+	*transactionalProxy
+}
+
+func newTransactionalMap(p *transactionalProxy) *TransactionalMap {
+	return &TransactionalMap{transactionalProxy: p}
+}
+
+// ContainsKey returns true if the map contains an entry with the given key.
+func (m *TransactionalMap) ContainsKey(ctx context.Context, key interface{}) (bool, error) {
+	keyData, err := m.validateAndSerialize(key)
+	if err != nil {
+		return false, err
+	}
+	request := codec.EncodeTransactionalMapContainsKeyRequest(m.name, m.txnID, m.threadID, keyData)
+	response, err := m.invoke(ctx, request)
+	if err != nil {
+		return false, err
+	}
+	return codec.DecodeTransactionalMapContainsKeyResponse(response), nil
+}
+
+// Get returns the value for the given key, or nil if the map does not contain the key.
+func (m *TransactionalMap) Get(ctx context.Context, key interface{}) (interface{}, error) {
+	keyData, err := m.validateAndSerialize(key)
+	if err != nil {
+		return nil, err
+	}
+	request := codec.EncodeTransactionalMapGetRequest(m.name, m.txnID, m.threadID, keyData)
+	response, err := m.invoke(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return m.convertToObject(codec.DecodeTransactionalMapGetResponse(response))
+}
+
+// GetForUpdate locks the key and returns its value.
+// The lock is released when the transaction is committed or rolled back.
+func (m *TransactionalMap) GetForUpdate(ctx context.Context, key interface{}) (interface{}, error) {
+	keyData, err := m.validateAndSerialize(key)
+	if err != nil {
+		return nil, err
+	}
+	request := codec.EncodeTransactionalMapGetForUpdateRequest(m.name, m.txnID, m.threadID, keyData)
+	response, err := m.invoke(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return m.convertToObject(codec.DecodeTransactionalMapGetForUpdateResponse(response))
+}
+
+// Size returns the number of entries in the map.
+func (m *TransactionalMap) Size(ctx context.Context) (int, error) {
+	request := codec.EncodeTransactionalMapSizeRequest(m.name, m.txnID, m.threadID)
+	response, err := m.invoke(ctx, request)
+	if err != nil {
+		return 0, err
+	}
+	return int(codec.DecodeTransactionalMapSizeResponse(response)), nil
+}
+
+// IsEmpty returns true if the map does not have any entries.
+func (m *TransactionalMap) IsEmpty(ctx context.Context) (bool, error) {
+	request := codec.EncodeTransactionalMapIsEmptyRequest(m.name, m.txnID, m.threadID)
+	response, err := m.invoke(ctx, request)
+	if err != nil {
+		return false, err
+	}
+	return codec.DecodeTransactionalMapIsEmptyResponse(response), nil
+}
+
+// Put sets the value for the given key and returns the old value.
+func (m *TransactionalMap) Put(ctx context.Context, key interface{}, value interface{}) (interface{}, error) {
+	return m.putWithTTL(ctx, key, value, ttlUnset)
+}
+
+// PutWithTTL sets the value for the given key and returns the old value.
+// Entry will expire and get evicted after the ttl.
+func (m *TransactionalMap) PutWithTTL(ctx context.Context, key interface{}, value interface{}, ttl time.Duration) (interface{}, error) {
+	return m.putWithTTL(ctx, key, value, ttl.Milliseconds())
+}
+
+// Set sets the value for the given key.
+// It is more efficient than Put, since it does not return the old value.
+func (m *TransactionalMap) Set(ctx context.Context, key interface{}, value interface{}) error {
+	keyData, valueData, err := m.validateAndSerialize2(key, value)
+	if err != nil {
+		return err
+	}
+	request := codec.EncodeTransactionalMapSetRequest(m.name, m.txnID, m.threadID, keyData, valueData)
+	_, err = m.invoke(ctx, request)
+	return err
+}
+
+// PutIfAbsent associates the key with the given value if it is not already associated.
+// Returns the old value if the key was associated, nil otherwise.
+func (m *TransactionalMap) PutIfAbsent(ctx context.Context, key interface{}, value interface{}) (interface{}, error) {
+	keyData, valueData, err := m.validateAndSerialize2(key, value)
+	if err != nil {
+		return nil, err
+	}
+	request := codec.EncodeTransactionalMapPutIfAbsentRequest(m.name, m.txnID, m.threadID, keyData, valueData)
+	response, err := m.invoke(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return m.convertToObject(codec.DecodeTransactionalMapPutIfAbsentResponse(response))
+}
+
+// Replace replaces the entry for a key only if it is currently mapped to some value and returns the previous value.
+func (m *TransactionalMap) Replace(ctx context.Context, key interface{}, value interface{}) (interface{}, error) {
+	keyData, valueData, err := m.validateAndSerialize2(key, value)
+	if err != nil {
+		return nil, err
+	}
+	request := codec.EncodeTransactionalMapReplaceRequest(m.name, m.txnID, m.threadID, keyData, valueData)
+	response, err := m.invoke(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return m.convertToObject(codec.DecodeTransactionalMapReplaceResponse(response))
+}
+
+// ReplaceIfSame replaces the entry for a key only if it is currently mapped to the given value.
+// Returns true if the value was replaced.
+func (m *TransactionalMap) ReplaceIfSame(ctx context.Context, key interface{}, oldValue interface{}, newValue interface{}) (bool, error) {
+	keyData, oldValueData, newValueData, err := m.validateAndSerialize3(key, oldValue, newValue)
+	if err != nil {
+		return false, err
+	}
+	request := codec.EncodeTransactionalMapReplaceIfSameRequest(m.name, m.txnID, m.threadID, keyData, oldValueData, newValueData)
+	response, err := m.invoke(ctx, request)
+	if err != nil {
+		return false, err
+	}
+	return codec.DecodeTransactionalMapReplaceIfSameResponse(response), nil
+}
+
+// Remove deletes the value for the given key and returns it.
+func (m *TransactionalMap) Remove(ctx context.Context, key interface{}) (interface{}, error) {
+	keyData, err := m.validateAndSerialize(key)
+	if err != nil {
+		return nil, err
+	}
+	request := codec.EncodeTransactionalMapRemoveRequest(m.name, m.txnID, m.threadID, keyData)
+	response, err := m.invoke(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return m.convertToObject(codec.DecodeTransactionalMapRemoveResponse(response))
+}
+
+// RemoveIfSame removes the entry with the given key if it is currently mapped to the given value.
+// Returns true if the entry was removed.
+func (m *TransactionalMap) RemoveIfSame(ctx context.Context, key interface{}, value interface{}) (bool, error) {
+	keyData, valueData, err := m.validateAndSerialize2(key, value)
+	if err != nil {
+		return false, err
+	}
+	request := codec.EncodeTransactionalMapRemoveIfSameRequest(m.name, m.txnID, m.threadID, keyData, valueData)
+	response, err := m.invoke(ctx, request)
+	if err != nil {
+		return false, err
+	}
+	return codec.DecodeTransactionalMapRemoveIfSameResponse(response), nil
+}
+
+// Delete removes the mapping for a key from the map if it is present.
+// Unlike Remove, this method does not return the removed value.
+func (m *TransactionalMap) Delete(ctx context.Context, key interface{}) error {
+	keyData, err := m.validateAndSerialize(key)
+	if err != nil {
+		return err
+	}
+	request := codec.EncodeTransactionalMapDeleteRequest(m.name, m.txnID, m.threadID, keyData)
+	_, err = m.invoke(ctx, request)
+	return err
+}
+
+// GetKeySet returns keys contained in the map.
+func (m *TransactionalMap) GetKeySet(ctx context.Context) ([]interface{}, error) {
+	request := codec.EncodeTransactionalMapKeySetRequest(m.name, m.txnID, m.threadID)
+	response, err := m.invoke(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return m.convertToObjects(codec.DecodeTransactionalMapKeySetResponse(response))
+}
+
+// GetKeySetWithPredicate returns keys contained in the map which satisfy the given predicate.
+func (m *TransactionalMap) GetKeySetWithPredicate(ctx context.Context, predicate predicate.Predicate) ([]interface{}, error) {
+	predicateData, err := m.validateAndSerializePredicate(predicate)
+	if err != nil {
+		return nil, err
+	}
+	request := codec.EncodeTransactionalMapKeySetWithPredicateRequest(m.name, m.txnID, m.threadID, predicateData)
+	response, err := m.invoke(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return m.convertToObjects(codec.DecodeTransactionalMapKeySetWithPredicateResponse(response))
+}
+
+// GetValues returns values contained in the map.
+func (m *TransactionalMap) GetValues(ctx context.Context) ([]interface{}, error) {
+	request := codec.EncodeTransactionalMapValuesRequest(m.name, m.txnID, m.threadID)
+	response, err := m.invoke(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return m.convertToObjects(codec.DecodeTransactionalMapValuesResponse(response))
+}
+
+// GetValuesWithPredicate returns values contained in the map which satisfy the given predicate.
+func (m *TransactionalMap) GetValuesWithPredicate(ctx context.Context, predicate predicate.Predicate) ([]interface{}, error) {
+	predicateData, err := m.validateAndSerializePredicate(predicate)
+	if err != nil {
+		return nil, err
+	}
+	request := codec.EncodeTransactionalMapValuesWithPredicateRequest(m.name, m.txnID, m.threadID, predicateData)
+	response, err := m.invoke(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return m.convertToObjects(codec.DecodeTransactionalMapValuesWithPredicateResponse(response))
+}
+
+// ContainsValue returns true if the map contains an entry with the given value.
+func (m *TransactionalMap) ContainsValue(ctx context.Context, value interface{}) (bool, error) {
+	valueData, err := m.validateAndSerialize(value)
+	if err != nil {
+		return false, err
+	}
+	request := codec.EncodeTransactionalMapContainsValueRequest(m.name, m.txnID, m.threadID, valueData)
+	response, err := m.invoke(ctx, request)
+	if err != nil {
+		return false, err
+	}
+	return codec.DecodeTransactionalMapContainsValueResponse(response), nil
+}
+
+func (m *TransactionalMap) putWithTTL(ctx context.Context, key interface{}, value interface{}, ttl int64) (interface{}, error) {
+	keyData, valueData, err := m.validateAndSerialize2(key, value)
+	if err != nil {
+		return nil, err
+	}
+	request := codec.EncodeTransactionalMapPutRequest(m.name, m.txnID, m.threadID, keyData, valueData, ttl)
+	response, err := m.invoke(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return m.convertToObject(codec.DecodeTransactionalMapPutResponse(response))
+}
@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+)
+
+/*
+TransactionalMultiMap is the transactional version of MultiMap.
+
+TransactionalMultiMap is retrieved using TransactionContext.GetMultiMap after the transaction begins.
+*/
+type TransactionalMultiMap struct {
+	*transactionalProxy
+}
+
+func newTransactionalMultiMap(p *transactionalProxy) *TransactionalMultiMap {
+	return &TransactionalMultiMap{transactionalProxy: p}
+}
+
+// Put appends the value for the given key to the corresponding value list.
+// Returns true if the multi-map is changed.
+func (m *TransactionalMultiMap) Put(ctx context.Context, key interface{}, value interface{}) (bool, error) {
+	keyData, valueData, err := m.validateAndSerialize2(key, value)
+	if err != nil {
+		return false, err
+	}
+	request := codec.EncodeTransactionalMultiMapPutRequest(m.name, m.txnID, m.threadID, keyData, valueData)
+	response, err := m.invoke(ctx, request)
+	if err != nil {
+		return false, err
+	}
+	return codec.DecodeTransactionalMultiMapPutResponse(response), nil
+}
+
+// Get returns values for the given key.
+func (m *TransactionalMultiMap) Get(ctx context.Context, key interface{}) ([]interface{}, error) {
+	keyData, err := m.validateAndSerialize(key)
+	if err != nil {
+		return nil, err
+	}
+	request := codec.EncodeTransactionalMultiMapGetRequest(m.name, m.txnID, m.threadID, keyData)
+	response, err := m.invoke(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return m.convertToObjects(codec.DecodeTransactionalMultiMapGetResponse(response))
+}
+
+// Remove deletes all the values corresponding to the given key and returns them.
+func (m *TransactionalMultiMap) Remove(ctx context.Context, key interface{}) ([]interface{}, error) {
+	keyData, err := m.validateAndSerialize(key)
+	if err != nil {
+		return nil, err
+	}
+	request := codec.EncodeTransactionalMultiMapRemoveRequest(m.name, m.txnID, m.threadID, keyData)
+	response, err := m.invoke(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return m.convertToObjects(codec.DecodeTransactionalMultiMapRemoveResponse(response))
+}
+
+// RemoveEntry removes the specified value for the given key.
+// Returns true if the entry was removed.
+func (m *TransactionalMultiMap) RemoveEntry(ctx context.Context, key interface{}, value interface{}) (bool, error) {
+	keyData, valueData, err := m.validateAndSerialize2(key, value)
+	if err != nil {
+		return false, err
+	}
+	request := codec.EncodeTransactionalMultiMapRemoveEntryRequest(m.name, m.txnID, m.threadID, keyData, valueData)
+	response, err := m.invoke(ctx, request)
+	if err != nil {
+		return false, err
+	}
+	return codec.DecodeTransactionalMultiMapRemoveEntryResponse(response), nil
+}
+
+// ValueCount returns the number of values that match the given key in the multi-map.
+func (m *TransactionalMultiMap) ValueCount(ctx context.Context, key interface{}) (int, error) {
+	keyData, err := m.validateAndSerialize(key)
+	if err != nil {
+		return 0, err
+	}
+	request := codec.EncodeTransactionalMultiMapValueCountRequest(m.name, m.txnID, m.threadID, keyData)
+	response, err := m.invoke(ctx, request)
+	if err != nil {
+		return 0, err
+	}
+	return int(codec.DecodeTransactionalMultiMapValueCountResponse(response)), nil
+}
+
+// Size returns the number of entries in the multi-map.
+func (m *TransactionalMultiMap) Size(ctx context.Context) (int, error) {
+	request := codec.EncodeTransactionalMultiMapSizeRequest(m.name, m.txnID, m.threadID)
+	response, err := m.invoke(ctx, request)
+	if err != nil {
+		return 0, err
+	}
+	return int(codec.DecodeTransactionalMultiMapSizeResponse(response)), nil
+}
@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+)
+
+/*
+TransactionalQueue is the transactional version of Queue.
+
+TransactionalQueue is retrieved using TransactionContext.GetQueue after the transaction begins.
+*/
+type TransactionalQueue struct {
+	*transactionalProxy
+}
+
+func newTransactionalQueue(p *transactionalProxy) *TransactionalQueue {
+	return &TransactionalQueue{transactionalProxy: p}
+}
+
+// Offer inserts the given value in this queue.
+// Returns false if the queue is full.
+func (q *TransactionalQueue) Offer(ctx context.Context, value interface{}) (bool, error) {
+	return q.OfferWithTimeout(ctx, value, 0)
+}
+
+// OfferWithTimeout inserts the given value in this queue.
+// Waits up to the given timeout, if the queue is full.
+// Returns false if the value cannot be inserted within the timeout.
+func (q *TransactionalQueue) OfferWithTimeout(ctx context.Context, value interface{}, timeout time.Duration) (bool, error) {
+	valueData, err := q.validateAndSerialize(value)
+	if err != nil {
+		return false, err
+	}
+	request := codec.EncodeTransactionalQueueOfferRequest(q.name, q.txnID, q.threadID, valueData, timeout.Milliseconds())
+	response, err := q.invoke(ctx, request)
+	if err != nil {
+		return false, err
+	}
+	return codec.DecodeTransactionalQueueOfferResponse(response), nil
+}
+
+// Take retrieves and removes the head of this queue.
+// Waits until an item becomes available.
+func (q *TransactionalQueue) Take(ctx context.Context) (interface{}, error) {
+	request := codec.EncodeTransactionalQueueTakeRequest(q.name, q.txnID, q.threadID)
+	response, err := q.invoke(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return q.convertToObject(codec.DecodeTransactionalQueueTakeResponse(response))
+}
+
+// Poll retrieves and removes the head of this queue.
+// Returns nil if the queue is empty.
+func (q *TransactionalQueue) Poll(ctx context.Context) (interface{}, error) {
+	return q.PollWithTimeout(ctx, 0)
+}
+
+// PollWithTimeout retrieves and removes the head of this queue.
+// Waits up to the given timeout if the queue is empty.
+// Returns nil if no item becomes available within the timeout.
+func (q *TransactionalQueue) PollWithTimeout(ctx context.Context, timeout time.Duration) (interface{}, error) {
+	request := codec.EncodeTransactionalQueuePollRequest(q.name, q.txnID, q.threadID, timeout.Milliseconds())
+	response, err := q.invoke(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return q.convertToObject(codec.DecodeTransactionalQueuePollResponse(response))
+}
+
+// Peek retrieves the head of queue without removing it from the queue.
+// Returns nil if the queue is empty.
+func (q *TransactionalQueue) Peek(ctx context.Context) (interface{}, error) {
+	return q.PeekWithTimeout(ctx, 0)
+}
+
+// PeekWithTimeout retrieves the head of queue without removing it from the queue.
+// Waits up to the given timeout if the queue is empty.
+// Returns nil if no item becomes available within the timeout.
+func (q *TransactionalQueue) PeekWithTimeout(ctx context.Context, timeout time.Duration) (interface{}, error) {
+	request := codec.EncodeTransactionalQueuePeekRequest(q.name, q.txnID, q.threadID, timeout.Milliseconds())
+	response, err := q.invoke(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return q.convertToObject(codec.DecodeTransactionalQueuePeekResponse(response))
+}
+
+// Size returns the number of elements in this queue.
+func (q *TransactionalQueue) Size(ctx context.Context) (int, error) {
+	request := codec.EncodeTransactionalQueueSizeRequest(q.name, q.txnID, q.threadID)
+	response, err := q.invoke(ctx, request)
+	if err != nil {
+		return 0, err
+	}
+	return int(codec.DecodeTransactionalQueueSizeResponse(response)), nil
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+)
+
+/*
+TransactionalSet is the transactional version of Set.
+
+TransactionalSet is retrieved using TransactionContext.GetSet after the transaction begins.
+*/
+type TransactionalSet struct {
+	*transactionalProxy
+}
+
+func newTransactionalSet(p *transactionalProxy) *TransactionalSet {
+	return &TransactionalSet{transactionalProxy: p}
+}
+
+// Add adds the given item to the set.
+// Returns true if the set is changed.
+func (s *TransactionalSet) Add(ctx context.Context, item interface{}) (bool, error) {
+	itemData, err := s.validateAndSerialize(item)
+	if err != nil {
+		return false, err
+	}
+	request := codec.EncodeTransactionalSetAddRequest(s.name, s.txnID, s.threadID, itemData)
+	response, err := s.invoke(ctx, request)
+	if err != nil {
+		return false, err
+	}
+	return codec.DecodeTransactionalSetAddResponse(response), nil
+}
+
+// Remove removes the given item from the set.
+// Returns true if the item was in the set.
+func (s *TransactionalSet) Remove(ctx context.Context, item interface{}) (bool, error) {
+	itemData, err := s.validateAndSerialize(item)
+	if err != nil {
+		return false, err
+	}
+	request := codec.EncodeTransactionalSetRemoveRequest(s.name, s.txnID, s.threadID, itemData)
+	response, err := s.invoke(ctx, request)
+	if err != nil {
+		return false, err
+	}
+	return codec.DecodeTransactionalSetRemoveResponse(response), nil
+}
+
+// Size returns the number of items in the set.
+func (s *TransactionalSet) Size(ctx context.Context) (int, error) {
+	request := codec.EncodeTransactionalSetSizeRequest(s.name, s.txnID, s.threadID)
+	response, err := s.invoke(ctx, request)
+	if err != nil {
+		return 0, err
+	}
+	return int(codec.DecodeTransactionalSetSizeResponse(response)), nil
+}
@@ -0,0 +1,160 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+
+	"github.com/hazelcast/hazelcast-go-client/cluster"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+// TypedList is a type-safe wrapper around List with elements of type T.
+// Elements which cannot be converted to T cause an error which wraps hzerrors.ErrClassCast.
+type TypedList[T any] struct {
+	l *List
+}
+
+// TypedListItemNotified is the typed counterpart of ListItemNotified.
+type TypedListItemNotified[T any] struct {
+	Value     T
+	ListName  string
+	Member    cluster.MemberInfo
+	EventType ItemEventType
+}
+
+// NewTypedList returns a TypedList which wraps the given list.
+func NewTypedList[T any](l *List) *TypedList[T] {
+	return &TypedList[T]{l: l}
+}
+
+// GetTypedList returns a TypedList instance.
+func GetTypedList[T any](ctx context.Context, c *Client, name string) (*TypedList[T], error) {
+	l, err := c.GetList(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return NewTypedList[T](l), nil
+}
+
+// Unwrap returns the underlying List.
+func (l *TypedList[T]) Unwrap() *List {
+	return l.l
+}
+
+// Name returns the name of the list.
+func (l *TypedList[T]) Name() string {
+	return l.l.Name()
+}
+
+// Add appends the specified element to the end of this list.
+func (l *TypedList[T]) Add(ctx context.Context, element T) (bool, error) {
+	return l.l.Add(ctx, element)
+}
+
+// AddAll appends all elements in the specified slice to the end of this list.
+func (l *TypedList[T]) AddAll(ctx context.Context, elements ...T) (bool, error) {
+	return l.l.AddAll(ctx, untypedSlice(elements)...)
+}
+
+// AddAllAt inserts all elements in the specified slice at specified index, keeping the order of the slice.
+func (l *TypedList[T]) AddAllAt(ctx context.Context, index int, elements ...T) (bool, error) {
+	return l.l.AddAllAt(ctx, index, untypedSlice(elements)...)
+}
+
+// AddListener adds an item listener for this list.
+// Events with items that cannot be converted to T are logged and dropped.
+func (l *TypedList[T]) AddListener(ctx context.Context, includeValue bool, handler func(event *TypedListItemNotified[T])) (types.UUID, error) {
+	return l.l.AddListener(ctx, includeValue, func(event *ListItemNotified) {
+		v, err := convertTo[T](event.Value)
+		if err != nil {
+			l.l.logger.Errorf("converting item notified event of %s: %w", event.ListName, err)
+			return
+		}
+		handler(&TypedListItemNotified[T]{
+			Value:     v,
+			ListName:  event.ListName,
+			Member:    event.Member,
+			EventType: event.EventType,
+		})
+	})
+}
+
+// Clear removes all elements from the list.
+func (l *TypedList[T]) Clear(ctx context.Context) error {
+	return l.l.Clear(ctx)
+}
+
+// Contains checks if the list contains the given element.
+func (l *TypedList[T]) Contains(ctx context.Context, element T) (bool, error) {
+	return l.l.Contains(ctx, element)
+}
+
+// Destroy removes the list from the cluster.
+func (l *TypedList[T]) Destroy(ctx context.Context) error {
+	return l.l.Destroy(ctx)
+}
+
+// Get retrieves the element at given index.
+func (l *TypedList[T]) Get(ctx context.Context, index int) (T, error) {
+	return convertValueAndError[T](l.l.Get(ctx, index))
+}
+
+// GetAll returns all elements of the list.
+func (l *TypedList[T]) GetAll(ctx context.Context) ([]T, error) {
+	return convertSlice[T](l.l.GetAll(ctx))
+}
+
+// IndexOf returns the index of the first occurrence of the given element in this list.
+func (l *TypedList[T]) IndexOf(ctx context.Context, element T) (int, error) {
+	return l.l.IndexOf(ctx, element)
+}
+
+// IsEmpty return true if the list is empty, false otherwise.
+func (l *TypedList[T]) IsEmpty(ctx context.Context) (bool, error) {
+	return l.l.IsEmpty(ctx)
+}
+
+// Remove removes the given element from this list.
+func (l *TypedList[T]) Remove(ctx context.Context, element T) (bool, error) {
+	return l.l.Remove(ctx, element)
+}
+
+// RemoveAt removes the element at the given index and returns it.
+func (l *TypedList[T]) RemoveAt(ctx context.Context, index int) (T, error) {
+	return convertValueAndError[T](l.l.RemoveAt(ctx, index))
+}
+
+// RemoveListener removes the item listener with the given subscription ID.
+func (l *TypedList[T]) RemoveListener(ctx context.Context, subscriptionID types.UUID) error {
+	return l.l.RemoveListener(ctx, subscriptionID)
+}
+
+// Set replaces the element at the specified index in this list with the specified element and returns the previous element.
+func (l *TypedList[T]) Set(ctx context.Context, index int, element T) (T, error) {
+	return convertValueAndError[T](l.l.Set(ctx, index, element))
+}
+
+// Size returns the number of elements in this list.
+func (l *TypedList[T]) Size(ctx context.Context) (int, error) {
+	return l.l.Size(ctx)
+}
+
+// SubList returns a view of this list that contains elements between index numbers from start (inclusive) to end (exclusive).
+func (l *TypedList[T]) SubList(ctx context.Context, start int, end int) ([]T, error) {
+	return convertSlice[T](l.l.SubList(ctx, start, end))
+}
@@ -0,0 +1,253 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/predicate"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+/*
+TypedMap is a type-safe wrapper around Map with keys of type K and values of type V.
+
+Values received from the cluster are converted to K and V.
+If a value cannot be converted, the corresponding method returns an error which wraps hzerrors.ErrClassCast.
+Missing values are returned as the zero value of V, use a pointer type for V or ContainsKey to distinguish them.
+
+Methods which are not provided by TypedMap are available on the underlying Map, see Unwrap.
+
+	m, err := hazelcast.GetTypedMap[string, int64](ctx, client, "counters")
+	// handle error
+	v, err := m.Get(ctx, "visits")
+	// handle error, v is an int64
+*/
+type TypedMap[K, V any] struct {
+	m *Map
+}
+
+// NewTypedMap returns a TypedMap which wraps the given map.
+func NewTypedMap[K, V any](m *Map) *TypedMap[K, V] {
+	return &TypedMap[K, V]{m: m}
+}
+
+// GetTypedMap returns a TypedMap instance.
+func GetTypedMap[K, V any](ctx context.Context, c *Client, name string) (*TypedMap[K, V], error) {
+	m, err := c.GetMap(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return NewTypedMap[K, V](m), nil
+}
+
+// Unwrap returns the underlying Map.
+func (m *TypedMap[K, V]) Unwrap() *Map {
+	return m.m
+}
+
+// Name returns the name of the map.
+func (m *TypedMap[K, V]) Name() string {
+	return m.m.Name()
+}
+
+// AddListener adds a continuous entry listener to this map.
+func (m *TypedMap[K, V]) AddListener(ctx context.Context, listener TypedMapListener[K, V], includeValue bool) (types.UUID, error) {
+	return m.m.AddListener(ctx, listener.untyped(m.m.logger), includeValue)
+}
+
+// AddListenerWithKey adds a continuous entry listener on a specific key to this map.
+func (m *TypedMap[K, V]) AddListenerWithKey(ctx context.Context, listener TypedMapListener[K, V], key K, includeValue bool) (types.UUID, error) {
+	return m.m.AddListenerWithKey(ctx, listener.untyped(m.m.logger), key, includeValue)
+}
+
+// AddListenerWithPredicate adds a continuous entry listener to this map, which is notified of the entries matching the predicate.
+func (m *TypedMap[K, V]) AddListenerWithPredicate(ctx context.Context, listener TypedMapListener[K, V], pred predicate.Predicate, includeValue bool) (types.UUID, error) {
+	return m.m.AddListenerWithPredicate(ctx, listener.untyped(m.m.logger), pred, includeValue)
+}
+
+// Clear deletes all entries one by one and fires related events.
+func (m *TypedMap[K, V]) Clear(ctx context.Context) error {
+	return m.m.Clear(ctx)
+}
+
+// ContainsKey returns true if the map contains an entry with the given key.
+func (m *TypedMap[K, V]) ContainsKey(ctx context.Context, key K) (bool, error) {
+	return m.m.ContainsKey(ctx, key)
+}
+
+// ContainsValue returns true if the map contains an entry with the given value.
+func (m *TypedMap[K, V]) ContainsValue(ctx context.Context, value V) (bool, error) {
+	return m.m.ContainsValue(ctx, value)
+}
+
+// Delete removes the mapping for a key from this map if it is present.
+func (m *TypedMap[K, V]) Delete(ctx context.Context, key K) error {
+	return m.m.Delete(ctx, key)
+}
+
+// Destroy removes the map from the cluster.
+func (m *TypedMap[K, V]) Destroy(ctx context.Context) error {
+	return m.m.Destroy(ctx)
+}
+
+// Evict evicts the mapping for a key from this map.
+func (m *TypedMap[K, V]) Evict(ctx context.Context, key K) (bool, error) {
+	return m.m.Evict(ctx, key)
+}
+
+// Get returns the value for the specified key, or the zero value of V if this map does not contain this key.
+func (m *TypedMap[K, V]) Get(ctx context.Context, key K) (V, error) {
+	return convertValueAndError[V](m.m.Get(ctx, key))
+}
+
+// GetAll returns the entries for the given keys.
+func (m *TypedMap[K, V]) GetAll(ctx context.Context, keys ...K) ([]TypedEntry[K, V], error) {
+	return convertEntries[K, V](m.m.GetAll(ctx, untypedSlice(keys)...))
+}
+
+// GetEntrySet returns a clone of the mappings contained in this map.
+func (m *TypedMap[K, V]) GetEntrySet(ctx context.Context) ([]TypedEntry[K, V], error) {
+	return convertEntries[K, V](m.m.GetEntrySet(ctx))
+}
+
+// GetEntrySetWithPredicate returns a clone of the mappings contained in this map which match the predicate.
+func (m *TypedMap[K, V]) GetEntrySetWithPredicate(ctx context.Context, pred predicate.Predicate) ([]TypedEntry[K, V], error) {
+	return convertEntries[K, V](m.m.GetEntrySetWithPredicate(ctx, pred))
+}
+
+// GetKeySet returns keys contained in this map.
+func (m *TypedMap[K, V]) GetKeySet(ctx context.Context) ([]K, error) {
+	return convertSlice[K](m.m.GetKeySet(ctx))
+}
+
+// GetKeySetWithPredicate returns keys contained in this map which match the predicate.
+func (m *TypedMap[K, V]) GetKeySetWithPredicate(ctx context.Context, pred predicate.Predicate) ([]K, error) {
+	return convertSlice[K](m.m.GetKeySetWithPredicate(ctx, pred))
+}
+
+// GetValues returns a list clone of the values contained in this map.
+func (m *TypedMap[K, V]) GetValues(ctx context.Context) ([]V, error) {
+	return convertSlice[V](m.m.GetValues(ctx))
+}
+
+// GetValuesWithPredicate returns a list clone of the values contained in this map which match the predicate.
+func (m *TypedMap[K, V]) GetValuesWithPredicate(ctx context.Context, pred predicate.Predicate) ([]V, error) {
+	return convertSlice[V](m.m.GetValuesWithPredicate(ctx, pred))
+}
+
+// IsEmpty returns true if this map contains no key-value mappings.
+func (m *TypedMap[K, V]) IsEmpty(ctx context.Context) (bool, error) {
+	return m.m.IsEmpty(ctx)
+}
+
+// Lock acquires the lock for the specified key.
+// See Map.Lock for details.
+func (m *TypedMap[K, V]) Lock(ctx context.Context, key K) error {
+	return m.m.Lock(ctx, key)
+}
+
+// NewLockContext augments the passed parent context with a unique lock ID.
+// See Map.NewLockContext for details.
+func (m *TypedMap[K, V]) NewLockContext(ctx context.Context) context.Context {
+	return m.m.NewLockContext(ctx)
+}
+
+// Put sets the value for the given key and returns the old value.
+func (m *TypedMap[K, V]) Put(ctx context.Context, key K, value V) (V, error) {
+	return convertValueAndError[V](m.m.Put(ctx, key, value))
+}
+
+// PutWithTTL sets the value for the given key and returns the old value.
+// Entry will expire and get evicted after the ttl.
+func (m *TypedMap[K, V]) PutWithTTL(ctx context.Context, key K, value V, ttl time.Duration) (V, error) {
+	return convertValueAndError[V](m.m.PutWithTTL(ctx, key, value, ttl))
+}
+
+// PutAll copies all the mappings from the specified entries to this map.
+func (m *TypedMap[K, V]) PutAll(ctx context.Context, entries ...TypedEntry[K, V]) error {
+	es := make([]types.Entry, len(entries))
+	for i, e := range entries {
+		es[i] = types.NewEntry(e.Key, e.Value)
+	}
+	return m.m.PutAll(ctx, es...)
+}
+
+// PutIfAbsent associates the specified key with the given value if it is not already associated.
+// Returns the existing value, or the zero value of V if there was none.
+func (m *TypedMap[K, V]) PutIfAbsent(ctx context.Context, key K, value V) (V, error) {
+	return convertValueAndError[V](m.m.PutIfAbsent(ctx, key, value))
+}
+
+// Remove deletes the value for the given key and returns it.
+func (m *TypedMap[K, V]) Remove(ctx context.Context, key K) (V, error) {
+	return convertValueAndError[V](m.m.Remove(ctx, key))
+}
+
+// RemoveIfSame removes the entry for a key only if it is currently mapped to a given value.
+func (m *TypedMap[K, V]) RemoveIfSame(ctx context.Context, key K, value V) (bool, error) {
+	return m.m.RemoveIfSame(ctx, key, value)
+}
+
+// RemoveListener removes the specified entry listener.
+func (m *TypedMap[K, V]) RemoveListener(ctx context.Context, subscriptionID types.UUID) error {
+	return m.m.RemoveListener(ctx, subscriptionID)
+}
+
+// Replace replaces the entry for a key only if it is currently mapped to some value.
+// Returns the previous value, or the zero value of V if there was none.
+func (m *TypedMap[K, V]) Replace(ctx context.Context, key K, value V) (V, error) {
+	return convertValueAndError[V](m.m.Replace(ctx, key, value))
+}
+
+// ReplaceIfSame replaces the entry for a key only if it is currently mapped to a given value.
+func (m *TypedMap[K, V]) ReplaceIfSame(ctx context.Context, key K, oldValue V, newValue V) (bool, error) {
+	return m.m.ReplaceIfSame(ctx, key, oldValue, newValue)
+}
+
+// Set sets the value for the given key.
+func (m *TypedMap[K, V]) Set(ctx context.Context, key K, value V) error {
+	return m.m.Set(ctx, key, value)
+}
+
+// SetWithTTL sets the value for the given key.
+// Entry will expire and get evicted after the ttl.
+func (m *TypedMap[K, V]) SetWithTTL(ctx context.Context, key K, value V, ttl time.Duration) error {
+	return m.m.SetWithTTL(ctx, key, value, ttl)
+}
+
+// Size returns the number of entries in this map.
+func (m *TypedMap[K, V]) Size(ctx context.Context) (int, error) {
+	return m.m.Size(ctx)
+}
+
+// TryLock tries to acquire the lock for the specified key.
+// See Map.TryLock for details.
+func (m *TypedMap[K, V]) TryLock(ctx context.Context, key K) (bool, error) {
+	return m.m.TryLock(ctx, key)
+}
+
+// TryPut tries to put the given key and value into this map and returns immediately.
+func (m *TypedMap[K, V]) TryPut(ctx context.Context, key K, value V) (bool, error) {
+	return m.m.TryPut(ctx, key, value)
+}
+
+// Unlock releases the lock for the specified key.
+func (m *TypedMap[K, V]) Unlock(ctx context.Context, key K) error {
+	return m.m.Unlock(ctx, key)
+}
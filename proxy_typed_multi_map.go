@@ -0,0 +1,160 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"time"
+)
+
+// TypedMultiMap is a type-safe wrapper around MultiMap with keys of type K and values of type V.
+// Values which cannot be converted to K or V cause an error which wraps hzerrors.ErrClassCast.
+type TypedMultiMap[K, V any] struct {
+	m *MultiMap
+}
+
+// NewTypedMultiMap returns a TypedMultiMap which wraps the given multi map.
+func NewTypedMultiMap[K, V any](m *MultiMap) *TypedMultiMap[K, V] {
+	return &TypedMultiMap[K, V]{m: m}
+}
+
+// GetTypedMultiMap returns a TypedMultiMap instance.
+func GetTypedMultiMap[K, V any](ctx context.Context, c *Client, name string) (*TypedMultiMap[K, V], error) {
+	m, err := c.GetMultiMap(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return NewTypedMultiMap[K, V](m), nil
+}
+
+// Unwrap returns the underlying MultiMap.
+func (m *TypedMultiMap[K, V]) Unwrap() *MultiMap {
+	return m.m
+}
+
+// Name returns the name of the multi map.
+func (m *TypedMultiMap[K, V]) Name() string {
+	return m.m.Name()
+}
+
+// Clear deletes all entries one by one and fires related events.
+func (m *TypedMultiMap[K, V]) Clear(ctx context.Context) error {
+	return m.m.Clear(ctx)
+}
+
+// ContainsEntry returns true if the multi-map contains an entry with the given key and value.
+func (m *TypedMultiMap[K, V]) ContainsEntry(ctx context.Context, key K, value V) (bool, error) {
+	return m.m.ContainsEntry(ctx, key, value)
+}
+
+// ContainsKey returns true if the multi-map contains an entry with the given key.
+func (m *TypedMultiMap[K, V]) ContainsKey(ctx context.Context, key K) (bool, error) {
+	return m.m.ContainsKey(ctx, key)
+}
+
+// ContainsValue returns true if the multi-map contains an entry with the given value.
+func (m *TypedMultiMap[K, V]) ContainsValue(ctx context.Context, value V) (bool, error) {
+	return m.m.ContainsValue(ctx, value)
+}
+
+// Delete removes all the entries associated with the given key.
+func (m *TypedMultiMap[K, V]) Delete(ctx context.Context, key K) error {
+	return m.m.Delete(ctx, key)
+}
+
+// Destroy removes the multi-map from the cluster.
+func (m *TypedMultiMap[K, V]) Destroy(ctx context.Context) error {
+	return m.m.Destroy(ctx)
+}
+
+// Get returns values for the specified key.
+func (m *TypedMultiMap[K, V]) Get(ctx context.Context, key K) ([]V, error) {
+	return convertSlice[V](m.m.Get(ctx, key))
+}
+
+// GetEntrySet returns a clone of the mappings contained in this multi-map.
+func (m *TypedMultiMap[K, V]) GetEntrySet(ctx context.Context) ([]TypedEntry[K, V], error) {
+	return convertEntries[K, V](m.m.GetEntrySet(ctx))
+}
+
+// GetKeySet returns keys contained in this multi-map.
+func (m *TypedMultiMap[K, V]) GetKeySet(ctx context.Context) ([]K, error) {
+	return convertSlice[K](m.m.GetKeySet(ctx))
+}
+
+// GetValues returns a list clone of the values contained in this multi-map.
+func (m *TypedMultiMap[K, V]) GetValues(ctx context.Context) ([]V, error) {
+	return convertSlice[V](m.m.GetValues(ctx))
+}
+
+// Lock acquires the lock for the specified key.
+// See MultiMap.Lock for details.
+func (m *TypedMultiMap[K, V]) Lock(ctx context.Context, key K) error {
+	return m.m.Lock(ctx, key)
+}
+
+// LockWithLease acquires the lock for the specified key for the given lease time.
+func (m *TypedMultiMap[K, V]) LockWithLease(ctx context.Context, key K, leaseTime time.Duration) error {
+	return m.m.LockWithLease(ctx, key, leaseTime)
+}
+
+// NewLockContext augments the passed parent context with a unique lock ID.
+// See MultiMap.NewLockContext for details.
+func (m *TypedMultiMap[K, V]) NewLockContext(ctx context.Context) context.Context {
+	return m.m.NewLockContext(ctx)
+}
+
+// Put appends the value for the given key to the corresponding value list and returns if operation is successful.
+func (m *TypedMultiMap[K, V]) Put(ctx context.Context, key K, value V) (bool, error) {
+	return m.m.Put(ctx, key, value)
+}
+
+// PutAll appends given values to the value list of given key.
+func (m *TypedMultiMap[K, V]) PutAll(ctx context.Context, key K, values ...V) error {
+	return m.m.PutAll(ctx, key, untypedSlice(values)...)
+}
+
+// Remove deletes all the values corresponding to the given key and returns them.
+func (m *TypedMultiMap[K, V]) Remove(ctx context.Context, key K) ([]V, error) {
+	return convertSlice[V](m.m.Remove(ctx, key))
+}
+
+// RemoveEntry removes the specified value for the given key.
+func (m *TypedMultiMap[K, V]) RemoveEntry(ctx context.Context, key K, value V) (bool, error) {
+	return m.m.RemoveEntry(ctx, key, value)
+}
+
+// Size returns the number of entries in this multi-map.
+func (m *TypedMultiMap[K, V]) Size(ctx context.Context) (int, error) {
+	return m.m.Size(ctx)
+}
+
+// TryLock tries to acquire the lock for the specified key.
+// See MultiMap.TryLock for details.
+func (m *TypedMultiMap[K, V]) TryLock(ctx context.Context, key K) (bool, error) {
+	return m.m.TryLock(ctx, key)
+}
+
+// Unlock releases the lock for the specified key.
+func (m *TypedMultiMap[K, V]) Unlock(ctx context.Context, key K) error {
+	return m.m.Unlock(ctx, key)
+}
+
+// ValueCount returns the number of values that match the given key in the multi-map.
+func (m *TypedMultiMap[K, V]) ValueCount(ctx context.Context, key K) (int, error) {
+	return m.m.ValueCount(ctx, key)
+}
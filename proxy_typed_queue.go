@@ -0,0 +1,172 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/cluster"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+// TypedQueue is a type-safe wrapper around Queue with items of type T.
+// Items which cannot be converted to T cause an error which wraps hzerrors.ErrClassCast.
+// If no item is available, methods like Poll return the zero value of T.
+type TypedQueue[T any] struct {
+	q *Queue
+}
+
+// TypedQueueItemNotified is the typed counterpart of QueueItemNotified.
+type TypedQueueItemNotified[T any] struct {
+	Value     T
+	QueueName string
+	Member    cluster.MemberInfo
+	EventType ItemEventType
+}
+
+// NewTypedQueue returns a TypedQueue which wraps the given queue.
+func NewTypedQueue[T any](q *Queue) *TypedQueue[T] {
+	return &TypedQueue[T]{q: q}
+}
+
+// GetTypedQueue returns a TypedQueue instance.
+func GetTypedQueue[T any](ctx context.Context, c *Client, name string) (*TypedQueue[T], error) {
+	q, err := c.GetQueue(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return NewTypedQueue[T](q), nil
+}
+
+// Unwrap returns the underlying Queue.
+func (q *TypedQueue[T]) Unwrap() *Queue {
+	return q.q
+}
+
+// Name returns the name of the queue.
+func (q *TypedQueue[T]) Name() string {
+	return q.q.Name()
+}
+
+// Add adds the specified item to this queue if there is available space.
+func (q *TypedQueue[T]) Add(ctx context.Context, value T) (bool, error) {
+	return q.q.Add(ctx, value)
+}
+
+// AddWithTimeout adds the specified item to this queue if there is available space, waiting up to the timeout.
+func (q *TypedQueue[T]) AddWithTimeout(ctx context.Context, value T, timeout time.Duration) (bool, error) {
+	return q.q.AddWithTimeout(ctx, value, timeout)
+}
+
+// AddAll adds the elements in the specified collection to this queue.
+func (q *TypedQueue[T]) AddAll(ctx context.Context, values ...T) (bool, error) {
+	return q.q.AddAll(ctx, untypedSlice(values)...)
+}
+
+// AddItemListener adds an item listener for this queue.
+// Events with items that cannot be converted to T are logged and dropped.
+func (q *TypedQueue[T]) AddItemListener(ctx context.Context, includeValue bool, handler func(event *TypedQueueItemNotified[T])) (types.UUID, error) {
+	return q.q.AddItemListener(ctx, includeValue, func(event *QueueItemNotified) {
+		v, err := convertTo[T](event.Value)
+		if err != nil {
+			q.q.logger.Errorf("converting item notified event of %s: %w", event.QueueName, err)
+			return
+		}
+		handler(&TypedQueueItemNotified[T]{
+			Value:     v,
+			QueueName: event.QueueName,
+			Member:    event.Member,
+			EventType: event.EventType,
+		})
+	})
+}
+
+// Clear removes all of the elements from this queue.
+func (q *TypedQueue[T]) Clear(ctx context.Context) error {
+	return q.q.Clear(ctx)
+}
+
+// Contains returns true if the queue includes the given value.
+func (q *TypedQueue[T]) Contains(ctx context.Context, value T) (bool, error) {
+	return q.q.Contains(ctx, value)
+}
+
+// Destroy removes the queue from the cluster.
+func (q *TypedQueue[T]) Destroy(ctx context.Context) error {
+	return q.q.Destroy(ctx)
+}
+
+// Drain returns all items in the queue and empties it.
+func (q *TypedQueue[T]) Drain(ctx context.Context) ([]T, error) {
+	return convertSlice[T](q.q.Drain(ctx))
+}
+
+// DrainWithMaxSize returns maximum maxSize items in the queue and removes returned items from the queue.
+func (q *TypedQueue[T]) DrainWithMaxSize(ctx context.Context, maxSize int) ([]T, error) {
+	return convertSlice[T](q.q.DrainWithMaxSize(ctx, maxSize))
+}
+
+// GetAll returns all of the items in this queue.
+func (q *TypedQueue[T]) GetAll(ctx context.Context) ([]T, error) {
+	return convertSlice[T](q.q.GetAll(ctx))
+}
+
+// IsEmpty returns true if the queue is empty.
+func (q *TypedQueue[T]) IsEmpty(ctx context.Context) (bool, error) {
+	return q.q.IsEmpty(ctx)
+}
+
+// Peek retrieves the head of queue without removing it from the queue.
+func (q *TypedQueue[T]) Peek(ctx context.Context) (T, error) {
+	return convertValueAndError[T](q.q.Peek(ctx))
+}
+
+// Poll retrieves and removes the head of this queue.
+func (q *TypedQueue[T]) Poll(ctx context.Context) (T, error) {
+	return convertValueAndError[T](q.q.Poll(ctx))
+}
+
+// PollWithTimeout retrieves and removes the head of this queue, waiting up to the timeout if necessary.
+func (q *TypedQueue[T]) PollWithTimeout(ctx context.Context, timeout time.Duration) (T, error) {
+	return convertValueAndError[T](q.q.PollWithTimeout(ctx, timeout))
+}
+
+// Put adds the specified element into this queue, waiting if necessary for space to become available.
+func (q *TypedQueue[T]) Put(ctx context.Context, value T) error {
+	return q.q.Put(ctx, value)
+}
+
+// Remove removes the specified element from the queue if it exists.
+func (q *TypedQueue[T]) Remove(ctx context.Context, value T) (bool, error) {
+	return q.q.Remove(ctx, value)
+}
+
+// RemoveListener removes the item listener with the given subscription ID.
+func (q *TypedQueue[T]) RemoveListener(ctx context.Context, subscriptionID types.UUID) error {
+	return q.q.RemoveListener(ctx, subscriptionID)
+}
+
+// Size returns the number of elements in this collection.
+func (q *TypedQueue[T]) Size(ctx context.Context) (int, error) {
+	return q.q.Size(ctx)
+}
+
+// Take retrieves and removes the head of this queue, waiting if necessary until an element becomes available.
+func (q *TypedQueue[T]) Take(ctx context.Context) (T, error) {
+	return convertValueAndError[T](q.q.Take(ctx))
+}
@@ -0,0 +1,145 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+
+	"github.com/hazelcast/hazelcast-go-client/predicate"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+// TypedReplicatedMap is a type-safe wrapper around ReplicatedMap with keys of type K and values of type V.
+// Values which cannot be converted to K or V cause an error which wraps hzerrors.ErrClassCast.
+// Missing values are returned as the zero value of V.
+type TypedReplicatedMap[K, V any] struct {
+	m *ReplicatedMap
+}
+
+// NewTypedReplicatedMap returns a TypedReplicatedMap which wraps the given replicated map.
+func NewTypedReplicatedMap[K, V any](m *ReplicatedMap) *TypedReplicatedMap[K, V] {
+	return &TypedReplicatedMap[K, V]{m: m}
+}
+
+// GetTypedReplicatedMap returns a TypedReplicatedMap instance.
+func GetTypedReplicatedMap[K, V any](ctx context.Context, c *Client, name string) (*TypedReplicatedMap[K, V], error) {
+	m, err := c.GetReplicatedMap(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return NewTypedReplicatedMap[K, V](m), nil
+}
+
+// Unwrap returns the underlying ReplicatedMap.
+func (m *TypedReplicatedMap[K, V]) Unwrap() *ReplicatedMap {
+	return m.m
+}
+
+// Name returns the name of the replicated map.
+func (m *TypedReplicatedMap[K, V]) Name() string {
+	return m.m.Name()
+}
+
+// AddEntryListener adds a continuous entry listener to this map.
+// Events with keys or values that cannot be converted to K or V are logged and dropped.
+func (m *TypedReplicatedMap[K, V]) AddEntryListener(ctx context.Context, handler func(event *TypedEntryNotified[K, V])) (types.UUID, error) {
+	return m.m.AddEntryListener(ctx, typedEntryHandler(handler, m.m.logger))
+}
+
+// AddEntryListenerToKey adds a continuous entry listener for the given key to this map.
+func (m *TypedReplicatedMap[K, V]) AddEntryListenerToKey(ctx context.Context, key K, handler func(event *TypedEntryNotified[K, V])) (types.UUID, error) {
+	return m.m.AddEntryListenerToKey(ctx, key, typedEntryHandler(handler, m.m.logger))
+}
+
+// AddEntryListenerWithPredicate adds a continuous entry listener to this map, which is notified of the entries matching the predicate.
+func (m *TypedReplicatedMap[K, V]) AddEntryListenerWithPredicate(ctx context.Context, pred predicate.Predicate, handler func(event *TypedEntryNotified[K, V])) (types.UUID, error) {
+	return m.m.AddEntryListenerWithPredicate(ctx, pred, typedEntryHandler(handler, m.m.logger))
+}
+
+// Clear deletes all entries one by one and fires related events.
+func (m *TypedReplicatedMap[K, V]) Clear(ctx context.Context) error {
+	return m.m.Clear(ctx)
+}
+
+// ContainsKey returns true if the map contains an entry with the given key.
+func (m *TypedReplicatedMap[K, V]) ContainsKey(ctx context.Context, key K) (bool, error) {
+	return m.m.ContainsKey(ctx, key)
+}
+
+// ContainsValue returns true if the map contains an entry with the given value.
+func (m *TypedReplicatedMap[K, V]) ContainsValue(ctx context.Context, value V) (bool, error) {
+	return m.m.ContainsValue(ctx, value)
+}
+
+// Destroy removes the replicated map from the cluster.
+func (m *TypedReplicatedMap[K, V]) Destroy(ctx context.Context) error {
+	return m.m.Destroy(ctx)
+}
+
+// Get returns the value for the specified key, or the zero value of V if this map does not contain this key.
+func (m *TypedReplicatedMap[K, V]) Get(ctx context.Context, key K) (V, error) {
+	return convertValueAndError[V](m.m.Get(ctx, key))
+}
+
+// GetEntrySet returns a clone of the mappings contained in this map.
+func (m *TypedReplicatedMap[K, V]) GetEntrySet(ctx context.Context) ([]TypedEntry[K, V], error) {
+	return convertEntries[K, V](m.m.GetEntrySet(ctx))
+}
+
+// GetKeySet returns keys contained in this map.
+func (m *TypedReplicatedMap[K, V]) GetKeySet(ctx context.Context) ([]K, error) {
+	return convertSlice[K](m.m.GetKeySet(ctx))
+}
+
+// GetValues returns a list clone of the values contained in this map.
+func (m *TypedReplicatedMap[K, V]) GetValues(ctx context.Context) ([]V, error) {
+	return convertSlice[V](m.m.GetValues(ctx))
+}
+
+// IsEmpty returns true if this map contains no key-value mappings.
+func (m *TypedReplicatedMap[K, V]) IsEmpty(ctx context.Context) (bool, error) {
+	return m.m.IsEmpty(ctx)
+}
+
+// Put sets the value for the given key and returns the old value.
+func (m *TypedReplicatedMap[K, V]) Put(ctx context.Context, key K, value V) (V, error) {
+	return convertValueAndError[V](m.m.Put(ctx, key, value))
+}
+
+// PutAll copies all the mappings from the specified entries to this map.
+func (m *TypedReplicatedMap[K, V]) PutAll(ctx context.Context, entries ...TypedEntry[K, V]) error {
+	es := make([]types.Entry, len(entries))
+	for i, e := range entries {
+		es[i] = types.NewEntry(e.Key, e.Value)
+	}
+	return m.m.PutAll(ctx, es...)
+}
+
+// Remove deletes the value for the given key and returns it.
+func (m *TypedReplicatedMap[K, V]) Remove(ctx context.Context, key K) (V, error) {
+	return convertValueAndError[V](m.m.Remove(ctx, key))
+}
+
+// RemoveEntryListener removes the specified entry listener.
+func (m *TypedReplicatedMap[K, V]) RemoveEntryListener(ctx context.Context, subscriptionID types.UUID) error {
+	return m.m.RemoveEntryListener(ctx, subscriptionID)
+}
+
+// Size returns the number of entries in this map.
+func (m *TypedReplicatedMap[K, V]) Size(ctx context.Context) (int, error) {
+	return m.m.Size(ctx)
+}
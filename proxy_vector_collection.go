@@ -0,0 +1,179 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/check"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/vector"
+)
+
+/*
+VectorCollection is a distributed collection of documents, each of which holds a value and one or more vectors, which can be searched for the documents most similar to a given vector.
+
+The indexes of the collection must be configured before the collection is used.
+The configuration can be added to the client configuration with Config.AddVectorCollection, in which case it is sent to the cluster when the collection is retrieved for the first time.
+
+Each document must contain a vector for each index of the collection.
+If the collection has a single unnamed index, the vector names may be left blank.
+
+For details see https://docs.hazelcast.com/hazelcast/latest/data-structures/vector-collections
+*/
+type VectorCollection struct {
+	*proxy
+}
+
+func newVectorCollection(p *proxy) *VectorCollection {
+	return &VectorCollection{proxy: p}
+}
+
+// Delete removes the document associated with the given key.
+// Does nothing if there is no document for the key.
+func (vc *VectorCollection) Delete(ctx context.Context, key interface{}) error {
+	keyData, err := vc.validateAndSerialize(key)
+	if err != nil {
+		return err
+	}
+	request := codec.EncodeVectorCollectionDeleteRequest(vc.name, keyData)
+	_, err = vc.invokeOnKey(ctx, request, keyData)
+	return err
+}
+
+// Get returns the document associated with the given key.
+// Returns nil if there is no document for the key.
+func (vc *VectorCollection) Get(ctx context.Context, key interface{}) (*vector.Document, error) {
+	keyData, err := vc.validateAndSerialize(key)
+	if err != nil {
+		return nil, err
+	}
+	request := codec.EncodeVectorCollectionGetRequest(vc.name, keyData)
+	response, err := vc.invokeOnKey(ctx, request, keyData)
+	if err != nil {
+		return nil, err
+	}
+	return vc.convertToDocument(codec.DecodeVectorCollectionGetResponse(response))
+}
+
+// Put associates the given document with the given key and returns the previous document.
+// Returns nil if there was no document for the key.
+func (vc *VectorCollection) Put(ctx context.Context, key interface{}, doc vector.Document) (*vector.Document, error) {
+	keyData, docData, err := vc.validateAndSerializeDocument(key, doc)
+	if err != nil {
+		return nil, err
+	}
+	request := codec.EncodeVectorCollectionPutRequest(vc.name, keyData, docData)
+	response, err := vc.invokeOnKey(ctx, request, keyData)
+	if err != nil {
+		return nil, err
+	}
+	return vc.convertToDocument(codec.DecodeVectorCollectionPutResponse(response))
+}
+
+/*
+SearchNearest returns at most limit documents which are the most similar to the given vector, ordered by descending score.
+The name of the vector selects the index to search in, it may be left blank if the collection has a single unnamed index.
+Values and vectors of the found documents are returned only if includeValue and includeVectors are set respectively.
+Hints are passed to the index verbatim and tune the search, they may be nil.
+*/
+func (vc *VectorCollection) SearchNearest(ctx context.Context, vec vector.Vector, limit int, includeValue, includeVectors bool, hints map[string]string) ([]vector.SearchResult, error) {
+	if len(vec.Values) == 0 {
+		return nil, ihzerrors.NewIllegalArgumentError("vector must not be empty", nil)
+	}
+	limit32, err := validateSearchLimit(limit)
+	if err != nil {
+		return nil, err
+	}
+	if hints == nil {
+		hints = map[string]string{}
+	}
+	options := codec.VectorSearchOptions{
+		Hints:          hints,
+		Limit:          limit32,
+		IncludeValue:   includeValue,
+		IncludeVectors: includeVectors,
+	}
+	request := codec.EncodeVectorCollectionSearchNearVectorRequest(vc.name, []vector.Vector{vec}, options)
+	response, err := vc.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		return nil, err
+	}
+	results := codec.DecodeVectorCollectionSearchNearVectorResponse(response)
+	converted := make([]vector.SearchResult, len(results))
+	for i, r := range results {
+		key, err := vc.convertToObject(r.Key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := vc.convertToObject(r.Value)
+		if err != nil {
+			return nil, err
+		}
+		converted[i] = vector.SearchResult{
+			Key:     key,
+			Value:   value,
+			Vectors: r.Vectors,
+			Score:   r.Score,
+		}
+	}
+	return converted, nil
+}
+
+// Set associates the given document with the given key.
+// Set is more efficient than Put, since it does not return the previous document.
+func (vc *VectorCollection) Set(ctx context.Context, key interface{}, doc vector.Document) error {
+	keyData, docData, err := vc.validateAndSerializeDocument(key, doc)
+	if err != nil {
+		return err
+	}
+	request := codec.EncodeVectorCollectionSetRequest(vc.name, keyData, docData)
+	_, err = vc.invokeOnKey(ctx, request, keyData)
+	return err
+}
+
+func (vc *VectorCollection) validateAndSerializeDocument(key interface{}, doc vector.Document) (keyData iserialization.Data, docData codec.VectorDocument, err error) {
+	if len(doc.Vectors) == 0 {
+		return nil, docData, ihzerrors.NewIllegalArgumentError("document must have at least one vector", nil)
+	}
+	keyData, valueData, err := vc.validateAndSerialize2(key, doc.Value)
+	if err != nil {
+		return nil, docData, err
+	}
+	return keyData, codec.VectorDocument{Value: valueData, Vectors: doc.Vectors}, nil
+}
+
+func (vc *VectorCollection) convertToDocument(doc *codec.VectorDocument) (*vector.Document, error) {
+	if doc == nil {
+		return nil, nil
+	}
+	value, err := vc.convertToObject(doc.Value)
+	if err != nil {
+		return nil, err
+	}
+	return &vector.Document{Value: value, Vectors: doc.Vectors}, nil
+}
+
+func validateSearchLimit(limit int) (int32, error) {
+	if limit <= 0 {
+		return 0, ihzerrors.NewIllegalArgumentError(fmt.Sprintf("limit must be positive: %d", limit), nil)
+	}
+	return check.NonNegativeInt32(limit)
+}
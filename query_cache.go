@@ -0,0 +1,495 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	pubcluster "github.com/hazelcast/hazelcast-go-client/cluster"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/predicate"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// defaults of the query cache publisher, same as the ones of the Java client.
+	defaultQueryCacheBatchSize    = 1
+	defaultQueryCacheBufferSize   = 16
+	defaultQueryCacheDelaySeconds = 0
+)
+
+// QueryCacheEventLostHandler is called when a query cache detects that it missed events for a partition.
+type QueryCacheEventLostHandler func(event *QueryCacheEventLost)
+
+// QueryCacheEventLost contains information about lost events of a query cache.
+// Call QueryCache.TryRecover to recover the lost events.
+type QueryCacheEventLost struct {
+	QueryCacheName string
+	PartitionID    int32
+}
+
+/*
+QueryCache is a client-side materialized view of the entries of a Map which match a predicate.
+
+The contents of the query cache are kept in sync with the map using the events sent by the members.
+Read operations of the query cache run locally, and never block.
+If the query cache is created without values, only the keys are kept and the values are always nil.
+
+Every event sent to the query cache has a per-partition sequence number.
+If the query cache detects a gap in the sequence numbers, the event lost listeners are notified once,
+and TryRecover can be used to make the members send the missed events again.
+Until the missed events are received, the later events of that partition are not applied and the entry listeners are not notified of them,
+so every event is applied and notified once, in order, after the recovery.
+
+QueryCache is not safe to use after it is destroyed.
+*/
+type QueryCache struct {
+	m             *Map
+	mu            *sync.RWMutex
+	entries       map[string]queryCacheEntry
+	sequences     map[int32]int64
+	brokenSeqs    map[int32]int64
+	listeners     map[types.UUID]MapListener
+	lostListeners map[types.UUID]QueryCacheEventLostHandler
+	name          string
+	cacheID       string
+	listenerID    types.UUID
+	destroyed     int32
+	includeValue  bool
+}
+
+type queryCacheEntry struct {
+	key         interface{}
+	value       interface{}
+	partitionID int32
+}
+
+// GetQueryCache returns the query cache with the given name, creating it if it does not exist.
+// The query cache contains the entries which match the given predicate.
+// If includeValue is false, only the keys are kept in the query cache.
+// An existing query cache is returned as is, regardless of the given predicate.
+func (m *Map) GetQueryCache(ctx context.Context, name string, pred predicate.Predicate, includeValue bool) (*QueryCache, error) {
+	m.queryCachesMu.Lock()
+	defer m.queryCachesMu.Unlock()
+	if qc, ok := m.queryCaches[name]; ok {
+		return qc, nil
+	}
+	predData, err := m.validateAndSerializePredicate(pred)
+	if err != nil {
+		return nil, err
+	}
+	qc := &QueryCache{
+		m:             m,
+		mu:            &sync.RWMutex{},
+		entries:       map[string]queryCacheEntry{},
+		sequences:     map[int32]int64{},
+		brokenSeqs:    map[int32]int64{},
+		listeners:     map[types.UUID]MapListener{},
+		lostListeners: map[types.UUID]QueryCacheEventLostHandler{},
+		name:          name,
+		cacheID:       types.NewUUID().String(),
+		includeValue:  includeValue,
+	}
+	if err := qc.create(ctx, predData); err != nil {
+		return nil, err
+	}
+	m.queryCaches[name] = qc
+	return qc, nil
+}
+
+// AddEntryListener adds a listener which is notified of the changes in the query cache.
+// The listener is local, it does not create a listener on the members.
+// Events do not contain the values if the query cache was created without values.
+func (qc *QueryCache) AddEntryListener(listener MapListener) types.UUID {
+	id := types.NewUUID()
+	qc.mu.Lock()
+	qc.listeners[id] = listener
+	qc.mu.Unlock()
+	return id
+}
+
+// AddEventLostListener adds a listener which is notified when the query cache misses events.
+func (qc *QueryCache) AddEventLostListener(handler QueryCacheEventLostHandler) types.UUID {
+	id := types.NewUUID()
+	qc.mu.Lock()
+	qc.lostListeners[id] = handler
+	qc.mu.Unlock()
+	return id
+}
+
+// ContainsKey returns true if the query cache contains the given key.
+func (qc *QueryCache) ContainsKey(key interface{}) (bool, error) {
+	keyData, err := qc.m.validateAndSerialize(key)
+	if err != nil {
+		return false, err
+	}
+	qc.mu.RLock()
+	_, ok := qc.entries[string(keyData)]
+	qc.mu.RUnlock()
+	return ok, nil
+}
+
+// Destroy removes the query cache from the members and releases its resources.
+// The underlying map is not affected.
+func (qc *QueryCache) Destroy(ctx context.Context) error {
+	qc.m.queryCachesMu.Lock()
+	delete(qc.m.queryCaches, qc.name)
+	qc.m.queryCachesMu.Unlock()
+	return qc.destroy(ctx)
+}
+
+// EntrySet returns a copy of all entries in the query cache.
+func (qc *QueryCache) EntrySet() []types.Entry {
+	return qc.EntrySetWithFilter(nil)
+}
+
+// EntrySetWithFilter returns a copy of the entries in the query cache for which filter returns true.
+// The filter runs locally.
+func (qc *QueryCache) EntrySetWithFilter(filter func(entry types.Entry) bool) []types.Entry {
+	qc.mu.RLock()
+	defer qc.mu.RUnlock()
+	entries := make([]types.Entry, 0, len(qc.entries))
+	for _, e := range qc.entries {
+		entry := types.Entry{Key: e.key, Value: e.value}
+		if filter == nil || filter(entry) {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// Get returns the value for the given key, or nil if the query cache does not contain the key.
+func (qc *QueryCache) Get(key interface{}) (interface{}, error) {
+	keyData, err := qc.m.validateAndSerialize(key)
+	if err != nil {
+		return nil, err
+	}
+	qc.mu.RLock()
+	e := qc.entries[string(keyData)]
+	qc.mu.RUnlock()
+	return e.value, nil
+}
+
+// GetAll returns the entries for the given keys.
+// Keys which do not exist in the query cache are not included in the result.
+func (qc *QueryCache) GetAll(keys ...interface{}) ([]types.Entry, error) {
+	keysData, err := qc.m.validateAndSerializeValues(keys)
+	if err != nil {
+		return nil, err
+	}
+	qc.mu.RLock()
+	defer qc.mu.RUnlock()
+	entries := make([]types.Entry, 0, len(keys))
+	for _, kd := range keysData {
+		if e, ok := qc.entries[string(kd)]; ok {
+			entries = append(entries, types.Entry{Key: e.key, Value: e.value})
+		}
+	}
+	return entries, nil
+}
+
+// KeySet returns a copy of all keys in the query cache.
+func (qc *QueryCache) KeySet() []interface{} {
+	return qc.KeySetWithFilter(nil)
+}
+
+// KeySetWithFilter returns the keys of the entries in the query cache for which filter returns true.
+// The filter runs locally.
+func (qc *QueryCache) KeySetWithFilter(filter func(entry types.Entry) bool) []interface{} {
+	qc.mu.RLock()
+	defer qc.mu.RUnlock()
+	keys := make([]interface{}, 0, len(qc.entries))
+	for _, e := range qc.entries {
+		if filter == nil || filter(types.Entry{Key: e.key, Value: e.value}) {
+			keys = append(keys, e.key)
+		}
+	}
+	return keys
+}
+
+// Name returns the name of the query cache.
+func (qc *QueryCache) Name() string {
+	return qc.name
+}
+
+// RemoveEntryListener removes the entry listener with the given ID.
+func (qc *QueryCache) RemoveEntryListener(id types.UUID) {
+	qc.mu.Lock()
+	delete(qc.listeners, id)
+	delete(qc.lostListeners, id)
+	qc.mu.Unlock()
+}
+
+// Size returns the number of entries in the query cache.
+func (qc *QueryCache) Size() int {
+	qc.mu.RLock()
+	defer qc.mu.RUnlock()
+	return len(qc.entries)
+}
+
+// TryRecover makes the members send the missed events again, for the partitions which have lost events.
+// Returns true if all of the partitions were recovered, or there was nothing to recover.
+// If recovery is not possible, since the events are no longer available on the members, the query cache should be recreated.
+func (qc *QueryCache) TryRecover(ctx context.Context) (bool, error) {
+	qc.mu.RLock()
+	broken := make(map[int32]int64, len(qc.brokenSeqs))
+	for pid, seq := range qc.brokenSeqs {
+		broken[pid] = seq
+	}
+	qc.mu.RUnlock()
+	recovered := true
+	for pid, seq := range broken {
+		request := codec.EncodeContinuousQuerySetReadCursorRequest(qc.m.name, qc.cacheID, seq)
+		resp, err := qc.m.invokeOnPartition(ctx, request, pid)
+		if err != nil {
+			return false, err
+		}
+		// if the cursor is set, the members send the events starting from seq again.
+		// the partition is not broken anymore after the event with seq is received, see checkSequence.
+		if !codec.DecodeContinuousQuerySetReadCursorResponse(resp) {
+			recovered = false
+		}
+	}
+	return recovered, nil
+}
+
+func (qc *QueryCache) create(ctx context.Context, predData iserialization.Data) error {
+	// see: com.hazelcast.client.map.impl.querycache.subscriber.ClientQueryCacheEndToEndConstructor
+	if err := qc.addListener(ctx); err != nil {
+		return fmt.Errorf("adding query cache listener: %w", err)
+	}
+	if err := qc.createPublisher(ctx, predData); err != nil {
+		qc.removeListener(ctx)
+		return fmt.Errorf("creating query cache publisher: %w", err)
+	}
+	request := codec.EncodeContinuousQueryMadePublishableRequest(qc.m.name, qc.cacheID)
+	if _, err := qc.m.invokeOnRandomTarget(ctx, request, nil); err != nil {
+		if derr := qc.destroy(ctx); derr != nil {
+			qc.m.logger.Warnf("destroying query cache %s: %s", qc.name, derr.Error())
+		}
+		return fmt.Errorf("making query cache publishable: %w", err)
+	}
+	return nil
+}
+
+func (qc *QueryCache) createPublisher(ctx context.Context, predData iserialization.Data) error {
+	if qc.includeValue {
+		request := codec.EncodeContinuousQueryPublisherCreateWithValueRequest(qc.m.name, qc.cacheID, predData,
+			defaultQueryCacheBatchSize, defaultQueryCacheBufferSize, defaultQueryCacheDelaySeconds, true, false)
+		resp, err := qc.m.invokeOnRandomTarget(ctx, request, nil)
+		if err != nil {
+			return err
+		}
+		return qc.populate(codec.DecodeContinuousQueryPublisherCreateWithValueResponse(resp))
+	}
+	request := codec.EncodeContinuousQueryPublisherCreateRequest(qc.m.name, qc.cacheID, predData,
+		defaultQueryCacheBatchSize, defaultQueryCacheBufferSize, defaultQueryCacheDelaySeconds, true, false)
+	resp, err := qc.m.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		return err
+	}
+	keys := codec.DecodeContinuousQueryPublisherCreateResponse(resp)
+	pairs := make([]proto.Pair, len(keys))
+	for i, k := range keys {
+		pairs[i] = proto.NewPair(k, nil)
+	}
+	return qc.populate(pairs)
+}
+
+func (qc *QueryCache) populate(pairs []proto.Pair) error {
+	qc.mu.Lock()
+	defer qc.mu.Unlock()
+	for _, p := range pairs {
+		keyData := p.Key.(iserialization.Data)
+		var valueData iserialization.Data
+		if p.Value != nil {
+			valueData = p.Value.(iserialization.Data)
+		}
+		// the events which arrived during creation are more recent.
+		if _, ok := qc.entries[string(keyData)]; ok {
+			continue
+		}
+		e, err := qc.makeEntry(keyData, valueData)
+		if err != nil {
+			return err
+		}
+		if e.partitionID, err = qc.m.partitionService.GetPartitionID(keyData); err != nil {
+			return err
+		}
+		qc.entries[string(keyData)] = e
+	}
+	return nil
+}
+
+func (qc *QueryCache) addListener(ctx context.Context) error {
+	subscriptionID := types.NewUUID()
+	addRequest := codec.EncodeContinuousQueryAddListenerRequest(qc.cacheID, qc.m.smart)
+	// the member side listener is removed when the publisher is destroyed.
+	removeRequest := codec.EncodeMapRemoveEntryListenerRequest(qc.m.name, subscriptionID)
+	handler := func(msg *proto.ClientMessage) {
+		codec.HandleContinuousQueryAddListener(msg, func(data codec.QueryCacheEventData) {
+			qc.handleEvent(data)
+		}, func(events []codec.QueryCacheEventData, source string, partitionID int32) {
+			for _, e := range events {
+				qc.handleEvent(e)
+			}
+		})
+	}
+	if err := qc.m.listenerBinder.Add(ctx, subscriptionID, addRequest, removeRequest, handler); err != nil {
+		return err
+	}
+	qc.listenerID = subscriptionID
+	return nil
+}
+
+func (qc *QueryCache) removeListener(ctx context.Context) {
+	if err := qc.m.listenerBinder.Remove(ctx, qc.listenerID); err != nil {
+		qc.m.logger.Warnf("removing query cache %s listener: %s", qc.name, err.Error())
+	}
+}
+
+func (qc *QueryCache) destroy(ctx context.Context) error {
+	if !atomic.CompareAndSwapInt32(&qc.destroyed, 0, 1) {
+		return nil
+	}
+	qc.removeListener(ctx)
+	request := codec.EncodeContinuousQueryDestroyCacheRequest(qc.m.name, qc.cacheID)
+	if _, err := qc.m.invokeOnRandomTarget(ctx, request, nil); err != nil {
+		return fmt.Errorf("destroying query cache: %w", err)
+	}
+	qc.mu.Lock()
+	qc.entries = map[string]queryCacheEntry{}
+	qc.mu.Unlock()
+	return nil
+}
+
+func (qc *QueryCache) handleEvent(data codec.QueryCacheEventData) {
+	qc.mu.Lock()
+	apply, lost := qc.checkSequence(data)
+	var event *EntryNotified
+	var err error
+	var listeners []MapListener
+	if apply {
+		event, err = qc.applyEvent(data)
+		listeners = make([]MapListener, 0, len(qc.listeners))
+		for _, l := range qc.listeners {
+			listeners = append(listeners, l)
+		}
+	}
+	var lostListeners []QueryCacheEventLostHandler
+	if lost {
+		for _, l := range qc.lostListeners {
+			lostListeners = append(lostListeners, l)
+		}
+	}
+	qc.mu.Unlock()
+	if err != nil {
+		qc.m.logger.Errorf("hazelcast.QueryCache.handleEvent: %w", err)
+		return
+	}
+	for _, l := range lostListeners {
+		l(&QueryCacheEventLost{QueryCacheName: qc.name, PartitionID: data.PartitionID})
+	}
+	if event == nil {
+		return
+	}
+	for _, l := range listeners {
+		if qc.m.prepareFlagsOfMapListener(l)&int32(event.EventType) != 0 {
+			qc.m.mapListenerEventHandler(l)(event)
+		}
+	}
+}
+
+// checkSequence returns whether the event should be applied, and whether the partition was found to have lost events.
+// Only the next event of a partition is applied, the events after a gap are ignored until the missed events are sent again by TryRecover.
+// Must be called with the lock held.
+func (qc *QueryCache) checkSequence(data codec.QueryCacheEventData) (apply bool, lost bool) {
+	// see: com.hazelcast.map.impl.querycache.subscriber.SubscriberAccumulator#isApplicable
+	pid := data.PartitionID
+	current := qc.sequences[pid]
+	if data.Sequence <= current {
+		// a duplicate event, possibly sent again during recovery.
+		return false, false
+	}
+	if data.Sequence > current+1 {
+		if _, ok := qc.brokenSeqs[pid]; ok {
+			// the partition was already reported as broken.
+			return false, false
+		}
+		qc.brokenSeqs[pid] = current + 1
+		return false, true
+	}
+	delete(qc.brokenSeqs, pid)
+	qc.sequences[pid] = data.Sequence
+	return true, false
+}
+
+// applyEvent updates the entries and returns the event for the listeners.
+// Must be called with the lock held.
+func (qc *QueryCache) applyEvent(data codec.QueryCacheEventData) (*EntryNotified, error) {
+	eventType := EntryEventType(data.EventType)
+	switch eventType {
+	case EntryAdded, EntryUpdated, EntryMerged, EntryLoaded:
+		e, err := qc.makeEntry(data.Key, data.NewValue)
+		if err != nil {
+			return nil, err
+		}
+		e.partitionID = data.PartitionID
+		old := qc.entries[string(data.Key)]
+		qc.entries[string(data.Key)] = e
+		return newEntryNotifiedEvent(qc.name, pubcluster.MemberInfo{}, e.key, e.value, old.value, nil, 1, eventType), nil
+	case EntryRemoved, EntryEvicted, EntryExpired:
+		old, ok := qc.entries[string(data.Key)]
+		if !ok {
+			return nil, nil
+		}
+		delete(qc.entries, string(data.Key))
+		return newEntryNotifiedEvent(qc.name, pubcluster.MemberInfo{}, old.key, nil, old.value, nil, 1, eventType), nil
+	case EntryAllCleared, EntryAllEvicted:
+		// the event is sent for each partition.
+		var removed int
+		for k, e := range qc.entries {
+			if e.partitionID == data.PartitionID {
+				delete(qc.entries, k)
+				removed++
+			}
+		}
+		return newEntryNotifiedEvent(qc.name, pubcluster.MemberInfo{}, nil, nil, nil, nil, removed, eventType), nil
+	default:
+		return nil, ihzerrors.NewIllegalStateError(fmt.Sprintf("unknown query cache event type: %d", data.EventType), nil)
+	}
+}
+
+func (qc *QueryCache) makeEntry(keyData, valueData iserialization.Data) (queryCacheEntry, error) {
+	key, err := qc.m.convertToObject(keyData)
+	if err != nil {
+		return queryCacheEntry{}, err
+	}
+	var value interface{}
+	if qc.includeValue {
+		if value, err = qc.m.convertToObject(valueData); err != nil {
+			return queryCacheEntry{}, err
+		}
+	}
+	return queryCacheEntry{key: key, value: value}, nil
+}
@@ -0,0 +1,109 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"context"
+	"sort"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+	"github.com/hazelcast/hazelcast-go-client/predicate"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+func TestQueryCache_Populate(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		for i := int64(0); i < 10; i++ {
+			it.MustValue(m.Put(ctx, i, i*10))
+		}
+		qc, err := m.GetQueryCache(ctx, "qc", predicate.Less("this", int64(50)), true)
+		require.NoError(t, err)
+		defer qc.Destroy(ctx)
+		require.Equal(t, 5, qc.Size())
+		v, err := qc.Get(int64(2))
+		require.NoError(t, err)
+		require.Equal(t, int64(20), v)
+		v, err = qc.Get(int64(8))
+		require.NoError(t, err)
+		require.Nil(t, v)
+		keys := qc.KeySetWithFilter(func(e types.Entry) bool {
+			return e.Value.(int64) >= 30
+		})
+		sort.Slice(keys, func(i, j int) bool { return keys[i].(int64) < keys[j].(int64) })
+		require.Equal(t, []interface{}{int64(3), int64(4)}, keys)
+		// the same query cache is returned for the same name.
+		qc2, err := m.GetQueryCache(ctx, "qc", predicate.True(), true)
+		require.NoError(t, err)
+		require.Same(t, qc, qc2)
+	})
+}
+
+func TestQueryCache_Sync(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		qc, err := m.GetQueryCache(ctx, "qc", predicate.Greater("this", int64(100)), true)
+		require.NoError(t, err)
+		defer qc.Destroy(ctx)
+		var added, removed int32
+		qc.AddEntryListener(hz.MapListener{
+			EntryAdded: func(event *hz.EntryNotified) {
+				atomic.AddInt32(&added, 1)
+			},
+			EntryRemoved: func(event *hz.EntryNotified) {
+				atomic.AddInt32(&removed, 1)
+			},
+		})
+		it.MustValue(m.Put(ctx, "k1", int64(1)))
+		it.MustValue(m.Put(ctx, "k2", int64(200)))
+		it.MustValue(m.Put(ctx, "k3", int64(300)))
+		it.Eventually(t, func() bool {
+			return qc.Size() == 2 && atomic.LoadInt32(&added) == 2
+		})
+		it.MustValue(m.Remove(ctx, "k2"))
+		it.Eventually(t, func() bool {
+			return qc.Size() == 1 && atomic.LoadInt32(&removed) == 1
+		})
+		ok, err := qc.ContainsKey("k3")
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, []types.Entry{{Key: "k3", Value: int64(300)}}, qc.EntrySet())
+		// nothing was lost, so there is nothing to recover.
+		ok, err = qc.TryRecover(ctx)
+		require.NoError(t, err)
+		require.True(t, ok)
+	})
+}
+
+func TestQueryCache_WithoutValues(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		it.MustValue(m.Put(ctx, "k1", int64(1)))
+		qc, err := m.GetQueryCache(ctx, "qc", predicate.True(), false)
+		require.NoError(t, err)
+		defer qc.Destroy(ctx)
+		require.Equal(t, []interface{}{"k1"}, qc.KeySet())
+		v, err := qc.Get("k1")
+		require.NoError(t, err)
+		require.Nil(t, v)
+	})
+}
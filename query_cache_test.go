@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+)
+
+func TestQueryCache_CheckSequence(t *testing.T) {
+	qc := hz.NewQueryCacheSequences()
+	check := func(seq int64, apply, lost bool) {
+		t.Helper()
+		a, l := qc.CheckSequence(1, seq)
+		assert.Equal(t, apply, a, "apply %d", seq)
+		assert.Equal(t, lost, l, "lost %d", seq)
+	}
+	check(1, true, false)
+	check(2, true, false)
+	// event 3 is lost, the events after it are not applied and lost is reported once
+	check(4, false, true)
+	check(5, false, false)
+	// the events are sent again after recovery, each one is applied once
+	check(3, true, false)
+	check(4, true, false)
+	check(5, true, false)
+	check(4, false, false)
+	// other partitions are not affected
+	a, l := qc.CheckSequence(2, 1)
+	assert.True(t, a)
+	assert.False(t, l)
+}
@@ -0,0 +1,103 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+func TestReliableTopic_Publish(t *testing.T) {
+	it.ReliableTopicTester(t, func(t *testing.T, tp *hz.ReliableTopic) {
+		ctx := context.Background()
+		handlerValue := atomic.Value{}
+		handlerValue.Store("base-value")
+		subscriptionID, err := tp.AddMessageListener(ctx, func(event *hz.MessagePublished) {
+			handlerValue.Store(event.Value)
+		})
+		require.NoError(t, err)
+		require.NoError(t, tp.Publish(ctx, "value1"))
+		it.Eventually(t, func() bool { return handlerValue.Load() == "value1" })
+		require.NoError(t, tp.RemoveListener(ctx, subscriptionID))
+		handlerValue.Store("base-value")
+		require.NoError(t, tp.Publish(ctx, "value2"))
+		it.Never(t, func() bool { return handlerValue.Load() != "base-value" })
+	})
+}
+
+func TestReliableTopic_PublishAll_Ordered(t *testing.T) {
+	it.ReliableTopicTester(t, func(t *testing.T, tp *hz.ReliableTopic) {
+		ctx := context.Background()
+		var mu sync.Mutex
+		var values []interface{}
+		_, err := tp.AddMessageListener(ctx, func(event *hz.MessagePublished) {
+			mu.Lock()
+			values = append(values, event.Value)
+			mu.Unlock()
+		})
+		require.NoError(t, err)
+		require.NoError(t, tp.PublishAll(ctx, "v1", "v2", "v3"))
+		require.NoError(t, tp.Publish(ctx, "v4"))
+		it.Eventually(t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return len(values) == 4
+		})
+		mu.Lock()
+		require.Equal(t, []interface{}{"v1", "v2", "v3", "v4"}, values)
+		mu.Unlock()
+	})
+}
+
+func TestReliableTopic_InitialSequence(t *testing.T) {
+	it.ReliableTopicTester(t, func(t *testing.T, tp *hz.ReliableTopic) {
+		ctx := context.Background()
+		require.NoError(t, tp.PublishAll(ctx, "v1", "v2", "v3"))
+		var count int32
+		var lastSeq int64
+		cfg := hz.ReliableTopicListenerConfig{
+			UseInitialSequence: true,
+			InitialSequence:    1,
+			StoreSequence: func(sequence int64) {
+				atomic.StoreInt64(&lastSeq, sequence)
+			},
+		}
+		_, err := tp.AddMessageListenerWithConfig(ctx, cfg, func(event *hz.MessagePublished) {
+			atomic.AddInt32(&count, 1)
+		})
+		require.NoError(t, err)
+		it.Eventually(t, func() bool { return atomic.LoadInt32(&count) == 2 })
+		it.Eventually(t, func() bool { return atomic.LoadInt64(&lastSeq) == 2 })
+	})
+}
+
+func TestReliableTopic_RemoveUnknownListener(t *testing.T) {
+	it.ReliableTopicTester(t, func(t *testing.T, tp *hz.ReliableTopic) {
+		err := tp.RemoveListener(context.Background(), types.NewUUID())
+		require.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+	})
+}
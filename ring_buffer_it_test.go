@@ -18,7 +18,10 @@ package hazelcast_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -26,6 +29,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
 	"github.com/hazelcast/hazelcast-go-client/internal/it"
 	"github.com/hazelcast/hazelcast-go-client/serialization"
 )
@@ -337,3 +341,104 @@ func TestRingBuffer_ReadMany_WithFilter_AndMaxCount(t *testing.T) {
 		require.Equal(t, "good3", it.MustValue(rs.Get(2)))
 	})
 }
+
+func TestRingBuffer_ReadMany_WithFilterFunc(t *testing.T) {
+	it.RingbufferTester(t, func(t *testing.T, rb *hz.Ringbuffer) {
+		ctx := context.Background()
+		_, err := rb.AddAll(ctx, hz.OverflowPolicyOverwrite, "good1", "bad1", "good2", "bad2")
+		require.NoError(t, err)
+		rs, err := rb.ReadMany(ctx, 0, 4, 4, hz.RingbufferFilterFunc(func(item interface{}) bool {
+			return strings.HasPrefix(item.(string), "good")
+		}))
+		require.NoError(t, err)
+		require.Equal(t, int32(4), rs.ReadCount())
+		require.Equal(t, 2, rs.Size())
+		require.Equal(t, "good2", it.MustValue(rs.Get(1)))
+		require.Equal(t, int64(2), it.MustValue(rs.GetSequence(1)))
+		require.Equal(t, int64(4), rs.GetNextSequenceToReadFrom())
+	})
+}
+
+func TestRingbuffer_Subscribe(t *testing.T) {
+	it.RingbufferTester(t, func(t *testing.T, rb *hz.Ringbuffer) {
+		ctx := context.Background()
+		_, err := rb.AddAll(ctx, hz.OverflowPolicyOverwrite, "a1", "b1", "a2")
+		require.NoError(t, err)
+		store := &testCheckpointStore{checkpoints: map[string]int64{}}
+		opts := hz.RingbufferSubscribeOptions{
+			Filter: hz.RingbufferFilterFunc(func(item interface{}) bool {
+				return strings.HasPrefix(item.(string), "a")
+			}),
+			CheckpointStore: store,
+			BatchSize:       2,
+		}
+		sub, err := rb.Subscribe(ctx, 0, opts)
+		require.NoError(t, err)
+		require.Equal(t, hz.RingbufferItem{Item: "a1", Sequence: 0}, <-sub.Items())
+		require.Equal(t, hz.RingbufferItem{Item: "a2", Sequence: 2}, <-sub.Items())
+		require.NoError(t, sub.Close())
+		_, ok := <-sub.Items()
+		require.False(t, ok)
+		require.Equal(t, int64(3), store.checkpoint(rb.Name()))
+		// the next subscription continues from the checkpoint
+		_, err = rb.AddAll(ctx, hz.OverflowPolicyOverwrite, "b2", "a3")
+		require.NoError(t, err)
+		sub, err = rb.Subscribe(ctx, 0, opts)
+		require.NoError(t, err)
+		defer sub.Close()
+		require.Equal(t, hz.RingbufferItem{Item: "a3", Sequence: 4}, <-sub.Items())
+	})
+}
+
+func TestRingbuffer_Subscribe_StaleSequence(t *testing.T) {
+	it.RingbufferTester(t, func(t *testing.T, rb *hz.Ringbuffer) {
+		ctx := context.Background()
+		capacity := it.MustValue(rb.Capacity(ctx)).(int64)
+		// overwrite the first items
+		for i := int64(0); i < capacity+5; i++ {
+			_, err := rb.Add(ctx, fmt.Sprintf("item-%d", i), hz.OverflowPolicyOverwrite)
+			require.NoError(t, err)
+		}
+		sub, err := rb.Subscribe(ctx, 0, hz.RingbufferSubscribeOptions{})
+		require.NoError(t, err)
+		defer sub.Close()
+		item := <-sub.Items()
+		require.Equal(t, int64(5), item.Sequence)
+		require.Equal(t, "item-5", item.Item)
+	})
+}
+
+func TestRingbuffer_Subscribe_InvalidOptions(t *testing.T) {
+	it.RingbufferTester(t, func(t *testing.T, rb *hz.Ringbuffer) {
+		ctx := context.Background()
+		_, err := rb.Subscribe(ctx, -1, hz.RingbufferSubscribeOptions{})
+		require.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		_, err = rb.Subscribe(ctx, 0, hz.RingbufferSubscribeOptions{BatchSize: hz.MaxBatchSize + 1})
+		require.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+	})
+}
+
+type testCheckpointStore struct {
+	checkpoints map[string]int64
+	mu          sync.Mutex
+}
+
+func (s *testCheckpointStore) LoadCheckpoint(ctx context.Context, id string) (int64, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	seq, ok := s.checkpoints[id]
+	return seq, ok, nil
+}
+
+func (s *testCheckpointStore) StoreCheckpoint(ctx context.Context, id string, sequence int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checkpoints[id] = sequence
+	return nil
+}
+
+func (s *testCheckpointStore) checkpoint(id string) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.checkpoints[id]
+}
@@ -0,0 +1,353 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+)
+
+const (
+	defaultRingbufferSubscriptionBatchSize = 100
+	ringbufferSubscriptionRetryDelay       = 1 * time.Second
+)
+
+// RingbufferFilterFunc is a filter which is executed on the client.
+// It returns true if the item should be returned.
+// It can be passed as the filter argument of Ringbuffer.ReadMany, or set as RingbufferSubscribeOptions.Filter.
+// Unlike the filters executed on the members, it does not reduce the number of items sent to the client.
+type RingbufferFilterFunc func(item interface{}) bool
+
+// RingbufferItem is an item read by a RingbufferSubscription.
+type RingbufferItem struct {
+	Item     interface{}
+	Sequence int64
+}
+
+// RingbufferCheckpointStore persists the progress of a RingbufferSubscription.
+// Its methods are called from the goroutines of the subscription, so they must be safe for concurrent use if the store is shared.
+type RingbufferCheckpointStore interface {
+	// LoadCheckpoint returns the sequence to start reading from for the given subscription.
+	// ok is false if there is no checkpoint for the subscription.
+	LoadCheckpoint(ctx context.Context, id string) (sequence int64, ok bool, err error)
+	// StoreCheckpoint stores the sequence of the next item to read for the given subscription.
+	StoreCheckpoint(ctx context.Context, id string, sequence int64) error
+}
+
+// NewRingbufferCheckpointStore returns a RingbufferCheckpointStore which keeps the checkpoints in the given map.
+// The checkpoints are stored as int64 values with the subscription IDs as the keys.
+func NewRingbufferCheckpointStore(m *Map) RingbufferCheckpointStore {
+	return &mapRingbufferCheckpointStore{m: m}
+}
+
+type mapRingbufferCheckpointStore struct {
+	m *Map
+}
+
+func (s *mapRingbufferCheckpointStore) LoadCheckpoint(ctx context.Context, id string) (int64, bool, error) {
+	value, err := s.m.Get(ctx, id)
+	if err != nil || value == nil {
+		return 0, false, err
+	}
+	seq, ok := value.(int64)
+	if !ok {
+		return 0, false, ihzerrors.NewIllegalStateError(fmt.Sprintf("invalid checkpoint for %s: %v", id, value), nil)
+	}
+	return seq, true, nil
+}
+
+func (s *mapRingbufferCheckpointStore) StoreCheckpoint(ctx context.Context, id string, sequence int64) error {
+	return s.m.Set(ctx, id, sequence)
+}
+
+// RingbufferSubscribeOptions contains the options for Ringbuffer.Subscribe.
+type RingbufferSubscribeOptions struct {
+	// Filter is passed as the filter argument of ReadMany, see Ringbuffer.ReadMany.
+	Filter interface{}
+	// CheckpointStore persists the sequence of the next item to read.
+	// If it is set, the subscription continues from the stored sequence instead of the start sequence.
+	CheckpointStore RingbufferCheckpointStore
+	// CheckpointID identifies the subscription in CheckpointStore.
+	// Defaults to the name of the Ringbuffer.
+	CheckpointID string
+	// BatchSize is the maximum number of items to read at once.
+	// Defaults to 100, or the capacity of the Ringbuffer if it is smaller.
+	BatchSize int32
+}
+
+/*
+RingbufferSubscription reads the items of a Ringbuffer continuously and delivers them in order.
+
+The next batch of items is read while the current batch is being delivered.
+If the items at the current sequence were overwritten, the subscription continues from the head of the Ringbuffer and the lost items are skipped.
+
+If a checkpoint store is configured, the sequence of the next item is stored after each batch is delivered, and when the subscription stops.
+An item is considered delivered once it is received from the Items channel.
+The items delivered after the last stored checkpoint are delivered again if the process exits without stopping the subscription.
+*/
+type RingbufferSubscription struct {
+	rb       *Ringbuffer
+	items    chan RingbufferItem
+	batches  chan ringbufferBatch
+	cancel   context.CancelFunc
+	wg       *sync.WaitGroup
+	errMu    *sync.Mutex
+	err      error
+	opts     RingbufferSubscribeOptions
+	sequence int64
+}
+
+type ringbufferBatch struct {
+	items   []RingbufferItem
+	nextSeq int64
+}
+
+/*
+Subscribe starts reading the items of this Ringbuffer starting with startSequence and returns a subscription which delivers them.
+If a checkpoint store is configured and there is a checkpoint for the subscription, reading starts from the checkpoint instead.
+
+The subscription stops when the given context is canceled, Close is called, or a non-recoverable error occurs.
+The Items channel is closed when the subscription stops.
+
+	sub, err := rb.Subscribe(ctx, 0, hazelcast.RingbufferSubscribeOptions{})
+	if err != nil {
+		// handle the error
+	}
+	for item := range sub.Items() {
+		fmt.Println(item.Sequence, item.Item)
+	}
+	if err := sub.Err(); err != nil {
+		// handle the error
+	}
+*/
+func (rb *Ringbuffer) Subscribe(ctx context.Context, startSequence int64, opts RingbufferSubscribeOptions) (*RingbufferSubscription, error) {
+	if startSequence < 0 {
+		return nil, ihzerrors.NewIllegalArgumentError("startSequence can't be smaller then 0", nil)
+	}
+	if opts.BatchSize < 0 || opts.BatchSize > MaxBatchSize {
+		return nil, ihzerrors.NewIllegalArgumentError(fmt.Sprintf("batch size must be in the range [0,%d]", MaxBatchSize), nil)
+	}
+	if opts.CheckpointID == "" {
+		opts.CheckpointID = rb.name
+	}
+	if opts.BatchSize == 0 {
+		capacity, err := rb.Capacity(ctx)
+		if err != nil {
+			return nil, err
+		}
+		opts.BatchSize = defaultRingbufferSubscriptionBatchSize
+		if capacity < int64(opts.BatchSize) {
+			opts.BatchSize = int32(capacity)
+		}
+	}
+	if opts.CheckpointStore != nil {
+		seq, ok, err := opts.CheckpointStore.LoadCheckpoint(ctx, opts.CheckpointID)
+		if err != nil {
+			return nil, fmt.Errorf("loading checkpoint: %w", err)
+		}
+		if ok {
+			startSequence = seq
+		}
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	s := &RingbufferSubscription{
+		rb:       rb,
+		items:    make(chan RingbufferItem),
+		batches:  make(chan ringbufferBatch, 1),
+		cancel:   cancel,
+		wg:       &sync.WaitGroup{},
+		errMu:    &sync.Mutex{},
+		opts:     opts,
+		sequence: startSequence,
+	}
+	s.wg.Add(2)
+	go s.read(ctx, startSequence)
+	go s.deliver(ctx)
+	return s, nil
+}
+
+// Items returns the channel which delivers the items.
+// The channel is closed when the subscription stops.
+func (s *RingbufferSubscription) Items() <-chan RingbufferItem {
+	return s.items
+}
+
+// Err returns the error which stopped the subscription.
+// It returns nil if the subscription is running, or it was stopped by canceling its context or calling Close.
+func (s *RingbufferSubscription) Err() error {
+	s.errMu.Lock()
+	defer s.errMu.Unlock()
+	return s.err
+}
+
+// Close stops the subscription and waits until the last checkpoint is stored.
+// It returns the error which stopped the subscription, if any.
+func (s *RingbufferSubscription) Close() error {
+	s.cancel()
+	s.wg.Wait()
+	return s.Err()
+}
+
+func (s *RingbufferSubscription) setErr(err error) {
+	s.errMu.Lock()
+	s.err = err
+	s.errMu.Unlock()
+}
+
+// read reads the batches of items and passes them to the delivery goroutine.
+func (s *RingbufferSubscription) read(ctx context.Context, seq int64) {
+	defer s.wg.Done()
+	defer close(s.batches)
+	for ctx.Err() == nil {
+		rs, err := s.rb.ReadMany(ctx, seq, 1, s.opts.BatchSize, s.opts.Filter)
+		if err != nil {
+			next, ok := s.handleError(ctx, seq, err)
+			if !ok {
+				return
+			}
+			seq = next
+			continue
+		}
+		if lost := rs.GetNextSequenceToReadFrom() - int64(rs.ReadCount()) - seq; lost > 0 {
+			s.rb.logger.Warnf("subscription %s on Ringbuffer %s lost %d items", s.opts.CheckpointID, s.rb.name, lost)
+		}
+		batch := ringbufferBatch{
+			items:   make([]RingbufferItem, 0, rs.Size()),
+			nextSeq: rs.GetNextSequenceToReadFrom(),
+		}
+		for i := 0; i < rs.Size(); i++ {
+			item, err := rs.Get(i)
+			if err != nil {
+				s.rb.logger.Warnf("cannot convert data to Go value: %v", err)
+				continue
+			}
+			itemSeq, _ := rs.GetSequence(i)
+			batch.items = append(batch.items, RingbufferItem{Item: item, Sequence: itemSeq})
+		}
+		select {
+		case s.batches <- batch:
+		case <-ctx.Done():
+			return
+		}
+		seq = batch.nextSeq
+	}
+}
+
+// deliver sends the items to the subscriber and stores the checkpoints.
+func (s *RingbufferSubscription) deliver(ctx context.Context) {
+	defer s.wg.Done()
+	defer close(s.items)
+	stored := s.sequence
+	// the context is canceled when the subscription stops, the last checkpoint is stored with a fresh one
+	defer func() {
+		if s.sequence != stored {
+			s.storeCheckpoint(context.Background())
+		}
+	}()
+	for batch := range s.batches {
+		for _, item := range batch.items {
+			select {
+			case s.items <- item:
+				s.sequence = item.Sequence + 1
+			case <-ctx.Done():
+				return
+			}
+		}
+		s.sequence = batch.nextSeq
+		if s.storeCheckpoint(ctx) {
+			stored = s.sequence
+		}
+	}
+}
+
+// storeCheckpoint returns true if the current sequence was stored.
+func (s *RingbufferSubscription) storeCheckpoint(ctx context.Context) bool {
+	if s.opts.CheckpointStore == nil {
+		return true
+	}
+	if err := s.opts.CheckpointStore.StoreCheckpoint(ctx, s.opts.CheckpointID, s.sequence); err != nil {
+		s.rb.logger.Warnf("subscription %s on Ringbuffer %s cannot store the checkpoint: %v", s.opts.CheckpointID, s.rb.name, err)
+		return false
+	}
+	return true
+}
+
+// handleError returns the sequence to continue reading from and true if reading should be retried after the given error.
+func (s *RingbufferSubscription) handleError(ctx context.Context, seq int64, err error) (int64, bool) {
+	if ctx.Err() != nil {
+		return seq, false
+	}
+	switch {
+	case errors.Is(err, hzerrors.ErrStaleSequence):
+		head, err := s.rb.HeadSequence(ctx)
+		if err != nil {
+			return s.handleError(ctx, seq, err)
+		}
+		s.rb.logger.Warnf("subscription %s on Ringbuffer %s lost %d items", s.opts.CheckpointID, s.rb.name, head-seq)
+		return head, true
+	case errors.Is(err, hzerrors.ErrOperationTimeout):
+		// the blocking read timed out since no items were added
+		return seq, true
+	case errors.Is(err, hzerrors.ErrClientNotActive), errors.Is(err, hzerrors.ErrDistributedObjectDestroyed):
+		s.setErr(err)
+		return seq, false
+	case ihzerrors.IsRetryable(err),
+		errors.Is(err, hzerrors.ErrClientOffline),
+		errors.Is(err, hzerrors.ErrHazelcastInstanceNotActive),
+		errors.Is(err, hzerrors.ErrTargetDisconnected),
+		errors.Is(err, hzerrors.ErrIO):
+		// the member may be restarting, or the client may be reconnecting
+		s.rb.logger.Debug(func() string {
+			return fmt.Sprintf("subscription %s on Ringbuffer %s will retry reading: %s", s.opts.CheckpointID, s.rb.name, err.Error())
+		})
+		timer := time.NewTimer(ringbufferSubscriptionRetryDelay)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return seq, false
+		case <-timer.C:
+			return seq, true
+		}
+	}
+	s.rb.logger.Errorf("terminating subscription %s on Ringbuffer %s: %v", s.opts.CheckpointID, s.rb.name, err)
+	s.setErr(err)
+	return seq, false
+}
+
+// filterReadItems returns the items for which the given filter returns true, along with their conversion errors and sequences.
+// The items which could not be converted are kept, so the conversion errors are not lost.
+// If seqs is shorter than items, the returned sequences contain only the ones of the kept items which have a sequence.
+func filterReadItems(filter RingbufferFilterFunc, items []interface{}, errs []error, seqs []int64) ([]interface{}, []error, []int64) {
+	n, seqCount := 0, 0
+	for i, item := range items {
+		if errs[i] != nil || filter(item) {
+			items[n], errs[n] = item, errs[i]
+			if i < len(seqs) {
+				seqs[n] = seqs[i]
+				seqCount = n + 1
+			}
+			n++
+		}
+	}
+	return items[:n], errs[:n], seqs[:seqCount]
+}
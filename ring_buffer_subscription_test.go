@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+)
+
+func TestFilterReadItems(t *testing.T) {
+	even := func(item interface{}) bool {
+		return item.(int)%2 == 0
+	}
+	errConversion := errors.New("conversion error")
+	testCases := []struct {
+		name      string
+		items     []interface{}
+		errs      []error
+		seqs      []int64
+		wantItems []interface{}
+		wantErrs  []error
+		wantSeqs  []int64
+	}{
+		{
+			name:      "all sequences",
+			items:     []interface{}{1, 2, 3, 4},
+			errs:      []error{nil, nil, nil, nil},
+			seqs:      []int64{10, 11, 12, 13},
+			wantItems: []interface{}{2, 4},
+			wantErrs:  []error{nil, nil},
+			wantSeqs:  []int64{11, 13},
+		},
+		{
+			name:      "no sequences",
+			items:     []interface{}{1, 2, 3, 4},
+			errs:      []error{nil, nil, nil, nil},
+			seqs:      []int64{},
+			wantItems: []interface{}{2, 4},
+			wantErrs:  []error{nil, nil},
+			wantSeqs:  []int64{},
+		},
+		{
+			name:      "fewer sequences",
+			items:     []interface{}{1, 2, 3, 4},
+			errs:      []error{nil, nil, nil, nil},
+			seqs:      []int64{10, 11, 12},
+			wantItems: []interface{}{2, 4},
+			wantErrs:  []error{nil, nil},
+			wantSeqs:  []int64{11},
+		},
+		{
+			name:      "conversion errors are kept",
+			items:     []interface{}{1, nil, 3, 4},
+			errs:      []error{nil, errConversion, nil, nil},
+			seqs:      []int64{10, 11, 12, 13},
+			wantItems: []interface{}{nil, 4},
+			wantErrs:  []error{errConversion, nil},
+			wantSeqs:  []int64{11, 13},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			items, errs, seqs := hz.FilterReadItems(func(item interface{}) bool {
+				// the filter is not called for the items which could not be converted
+				if item == nil {
+					t.Fatal("filter called for an item with a conversion error")
+				}
+				return even(item)
+			}, tc.items, tc.errs, tc.seqs)
+			assert.Equal(t, tc.wantItems, items)
+			assert.Equal(t, tc.wantErrs, errs)
+			assert.Equal(t, tc.wantSeqs, seqs)
+		})
+	}
+}
@@ -0,0 +1,162 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+	"github.com/hazelcast/hazelcast-go-client/internal/it/skip"
+)
+
+func TestSemaphore(t *testing.T) {
+	skip.If(t, "enterprise")
+	skip.If(t, "hz < 5")
+	testCases := []struct {
+		name       string
+		f          func(t *testing.T)
+		noParallel bool
+	}{
+		{name: "SemaphoreInit", f: semaphoreInitTest},
+		{name: "SemaphoreAcquire", f: semaphoreAcquireTest},
+		{name: "SemaphoreTryAcquire_WhenNoPermits", f: semaphoreTryAcquireWhenNoPermitsTest},
+		{name: "SemaphoreRelease_WhenNotAcquired", f: semaphoreReleaseWhenNotAcquiredTest},
+		{name: "SemaphoreDrain", f: semaphoreDrainTest},
+		{name: "SemaphoreAcquire_BlocksUntilRelease", f: semaphoreAcquireBlocksUntilReleaseTest},
+	}
+	// run no-parallel test first
+	sort.Slice(testCases, func(i, j int) bool {
+		return testCases[i].noParallel && !testCases[j].noParallel
+	})
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if !tc.noParallel {
+				t.Parallel()
+			}
+			tc.f(t)
+		})
+	}
+}
+
+func semaphoreInitTest(t *testing.T) {
+	it.SemaphoreTester(t, func(t *testing.T, s *hz.Semaphore) {
+		ctx := context.Background()
+		ok, err := s.Init(ctx, 7)
+		require.NoError(t, err)
+		require.True(t, ok)
+		ok, err = s.Init(ctx, 5)
+		require.NoError(t, err)
+		require.False(t, ok)
+		permits, err := s.AvailablePermits(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 7, permits)
+	})
+}
+
+func semaphoreAcquireTest(t *testing.T) {
+	it.SemaphoreTester(t, func(t *testing.T, s *hz.Semaphore) {
+		ctx := context.Background()
+		_, err := s.Init(ctx, 7)
+		require.NoError(t, err)
+		require.NoError(t, s.Acquire(ctx, 3))
+		permits, err := s.AvailablePermits(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 4, permits)
+		require.NoError(t, s.Release(ctx, 3))
+		permits, err = s.AvailablePermits(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 7, permits)
+	})
+}
+
+func semaphoreTryAcquireWhenNoPermitsTest(t *testing.T) {
+	it.SemaphoreTester(t, func(t *testing.T, s *hz.Semaphore) {
+		ctx := context.Background()
+		_, err := s.Init(ctx, 1)
+		require.NoError(t, err)
+		ok, err := s.TryAcquire(ctx, 2, 0)
+		require.NoError(t, err)
+		require.False(t, ok)
+		ok, err = s.TryAcquire(ctx, 2, 500*time.Millisecond)
+		require.NoError(t, err)
+		require.False(t, ok)
+		ok, err = s.TryAcquire(ctx, 1, 0)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.NoError(t, s.Release(ctx, 1))
+	})
+}
+
+func semaphoreReleaseWhenNotAcquiredTest(t *testing.T) {
+	it.SemaphoreTester(t, func(t *testing.T, s *hz.Semaphore) {
+		ctx := context.Background()
+		_, err := s.Init(ctx, 1)
+		require.NoError(t, err)
+		err = s.Release(ctx, 1)
+		require.True(t, errors.Is(err, hzerrors.ErrIllegalState))
+	})
+}
+
+func semaphoreDrainTest(t *testing.T) {
+	it.SemaphoreTester(t, func(t *testing.T, s *hz.Semaphore) {
+		ctx := context.Background()
+		_, err := s.Init(ctx, 10)
+		require.NoError(t, err)
+		require.NoError(t, s.Acquire(ctx, 3))
+		drained, err := s.Drain(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 7, drained)
+		permits, err := s.AvailablePermits(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 0, permits)
+		require.NoError(t, s.Release(ctx, 10))
+	})
+}
+
+func semaphoreAcquireBlocksUntilReleaseTest(t *testing.T) {
+	it.SemaphoreTester(t, func(t *testing.T, s *hz.Semaphore) {
+		ctx := context.Background()
+		_, err := s.Init(ctx, 1)
+		require.NoError(t, err)
+		require.NoError(t, s.Acquire(ctx, 1))
+		acquired := make(chan error, 1)
+		go func() {
+			acquired <- s.Acquire(hz.NewLockContext(ctx), 1)
+		}()
+		select {
+		case <-acquired:
+			t.Fatalf("acquire should block")
+		case <-time.After(500 * time.Millisecond):
+		}
+		require.NoError(t, s.Release(ctx, 1))
+		select {
+		case err := <-acquired:
+			require.NoError(t, err)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("acquire should complete after release")
+		}
+	})
+}
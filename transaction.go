@@ -0,0 +1,317 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/client"
+	"github.com/hazelcast/hazelcast-go-client/internal/cluster"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	iproxy "github.com/hazelcast/hazelcast-go-client/internal/proxy"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	defaultTransactionTimeout    = 2 * time.Minute
+	defaultTransactionDurability = 1
+)
+
+// TransactionType is the commit protocol of a transaction.
+type TransactionType int32
+
+const (
+	// TransactionTypeTwoPhase commits the transaction in two phases.
+	// The transaction log is first replicated to the backup members, so the transaction can be completed even if the member that coordinates it crashes.
+	// This is the default transaction type.
+	TransactionTypeTwoPhase TransactionType = 1
+	// TransactionTypeOnePhase commits the transaction in a single phase.
+	// It is faster than TransactionTypeTwoPhase, but the system may be left in an inconsistent state if a member crashes during the commit.
+	TransactionTypeOnePhase TransactionType = 2
+)
+
+// TransactionOptions contains the options for a transaction.
+// Use NewTransactionOptions to create TransactionOptions with the default values.
+type TransactionOptions struct {
+	// Timeout is the maximum duration of the transaction.
+	// The transaction cannot be committed after it times out.
+	// If zero, defaults to 2 minutes.
+	Timeout time.Duration
+	// Durability is the number of backups of the transaction log.
+	// It is only used for two-phase transactions.
+	// If zero, defaults to 1.
+	Durability int
+	// Type is the commit protocol of the transaction.
+	// If not set, defaults to TransactionTypeTwoPhase.
+	Type TransactionType
+}
+
+// NewTransactionOptions returns TransactionOptions with the default values.
+func NewTransactionOptions() TransactionOptions {
+	return TransactionOptions{
+		Timeout:    defaultTransactionTimeout,
+		Durability: defaultTransactionDurability,
+		Type:       TransactionTypeTwoPhase,
+	}
+}
+
+// Validate validates the options and sets the default values of unset fields.
+func (o *TransactionOptions) Validate() error {
+	if o.Timeout < 0 {
+		return ihzerrors.NewIllegalArgumentError("transaction timeout must be non-negative", nil)
+	}
+	if o.Timeout == 0 {
+		o.Timeout = defaultTransactionTimeout
+	}
+	if o.Durability < 0 {
+		return ihzerrors.NewIllegalArgumentError("transaction durability must be non-negative", nil)
+	}
+	if o.Durability == 0 {
+		o.Durability = defaultTransactionDurability
+	}
+	switch o.Type {
+	case 0:
+		o.Type = TransactionTypeTwoPhase
+	case TransactionTypeOnePhase, TransactionTypeTwoPhase:
+	default:
+		return ihzerrors.NewIllegalArgumentError(fmt.Sprintf("unknown transaction type: %d", o.Type), nil)
+	}
+	return nil
+}
+
+type transactionState int32
+
+const (
+	transactionStateNone transactionState = iota
+	transactionStateActive
+	transactionStateCommitting
+	transactionStateCommitted
+	transactionStateCommitFailed
+	transactionStateRolledBack
+)
+
+/*
+TransactionContext provides access to the transactional data structures.
+
+A transaction starts with a call to Begin and completes with either Commit or Rollback.
+The transactional data structures must be retrieved after the transaction starts.
+All operations of a transaction are sent over the same connection, so the transaction fails if that connection is closed.
+
+A TransactionContext can be used for a single transaction only.
+Create a new one with Client.NewTransactionContext for each transaction.
+*/
+type TransactionContext struct {
+	startTime time.Time
+	bundle    creationBundle
+	connMgr   *cluster.ConnectionManager
+	conn      *cluster.Connection
+	invoker   *client.Invoker
+	refIDGen  *iproxy.ReferenceIDGenerator
+	mu        *sync.Mutex
+	proxies   map[string]interface{}
+	opts      TransactionOptions
+	txnID     types.UUID
+	threadID  int64
+	state     transactionState
+}
+
+func newTransactionContext(bundle creationBundle, connMgr *cluster.ConnectionManager, refIDGen *iproxy.ReferenceIDGenerator, opts TransactionOptions) *TransactionContext {
+	return &TransactionContext{
+		bundle:   bundle,
+		connMgr:  connMgr,
+		invoker:  bundle.Invoker,
+		refIDGen: refIDGen,
+		mu:       &sync.Mutex{},
+		proxies:  map[string]interface{}{},
+		opts:     opts,
+		threadID: iproxy.NextLockID(),
+	}
+}
+
+// TransactionID returns the ID of the transaction.
+// It returns zero value of types.UUID{} if the transaction is not started yet.
+func (tc *TransactionContext) TransactionID() types.UUID {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	return tc.txnID
+}
+
+// Begin starts the transaction.
+func (tc *TransactionContext) Begin(ctx context.Context) error {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	if tc.state == transactionStateActive {
+		return ihzerrors.NewIllegalStateError("transaction is already active", nil)
+	}
+	if tc.state != transactionStateNone {
+		return ihzerrors.NewIllegalStateError("transaction context cannot be reused", nil)
+	}
+	conn := tc.connMgr.RandomConnection()
+	if conn == nil {
+		return ihzerrors.NewIOError("no connection found", nil)
+	}
+	o := tc.opts
+	request := codec.EncodeTransactionCreateRequest(o.Timeout.Milliseconds(), int32(o.Durability), int32(o.Type), tc.threadID)
+	resp, err := tc.invoker.InvokeOnConnection(ctx, request, conn)
+	if err != nil {
+		return err
+	}
+	tc.txnID = codec.DecodeTransactionCreateResponse(resp)
+	tc.conn = conn
+	tc.startTime = time.Now()
+	tc.state = transactionStateActive
+	return nil
+}
+
+// Commit commits the transaction.
+// If the commit fails, the transaction should be rolled back with Rollback.
+func (tc *TransactionContext) Commit(ctx context.Context) error {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	if tc.state != transactionStateActive {
+		return ihzerrors.NewClientError("transaction is not active", nil, hzerrors.ErrTransactionNotActive)
+	}
+	if time.Since(tc.startTime) > tc.opts.Timeout {
+		tc.state = transactionStateCommitFailed
+		return ihzerrors.NewClientError("transaction is timed-out", nil, hzerrors.ErrTransactionTimedOut)
+	}
+	tc.state = transactionStateCommitting
+	request := codec.EncodeTransactionCommitRequest(tc.txnID, tc.threadID)
+	if _, err := tc.invoker.InvokeOnConnection(ctx, request, tc.conn); err != nil {
+		tc.state = transactionStateCommitFailed
+		return err
+	}
+	tc.state = transactionStateCommitted
+	return nil
+}
+
+// Rollback rolls back the transaction.
+// The transaction is marked as rolled back even if the request fails, since the cluster rolls back the transactions of disconnected clients.
+func (tc *TransactionContext) Rollback(ctx context.Context) error {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	switch tc.state {
+	case transactionStateActive, transactionStateCommitFailed:
+	default:
+		return ihzerrors.NewClientError("transaction is not active", nil, hzerrors.ErrTransactionNotActive)
+	}
+	request := codec.EncodeTransactionRollbackRequest(tc.txnID, tc.threadID)
+	_, err := tc.invoker.InvokeOnConnection(ctx, request, tc.conn)
+	tc.state = transactionStateRolledBack
+	return err
+}
+
+// GetMap returns the transactional map with the given name.
+func (tc *TransactionContext) GetMap(ctx context.Context, name string) (*TransactionalMap, error) {
+	p, err := tc.proxyFor(ctx, ServiceNameMap, name, func(p *transactionalProxy) interface{} {
+		return newTransactionalMap(p)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p.(*TransactionalMap), nil
+}
+
+// GetMultiMap returns the transactional multi map with the given name.
+func (tc *TransactionContext) GetMultiMap(ctx context.Context, name string) (*TransactionalMultiMap, error) {
+	p, err := tc.proxyFor(ctx, ServiceNameMultiMap, name, func(p *transactionalProxy) interface{} {
+		return newTransactionalMultiMap(p)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p.(*TransactionalMultiMap), nil
+}
+
+// GetQueue returns the transactional queue with the given name.
+func (tc *TransactionContext) GetQueue(ctx context.Context, name string) (*TransactionalQueue, error) {
+	p, err := tc.proxyFor(ctx, ServiceNameQueue, name, func(p *transactionalProxy) interface{} {
+		return newTransactionalQueue(p)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p.(*TransactionalQueue), nil
+}
+
+// GetList returns the transactional list with the given name.
+func (tc *TransactionContext) GetList(ctx context.Context, name string) (*TransactionalList, error) {
+	p, err := tc.proxyFor(ctx, ServiceNameList, name, func(p *transactionalProxy) interface{} {
+		return newTransactionalList(p)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p.(*TransactionalList), nil
+}
+
+// GetSet returns the transactional set with the given name.
+func (tc *TransactionContext) GetSet(ctx context.Context, name string) (*TransactionalSet, error) {
+	p, err := tc.proxyFor(ctx, ServiceNameSet, name, func(p *transactionalProxy) interface{} {
+		return newTransactionalSet(p)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p.(*TransactionalSet), nil
+}
+
+func (tc *TransactionContext) proxyFor(ctx context.Context, serviceName string, objectName string, wrapProxyFn func(p *transactionalProxy) interface{}) (interface{}, error) {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	if tc.state != transactionStateActive {
+		return nil, ihzerrors.NewClientError("no transaction is found", nil, hzerrors.ErrTransactionNotActive)
+	}
+	name := makeProxyName(serviceName, objectName)
+	if wrapper, ok := tc.proxies[name]; ok {
+		return wrapper, nil
+	}
+	// transactional proxies are not registered to the proxy manager, the cluster creates the object on first use.
+	p, err := newProxy(ctx, tc.bundle, serviceName, objectName, tc.refIDGen, func(ctx context.Context) bool {
+		return true
+	}, false)
+	if err != nil {
+		return nil, err
+	}
+	wrapper := wrapProxyFn(&transactionalProxy{
+		proxy:    p,
+		conn:     tc.conn,
+		txnID:    tc.txnID,
+		threadID: tc.threadID,
+	})
+	tc.proxies[name] = wrapper
+	return wrapper, nil
+}
+
+// transactionalProxy is the base of the transactional data structures.
+// All of its invocations are bound to the connection of the transaction.
+type transactionalProxy struct {
+	*proxy
+	conn     *cluster.Connection
+	txnID    types.UUID
+	threadID int64
+}
+
+func (p *transactionalProxy) invoke(ctx context.Context, request *proto.ClientMessage) (*proto.ClientMessage, error) {
+	return p.invoker.InvokeOnConnection(ctx, request, p.conn)
+}
@@ -0,0 +1,124 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+)
+
+func TestTransaction_MapCommit(t *testing.T) {
+	it.Tester(t, func(t *testing.T, client *hazelcast.Client) {
+		ctx := context.Background()
+		name := it.NewUniqueObjectName("txmap")
+		tc := mustBeginTransaction(t, client, hazelcast.NewTransactionOptions())
+		tm, err := tc.GetMap(ctx, name)
+		require.NoError(t, err)
+		_, err = tm.Put(ctx, "k1", "v1")
+		require.NoError(t, err)
+		require.NoError(t, tm.Set(ctx, "k2", "v2"))
+		v, err := tm.Get(ctx, "k1")
+		require.NoError(t, err)
+		assert.Equal(t, "v1", v)
+		// the changes must not be visible outside the transaction
+		m := it.MustValue(client.GetMap(ctx, name)).(*hazelcast.Map)
+		assert.False(t, it.MustBool(m.ContainsKey(ctx, "k1")))
+		require.NoError(t, tc.Commit(ctx))
+		assert.Equal(t, "v1", it.MustValue(m.Get(ctx, "k1")))
+		assert.Equal(t, "v2", it.MustValue(m.Get(ctx, "k2")))
+	})
+}
+
+func TestTransaction_MapRollback(t *testing.T) {
+	it.Tester(t, func(t *testing.T, client *hazelcast.Client) {
+		ctx := context.Background()
+		name := it.NewUniqueObjectName("txmap")
+		tc := mustBeginTransaction(t, client, hazelcast.TransactionOptions{Type: hazelcast.TransactionTypeOnePhase})
+		tm, err := tc.GetMap(ctx, name)
+		require.NoError(t, err)
+		_, err = tm.Put(ctx, "k1", "v1")
+		require.NoError(t, err)
+		require.NoError(t, tc.Rollback(ctx))
+		m := it.MustValue(client.GetMap(ctx, name)).(*hazelcast.Map)
+		assert.False(t, it.MustBool(m.ContainsKey(ctx, "k1")))
+	})
+}
+
+func TestTransaction_QueueListSetMultiMap(t *testing.T) {
+	it.Tester(t, func(t *testing.T, client *hazelcast.Client) {
+		ctx := context.Background()
+		tc := mustBeginTransaction(t, client, hazelcast.NewTransactionOptions())
+		q, err := tc.GetQueue(ctx, it.NewUniqueObjectName("txqueue"))
+		require.NoError(t, err)
+		assert.True(t, it.MustBool(q.Offer(ctx, "item")))
+		assert.Equal(t, "item", it.MustValue(q.Peek(ctx)))
+		l, err := tc.GetList(ctx, it.NewUniqueObjectName("txlist"))
+		require.NoError(t, err)
+		assert.True(t, it.MustBool(l.Add(ctx, "item")))
+		s, err := tc.GetSet(ctx, it.NewUniqueObjectName("txset"))
+		require.NoError(t, err)
+		assert.True(t, it.MustBool(s.Add(ctx, "item")))
+		assert.False(t, it.MustBool(s.Add(ctx, "item")))
+		mm, err := tc.GetMultiMap(ctx, it.NewUniqueObjectName("txmultimap"))
+		require.NoError(t, err)
+		assert.True(t, it.MustBool(mm.Put(ctx, "k", "v1")))
+		assert.True(t, it.MustBool(mm.Put(ctx, "k", "v2")))
+		assert.Equal(t, 2, it.MustValue(mm.ValueCount(ctx, "k")))
+		require.NoError(t, tc.Commit(ctx))
+	})
+}
+
+func TestTransaction_NotActive(t *testing.T) {
+	it.Tester(t, func(t *testing.T, client *hazelcast.Client) {
+		ctx := context.Background()
+		tc, err := client.NewTransactionContext(hazelcast.NewTransactionOptions())
+		require.NoError(t, err)
+		_, err = tc.GetMap(ctx, "txmap")
+		assert.True(t, errors.Is(err, hzerrors.ErrTransactionNotActive))
+		assert.True(t, errors.Is(tc.Commit(ctx), hzerrors.ErrTransactionNotActive))
+		require.NoError(t, tc.Begin(ctx))
+		require.NoError(t, tc.Commit(ctx))
+		assert.True(t, errors.Is(tc.Commit(ctx), hzerrors.ErrTransactionNotActive))
+		assert.True(t, errors.Is(tc.Rollback(ctx), hzerrors.ErrTransactionNotActive))
+	})
+}
+
+func TestTransaction_Timeout(t *testing.T) {
+	it.Tester(t, func(t *testing.T, client *hazelcast.Client) {
+		ctx := context.Background()
+		tc := mustBeginTransaction(t, client, hazelcast.TransactionOptions{Timeout: 100 * time.Millisecond})
+		time.Sleep(200 * time.Millisecond)
+		assert.True(t, errors.Is(tc.Commit(ctx), hzerrors.ErrTransactionTimedOut))
+		require.NoError(t, tc.Rollback(ctx))
+	})
+}
+
+func mustBeginTransaction(t *testing.T, client *hazelcast.Client, opts hazelcast.TransactionOptions) *hazelcast.TransactionContext {
+	tc, err := client.NewTransactionContext(opts)
+	require.NoError(t, err)
+	require.NoError(t, tc.Begin(context.Background()))
+	return tc
+}
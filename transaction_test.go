@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+)
+
+func TestTransactionOptions_Validate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		opts       hazelcast.TransactionOptions
+		durability int
+		err        bool
+	}{
+		{name: "default", opts: hazelcast.NewTransactionOptions(), durability: 1},
+		{name: "zero value", opts: hazelcast.TransactionOptions{}, durability: 1},
+		{name: "one phase", opts: hazelcast.TransactionOptions{Type: hazelcast.TransactionTypeOnePhase}, durability: 1},
+		{name: "two backups", opts: hazelcast.TransactionOptions{Durability: 2}, durability: 2},
+		{name: "negative timeout", opts: hazelcast.TransactionOptions{Timeout: -1}, err: true},
+		{name: "negative durability", opts: hazelcast.TransactionOptions{Durability: -1}, err: true},
+		{name: "unknown type", opts: hazelcast.TransactionOptions{Type: 3}, err: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.opts.Validate()
+			if tc.err {
+				assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tc.opts.Timeout > 0)
+			assert.NotZero(t, tc.opts.Type)
+			assert.Equal(t, tc.durability, tc.opts.Durability)
+		})
+	}
+}
+
+func TestTransactionOptions_Defaults(t *testing.T) {
+	opts := hazelcast.NewTransactionOptions()
+	assert.Equal(t, 2*time.Minute, opts.Timeout)
+	assert.Equal(t, 1, opts.Durability)
+	assert.Equal(t, hazelcast.TransactionTypeTwoPhase, opts.Type)
+}
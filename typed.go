@@ -0,0 +1,173 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/hazelcast/hazelcast-go-client/cluster"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/logger"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+// TypedEntry is a key-value pair with typed key and value.
+type TypedEntry[K, V any] struct {
+	Key   K
+	Value V
+}
+
+// TypedEntryNotified is the typed counterpart of EntryNotified.
+// Values which do not exist in the event are the zero values of their types.
+type TypedEntryNotified[K, V any] struct {
+	Key                     K
+	Value                   V
+	OldValue                V
+	MergingValue            V
+	MapName                 string
+	Member                  cluster.MemberInfo
+	NumberOfAffectedEntries int
+	EventType               EntryEventType
+}
+
+// TypedMapListener is the typed counterpart of MapListener.
+// Events with keys or values that cannot be converted to K or V are logged and dropped.
+type TypedMapListener[K, V any] struct {
+	EntryAdded   func(event *TypedEntryNotified[K, V])
+	EntryRemoved func(event *TypedEntryNotified[K, V])
+	EntryUpdated func(event *TypedEntryNotified[K, V])
+	EntryEvicted func(event *TypedEntryNotified[K, V])
+	EntryExpired func(event *TypedEntryNotified[K, V])
+	MapEvicted   func(event *TypedEntryNotified[K, V])
+	MapCleared   func(event *TypedEntryNotified[K, V])
+	EntryMerged  func(event *TypedEntryNotified[K, V])
+	EntryLoaded  func(event *TypedEntryNotified[K, V])
+}
+
+func (l TypedMapListener[K, V]) untyped(lg logger.LogAdaptor) MapListener {
+	return MapListener{
+		EntryAdded:   typedEntryHandler(l.EntryAdded, lg),
+		EntryRemoved: typedEntryHandler(l.EntryRemoved, lg),
+		EntryUpdated: typedEntryHandler(l.EntryUpdated, lg),
+		EntryEvicted: typedEntryHandler(l.EntryEvicted, lg),
+		EntryExpired: typedEntryHandler(l.EntryExpired, lg),
+		MapEvicted:   typedEntryHandler(l.MapEvicted, lg),
+		MapCleared:   typedEntryHandler(l.MapCleared, lg),
+		EntryMerged:  typedEntryHandler(l.EntryMerged, lg),
+		EntryLoaded:  typedEntryHandler(l.EntryLoaded, lg),
+	}
+}
+
+// typedEntryHandler returns nil if handler is nil, so the corresponding event is not requested from the members.
+func typedEntryHandler[K, V any](handler func(event *TypedEntryNotified[K, V]), lg logger.LogAdaptor) func(event *EntryNotified) {
+	if handler == nil {
+		return nil
+	}
+	return func(event *EntryNotified) {
+		e, err := convertEntryNotified[K, V](event)
+		if err != nil {
+			lg.Errorf("converting entry notified event of %s: %w", event.MapName, err)
+			return
+		}
+		handler(e)
+	}
+}
+
+func convertEntryNotified[K, V any](event *EntryNotified) (*TypedEntryNotified[K, V], error) {
+	e := &TypedEntryNotified[K, V]{
+		MapName:                 event.MapName,
+		Member:                  event.Member,
+		NumberOfAffectedEntries: event.NumberOfAffectedEntries,
+		EventType:               event.EventType,
+	}
+	var err error
+	if e.Key, err = convertTo[K](event.Key); err != nil {
+		return nil, err
+	}
+	if e.Value, err = convertTo[V](event.Value); err != nil {
+		return nil, err
+	}
+	if e.OldValue, err = convertTo[V](event.OldValue); err != nil {
+		return nil, err
+	}
+	if e.MergingValue, err = convertTo[V](event.MergingValue); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// convertTo converts value to T.
+// nil is converted to the zero value of T.
+// Returns an error wrapping hzerrors.ErrClassCast if value is not a T.
+func convertTo[T any](value interface{}) (T, error) {
+	var zero T
+	if value == nil {
+		return zero, nil
+	}
+	v, ok := value.(T)
+	if !ok {
+		msg := fmt.Sprintf("cannot convert %T to %s", value, reflect.TypeOf((*T)(nil)).Elem())
+		return zero, ihzerrors.NewClassCastError(msg, nil)
+	}
+	return v, nil
+}
+
+func convertValueAndError[T any](value interface{}, err error) (T, error) {
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return convertTo[T](value)
+}
+
+func convertSlice[T any](values []interface{}, err error) ([]T, error) {
+	if err != nil {
+		return nil, err
+	}
+	ts := make([]T, len(values))
+	for i, v := range values {
+		if ts[i], err = convertTo[T](v); err != nil {
+			return nil, err
+		}
+	}
+	return ts, nil
+}
+
+func convertEntries[K, V any](entries []types.Entry, err error) ([]TypedEntry[K, V], error) {
+	if err != nil {
+		return nil, err
+	}
+	es := make([]TypedEntry[K, V], len(entries))
+	for i, e := range entries {
+		if es[i].Key, err = convertTo[K](e.Key); err != nil {
+			return nil, err
+		}
+		if es[i].Value, err = convertTo[V](e.Value); err != nil {
+			return nil, err
+		}
+	}
+	return es, nil
+}
+
+func untypedSlice[T any](values []T) []interface{} {
+	vs := make([]interface{}, len(values))
+	for i, v := range values {
+		vs[i] = v
+	}
+	return vs
+}
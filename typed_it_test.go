@@ -0,0 +1,141 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+)
+
+func TestTypedMap_PutGet(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		tm := hz.NewTypedMap[string, int64](m)
+		old, err := tm.Put(ctx, "k1", 10)
+		require.NoError(t, err)
+		require.Equal(t, int64(0), old)
+		old, err = tm.Put(ctx, "k1", 20)
+		require.NoError(t, err)
+		require.Equal(t, int64(10), old)
+		v, err := tm.Get(ctx, "k1")
+		require.NoError(t, err)
+		require.Equal(t, int64(20), v)
+		require.NoError(t, tm.PutAll(ctx, hz.TypedEntry[string, int64]{Key: "k2", Value: 30}))
+		keys, err := tm.GetKeySet(ctx)
+		require.NoError(t, err)
+		sort.Strings(keys)
+		require.Equal(t, []string{"k1", "k2"}, keys)
+		entries, err := tm.GetAll(ctx, "k2")
+		require.NoError(t, err)
+		require.Equal(t, []hz.TypedEntry[string, int64]{{Key: "k2", Value: 30}}, entries)
+	})
+}
+
+func TestTypedMap_ConversionError(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		it.MustValue(m.Put(ctx, "k1", "not a number"))
+		tm := hz.NewTypedMap[string, int64](m)
+		_, err := tm.Get(ctx, "k1")
+		require.True(t, errors.Is(err, hzerrors.ErrClassCast))
+		_, err = tm.GetValues(ctx)
+		require.True(t, errors.Is(err, hzerrors.ErrClassCast))
+	})
+}
+
+func TestTypedMap_AddListener(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		tm := hz.NewTypedMap[string, int64](m)
+		var total int64
+		_, err := tm.AddListener(ctx, hz.TypedMapListener[string, int64]{
+			EntryAdded: func(event *hz.TypedEntryNotified[string, int64]) {
+				atomic.AddInt64(&total, event.Value)
+			},
+		}, true)
+		require.NoError(t, err)
+		require.NoError(t, tm.Set(ctx, "k1", 10))
+		require.NoError(t, tm.Set(ctx, "k2", 32))
+		it.Eventually(t, func() bool {
+			return atomic.LoadInt64(&total) == 42
+		})
+	})
+}
+
+func TestTypedQueue(t *testing.T) {
+	it.QueueTester(t, func(t *testing.T, q *hz.Queue) {
+		ctx := context.Background()
+		tq := hz.NewTypedQueue[string](q)
+		it.MustValue(tq.AddAll(ctx, "a", "b"))
+		v, err := tq.Poll(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "a", v)
+		items, err := tq.Drain(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []string{"b"}, items)
+		// the queue is empty
+		v, err = tq.Poll(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "", v)
+	})
+}
+
+func TestTypedList(t *testing.T) {
+	it.ListTester(t, func(t *testing.T, l *hz.List) {
+		ctx := context.Background()
+		tl := hz.NewTypedList[int64](l)
+		it.MustValue(tl.AddAll(ctx, 1, 2, 3))
+		v, err := tl.Get(ctx, 1)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), v)
+		all, err := tl.GetAll(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []int64{1, 2, 3}, all)
+	})
+}
+
+func TestTypedReplicatedMap(t *testing.T) {
+	it.ReplicatedMapTester(t, func(t *testing.T, m *hz.ReplicatedMap) {
+		ctx := context.Background()
+		tm := hz.NewTypedReplicatedMap[string, string](m)
+		it.MustValue(tm.Put(ctx, "k1", "v1"))
+		v, err := tm.Get(ctx, "k1")
+		require.NoError(t, err)
+		require.Equal(t, "v1", v)
+	})
+}
+
+func TestTypedMultiMap(t *testing.T) {
+	it.MultiMapTester(t, func(t *testing.T, m *hz.MultiMap) {
+		ctx := context.Background()
+		tm := hz.NewTypedMultiMap[string, int64](m)
+		require.NoError(t, tm.PutAll(ctx, "k1", 1, 2))
+		vs, err := tm.Get(ctx, "k1")
+		require.NoError(t, err)
+		sort.Slice(vs, func(i, j int) bool { return vs[i] < vs[j] })
+		require.Equal(t, []int64{1, 2}, vs)
+	})
+}
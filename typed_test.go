@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+)
+
+func TestConvertTo(t *testing.T) {
+	v, err := hazelcast.ConvertTo[int64](int64(42))
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), v)
+	// nil is converted to the zero value
+	s, err := hazelcast.ConvertTo[string](nil)
+	require.NoError(t, err)
+	assert.Equal(t, "", s)
+	p, err := hazelcast.ConvertTo[*string](nil)
+	require.NoError(t, err)
+	assert.Nil(t, p)
+	// interface types are supported
+	e, err := hazelcast.ConvertTo[error](errors.New("foo"))
+	require.NoError(t, err)
+	assert.EqualError(t, e, "foo")
+}
+
+func TestConvertTo_Mismatch(t *testing.T) {
+	_, err := hazelcast.ConvertTo[int64](int32(42))
+	require.True(t, errors.Is(err, hzerrors.ErrClassCast))
+	assert.Contains(t, err.Error(), "cannot convert int32 to int64")
+	_, err = hazelcast.ConvertTo[error]("foo")
+	require.True(t, errors.Is(err, hzerrors.ErrClassCast))
+	assert.Contains(t, err.Error(), "cannot convert string to error")
+}
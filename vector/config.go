@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vector
+
+import (
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/check"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+)
+
+const (
+	maxDimension          = 16_000
+	defaultMaxDegree      = 16
+	defaultEfConstruction = 100
+)
+
+// Config is the VectorCollection configuration.
+// The configuration is sent to the cluster when the VectorCollection is first retrieved.
+type Config struct {
+	// Indexes are the vector indexes of the collection.
+	// At least one index is required.
+	// If there are more than one index, each index must have a unique name.
+	Indexes []IndexConfig `json:",omitempty"`
+}
+
+// Clone returns a copy of the configuration.
+func (c Config) Clone() Config {
+	indexes := make([]IndexConfig, len(c.Indexes))
+	copy(indexes, c.Indexes)
+	return Config{Indexes: indexes}
+}
+
+// Validate validates the configuration and replaces missing configuration with defaults.
+func (c *Config) Validate() error {
+	if len(c.Indexes) == 0 {
+		return ihzerrors.NewInvalidConfigurationError("vector.Config: Indexes: at least one index is required", nil)
+	}
+	names := make(map[string]struct{}, len(c.Indexes))
+	for i := range c.Indexes {
+		ic := &c.Indexes[i]
+		if len(c.Indexes) > 1 {
+			if ic.Name == "" {
+				return ihzerrors.NewInvalidConfigurationError("vector.Config: Indexes: index name is required when there are multiple indexes", nil)
+			}
+			if _, ok := names[ic.Name]; ok {
+				msg := fmt.Sprintf("vector.Config: Indexes: duplicate index name: %s", ic.Name)
+				return ihzerrors.NewInvalidConfigurationError(msg, nil)
+			}
+			names[ic.Name] = struct{}{}
+		}
+		if err := ic.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexConfig is the configuration of a vector index.
+type IndexConfig struct {
+	// Name is the name of the index.
+	// It may be left blank if the collection has a single index.
+	Name string `json:",omitempty"`
+	// Metric is the similarity metric of the index.
+	// The default is MetricEuclidean.
+	Metric Metric `json:",omitempty"`
+	// Dimension is the number of components of the vectors stored in the index.
+	// The allowed range is [1, 16000].
+	Dimension int32 `json:",omitempty"`
+	// MaxDegree is the maximum number of neighbours per node in the index graph.
+	// The default is 16.
+	MaxDegree int32 `json:",omitempty"`
+	// EfConstruction is the size of the search queue to use when finding nearest neighbours during index construction.
+	// The default is 100.
+	EfConstruction int32 `json:",omitempty"`
+	// DisableDeduplication disables storing identical vectors only once in the index.
+	// Deduplication is enabled by default.
+	DisableDeduplication bool `json:",omitempty"`
+}
+
+// Validate validates the configuration and replaces missing configuration with defaults.
+func (c *IndexConfig) Validate() error {
+	if err := check.WithinRangeInt32(c.Dimension, 1, maxDimension); err != nil {
+		return fmt.Errorf("vector.IndexConfig: Dimension: %w", err)
+	}
+	if err := check.WithinRangeInt32(int32(c.Metric), int32(MetricEuclidean), int32(MetricDot)); err != nil {
+		return fmt.Errorf("vector.IndexConfig: Metric: %w", err)
+	}
+	if c.MaxDegree == 0 {
+		c.MaxDegree = defaultMaxDegree
+	} else if c.MaxDegree < 0 {
+		return ihzerrors.NewInvalidConfigurationError("vector.IndexConfig: MaxDegree: must be positive", nil)
+	}
+	if c.EfConstruction == 0 {
+		c.EfConstruction = defaultEfConstruction
+	} else if c.EfConstruction < 0 {
+		return ihzerrors.NewInvalidConfigurationError("vector.IndexConfig: EfConstruction: must be positive", nil)
+	}
+	return nil
+}
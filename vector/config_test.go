@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vector_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hazelcast/hazelcast-go-client/vector"
+)
+
+func TestConfig_Validate_Defaults(t *testing.T) {
+	cfg := vector.Config{Indexes: []vector.IndexConfig{{Dimension: 3}}}
+	require.NoError(t, cfg.Validate())
+	target := vector.IndexConfig{
+		Metric:         vector.MetricEuclidean,
+		Dimension:      3,
+		MaxDegree:      16,
+		EfConstruction: 100,
+	}
+	assert.Equal(t, target, cfg.Indexes[0])
+}
+
+func TestConfig_Validate_Invalid(t *testing.T) {
+	testCases := []struct {
+		name string
+		cfg  vector.Config
+	}{
+		{name: "no indexes", cfg: vector.Config{}},
+		{name: "zero dimension", cfg: vector.Config{Indexes: []vector.IndexConfig{{}}}},
+		{name: "large dimension", cfg: vector.Config{Indexes: []vector.IndexConfig{{Dimension: 16_001}}}},
+		{name: "invalid metric", cfg: vector.Config{Indexes: []vector.IndexConfig{{Dimension: 3, Metric: 3}}}},
+		{name: "negative max degree", cfg: vector.Config{Indexes: []vector.IndexConfig{{Dimension: 3, MaxDegree: -1}}}},
+		{name: "unnamed index", cfg: vector.Config{Indexes: []vector.IndexConfig{{Name: "a", Dimension: 3}, {Dimension: 3}}}},
+		{name: "duplicate name", cfg: vector.Config{Indexes: []vector.IndexConfig{{Name: "a", Dimension: 3}, {Name: "a", Dimension: 3}}}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Error(t, tc.cfg.Validate())
+		})
+	}
+}
+
+func TestMetric_MarshalText(t *testing.T) {
+	for _, m := range []vector.Metric{vector.MetricEuclidean, vector.MetricCosine, vector.MetricDot} {
+		b, err := m.MarshalText()
+		require.NoError(t, err)
+		var u vector.Metric
+		require.NoError(t, u.UnmarshalText(b))
+		assert.Equal(t, m, u)
+	}
+	var m vector.Metric
+	require.Error(t, m.UnmarshalText([]byte("manhattan")))
+}
@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vector
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+)
+
+// Metric is the similarity metric used to compare vectors in an index.
+type Metric int32
+
+const (
+	// MetricEuclidean compares vectors using the Euclidean distance.
+	// The score is 1/(1+squaredEuclideanDistance).
+	MetricEuclidean Metric = iota
+	// MetricCosine compares vectors using the cosine of the angle between them.
+	// The score is (1+cos)/2.
+	MetricCosine
+	// MetricDot compares vectors using the dot product.
+	// Vectors must be normalized when this metric is used.
+	MetricDot
+)
+
+// String returns a string representation of the metric.
+func (m Metric) String() string {
+	switch m {
+	case MetricEuclidean:
+		return "EUCLIDEAN"
+	case MetricCosine:
+		return "COSINE"
+	case MetricDot:
+		return "DOT"
+	}
+	return fmt.Sprintf("UNKNOWN(%d)", int32(m))
+}
+
+// UnmarshalText unmarshals the given byte array to a Metric.
+func (m *Metric) UnmarshalText(b []byte) error {
+	s := string(b)
+	switch strings.ToLower(s) {
+	case "euclidean":
+		*m = MetricEuclidean
+	case "cosine":
+		*m = MetricCosine
+	case "dot":
+		*m = MetricDot
+	default:
+		msg := fmt.Sprintf("unknown metric: %s", s)
+		return hzerrors.NewIllegalArgumentError(msg, nil)
+	}
+	return nil
+}
+
+// MarshalText marshals the Metric to text.
+func (m Metric) MarshalText() ([]byte, error) {
+	switch m {
+	case MetricEuclidean:
+		return []byte("euclidean"), nil
+	case MetricCosine:
+		return []byte("cosine"), nil
+	case MetricDot:
+		return []byte("dot"), nil
+	default:
+		err := hzerrors.NewIllegalArgumentError(fmt.Sprintf("unknown metric: %d", m), nil)
+		return nil, err
+	}
+}
+
+// Vector is a named dense vector.
+// Name is the name of the index the vector belongs to.
+// It may be left blank if the collection has a single unnamed index.
+type Vector struct {
+	Name   string
+	Values []float32
+}
+
+// NewVector creates an unnamed vector with the given values.
+func NewVector(values ...float32) Vector {
+	return Vector{Values: values}
+}
+
+// Document is a value stored in a VectorCollection together with its vectors.
+// The document must contain a vector for each index of the collection.
+type Document struct {
+	Value   interface{}
+	Vectors []Vector
+}
+
+// NewDocument creates a document with the given value and vectors.
+func NewDocument(value interface{}, vectors ...Vector) Document {
+	return Document{Value: value, Vectors: vectors}
+}
+
+// SearchResult is a document found by a similarity search.
+// Value is nil unless the value was requested in the search.
+// Vectors is nil unless the vectors were requested in the search.
+type SearchResult struct {
+	Key     interface{}
+	Value   interface{}
+	Vectors []Vector
+	// Score is the similarity of the document to the searched vector.
+	// Higher scores are more similar.
+	Score float32
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+	"github.com/hazelcast/hazelcast-go-client/vector"
+)
+
+func TestVectorCollection_PutGetDelete(t *testing.T) {
+	vectorCollectionTester(t, func(t *testing.T, vc *hz.VectorCollection) {
+		ctx := context.Background()
+		doc, err := vc.Put(ctx, "k1", vector.NewDocument("v1", vector.NewVector(1, 0, 0)))
+		require.NoError(t, err)
+		require.Nil(t, doc)
+		doc, err = vc.Put(ctx, "k1", vector.NewDocument("v2", vector.NewVector(0, 1, 0)))
+		require.NoError(t, err)
+		require.Equal(t, &vector.Document{Value: "v1", Vectors: []vector.Vector{vector.NewVector(1, 0, 0)}}, doc)
+		require.NoError(t, vc.Set(ctx, "k2", vector.NewDocument("v3", vector.NewVector(0, 0, 1))))
+		doc, err = vc.Get(ctx, "k2")
+		require.NoError(t, err)
+		require.Equal(t, &vector.Document{Value: "v3", Vectors: []vector.Vector{vector.NewVector(0, 0, 1)}}, doc)
+		require.NoError(t, vc.Delete(ctx, "k2"))
+		doc, err = vc.Get(ctx, "k2")
+		require.NoError(t, err)
+		require.Nil(t, doc)
+	})
+}
+
+func TestVectorCollection_SearchNearest(t *testing.T) {
+	vectorCollectionTester(t, func(t *testing.T, vc *hz.VectorCollection) {
+		ctx := context.Background()
+		require.NoError(t, vc.Set(ctx, "x", vector.NewDocument("vx", vector.NewVector(1, 0, 0))))
+		require.NoError(t, vc.Set(ctx, "y", vector.NewDocument("vy", vector.NewVector(0, 1, 0))))
+		require.NoError(t, vc.Set(ctx, "z", vector.NewDocument("vz", vector.NewVector(0, 0, 1))))
+		results, err := vc.SearchNearest(ctx, vector.NewVector(0.9, 0.1, 0), 2, true, false, nil)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		require.Equal(t, "x", results[0].Key)
+		require.Equal(t, "vx", results[0].Value)
+		require.Nil(t, results[0].Vectors)
+		require.Equal(t, "y", results[1].Key)
+		require.GreaterOrEqual(t, results[0].Score, results[1].Score)
+		results, err = vc.SearchNearest(ctx, vector.NewVector(0, 0, 1), 1, false, true, nil)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		require.Nil(t, results[0].Value)
+		require.Equal(t, []vector.Vector{vector.NewVector(0, 0, 1)}, results[0].Vectors)
+	})
+}
+
+func TestVectorCollection_SearchNearest_InvalidLimit(t *testing.T) {
+	vectorCollectionTester(t, func(t *testing.T, vc *hz.VectorCollection) {
+		_, err := vc.SearchNearest(context.Background(), vector.NewVector(1, 0, 0), 0, true, false, nil)
+		require.Error(t, err)
+	})
+}
+
+func vectorCollectionTester(t *testing.T, f func(t *testing.T, vc *hz.VectorCollection)) {
+	it.SkipIf(t, "hz < 5.5, !enterprise")
+	name := it.NewUniqueObjectName("vector-collection")
+	cb := func(config *hz.Config) {
+		it.Must(config.AddVectorCollection(name, vector.IndexConfig{Metric: vector.MetricCosine, Dimension: 3}))
+	}
+	it.TesterWithConfigBuilder(t, cb, func(t *testing.T, client *hz.Client) {
+		ctx := context.Background()
+		vc, err := client.GetVectorCollection(ctx, name)
+		require.NoError(t, err)
+		defer vc.Destroy(ctx)
+		f(t, vc)
+	})
+}